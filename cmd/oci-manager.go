@@ -19,24 +19,29 @@ package main
 import (
 	"context"
 	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/pprof"
 	"os"
+	"os/signal"
 	"reflect"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/golang/glog"
-	"golang.org/x/time/rate"
 
 	"k8s.io/api/core/v1"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	v1core "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/tools/leaderelection"
-	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
@@ -44,12 +49,18 @@ import (
 	ociauth "github.com/oracle/oci-go-sdk/common/auth"
 	ociidentity "github.com/oracle/oci-go-sdk/identity"
 
+	identitygroup "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
 	clientset "github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
 
 	"github.com/oracle/oci-manager/cmd/util"
 	cloudcontroller "github.com/oracle/oci-manager/pkg/controller/oci/cloud"
 	cloudcommon "github.com/oracle/oci-manager/pkg/controller/oci/cloud/common"
+	"github.com/oracle/oci-manager/pkg/health"
+	"github.com/oracle/oci-manager/pkg/leader"
+	"github.com/oracle/oci-manager/pkg/metrics"
+	"github.com/oracle/oci-manager/pkg/webhook"
 
 	kubecontroller "github.com/oracle/oci-manager/pkg/controller/oci/kubernetes"
 	kubecommon "github.com/oracle/oci-manager/pkg/controller/oci/kubernetes/common"
@@ -74,17 +85,48 @@ import (
 )
 
 var (
-	Version      string
-	kubeconfig   string
-	ociconfig    string
-	ipr          bool = false
-	disableCloud bool = false
-	resyncperiod int  = 60
-	kubeclient   kubernetes.Interface
+	Version       string
+	kubeconfig    string
+	ociconfig     string
+	ipr           bool = false
+	disableCloud  bool = false
+	dryRun        bool = false
+	resyncperiod  int  = 60
+	workers       int  = 1
+	kindWorkers        = util.KindWorkers{}
+	configFile    string
+	managerConfig        = &util.ManagerConfig{}
+	metricsAddr   string = ":8080"
+	clusterID     string
+	kubeclient    kubernetes.Interface
+	graphHandler  = resources.NewGraphHandler()
+
+	leaderElection = leader.DefaultConfig()
+	drainersLock   sync.Mutex
+	drainers       []*resources.Controller
+
+	healthAddr    = ":8081"
+	enablePprof   bool
+	healthChecker = health.NewChecker()
+	leading       = health.NewCondition("not holding the leader lock")
+	synced        = &health.Synced{}
+
+	webhookAddr     string
+	webhookCertFile string
+	webhookKeyFile  string
+	webhookCAFile   string
+	webhookService  string
+
+	conversionWebhook bool
+	storageVersion    = util.V1alpha1
+	crdConversion     *util.ConversionConfig
 )
 
 const (
 	EnvPodNamespace = "OCIM_POD_NAMESPACE"
+
+	// ociProbeInterval is the interval the OCI credentials are checked in for readiness
+	ociProbeInterval = time.Minute
 )
 
 var registerdAdapters = []string{
@@ -100,6 +142,19 @@ var registerdAdapters = []string{
 
 func main() {
 
+	if len(os.Args) > 1 && os.Args[1] == "graph" {
+		if err := util.RunGraphCommand(os.Args[2:], os.Stdout); err != nil {
+			glog.Fatalf("Error fetching dependency graph: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate-storage" {
+		if err := util.RunMigrateCommand(os.Args[2:], os.Stdout); err != nil {
+			glog.Fatalf("Error migrating the stored versions: %v", err)
+		}
+		return
+	}
+
 	namespace := os.Getenv(EnvPodNamespace)
 
 	flag.StringVar(&kubeconfig, "kubeconfig", kubeconfig, "kubeconfig file")
@@ -107,6 +162,29 @@ func main() {
 	flag.IntVar(&resyncperiod, "resync-seconds", resyncperiod, "full sync period in seconds")
 	flag.BoolVar(&ipr, "ipr", false, "use instance principals")
 	flag.BoolVar(&disableCloud, "disable-cloud", false, "disable cloud-abstraction controllers")
+	flag.IntVar(&workers, "workers", workers, "default number of concurrent workers per kind")
+	flag.Var(kindWorkers, "kind-workers", "per-kind worker overrides, e.g. AutonomousDatabase=4,Cluster.ocice.oracle.com=2, a bare kind applies to the kinds of every group")
+	flag.StringVar(&metricsAddr, "metrics-address", metricsAddr, "address to serve prometheus metrics on, empty to disable")
+	flag.StringVar(&healthAddr, "health-address", healthAddr, "address to serve the /healthz and /readyz probes on, empty to disable")
+	flag.BoolVar(&enablePprof, "pprof", enablePprof, "serve /debug/pprof on the health address")
+	flag.BoolVar(&dryRun, "dry-run", false, "only plan mutating OCI calls and record them in the resource status instead of sending them")
+	flag.StringVar(&configFile, "config", configFile, "manager config file with per-kind retry policies")
+	flag.StringVar(&webhookAddr, "webhook-address", webhookAddr, "address to serve the validating and defaulting admission webhooks on over TLS, empty to disable")
+	flag.StringVar(&webhookCertFile, "webhook-cert-file", webhookCertFile, "TLS certificate file of the webhook server")
+	flag.StringVar(&webhookKeyFile, "webhook-key-file", webhookKeyFile, "TLS key file of the webhook server")
+	flag.StringVar(&webhookCAFile, "webhook-ca-file", webhookCAFile, "CA bundle the apiserver verifies the webhook certificate with")
+	flag.StringVar(&webhookService, "webhook-service", webhookService, "service in the pod namespace routing to the webhooks, registers the webhook configurations if set")
+	flag.BoolVar(&conversionWebhook, "conversion-webhook", conversionWebhook, "serve the v1beta1 version of the oci resources converted by the webhook of --webhook-service, needs kubernetes 1.13 or later")
+	flag.StringVar(&storageVersion, "storage-version", storageVersion, "version the oci resources are stored in, v1beta1 needs --conversion-webhook")
+	flag.StringVar(&leaderElection.LockType, "leader-elect-resource-lock", leaderElection.LockType, "kind of the leader election lock, leases, configmaps or endpoints")
+	flag.StringVar(&leaderElection.Namespace, "leader-elect-namespace", leaderElection.Namespace, "namespace of the leader election lock, defaults to the oci-manager namespace")
+	flag.StringVar(&leaderElection.Name, "leader-elect-name", leaderElection.Name, "name of the leader election lock")
+	flag.StringVar(&leaderElection.Identity, "leader-elect-identity", leaderElection.Identity, "identity of the replica in the leader election, defaults to oci-manager-<hostname>")
+	flag.DurationVar(&leaderElection.LeaseDuration, "leader-elect-lease-duration", leaderElection.LeaseDuration, "duration a candidate waits before taking over a lock that is not renewed")
+	flag.DurationVar(&leaderElection.RenewDeadline, "leader-elect-renew-deadline", leaderElection.RenewDeadline, "duration the leader retries renewing the lock before it stops leading")
+	flag.DurationVar(&leaderElection.RetryPeriod, "leader-elect-retry-period", leaderElection.RetryPeriod, "interval of the attempts to acquire or renew the lock")
+	flag.DurationVar(&leaderElection.ShutdownTimeout, "shutdown-timeout", leaderElection.ShutdownTimeout, "how long the reconciles in flight are waited for once the replica stops leading")
+	flag.StringVar(&clusterID, "cluster-id", clusterID, "cluster id tagged on the created oci resources, defaults to the uid of the kube-system namespace")
 
 	flag.Set("logtostderr", "true")
 	flag.Parse()
@@ -123,43 +201,117 @@ func main() {
 		namespace = "oci-system"
 	}
 
+	if conversionWebhook {
+		if webhookService == "" {
+			glog.Fatalf("--conversion-webhook needs --webhook-service")
+		}
+		crdConversion = &util.ConversionConfig{
+			StorageVersion:   storageVersion,
+			ServiceNamespace: namespace,
+			ServiceName:      webhookService,
+			CABundle:         webhookCABundle(),
+		}
+	}
+	if storageVersion != util.V1alpha1 && (crdConversion == nil || storageVersion != util.V1beta1) {
+		glog.Fatalf("Unsupported storage version %s, v1beta1 needs --conversion-webhook", storageVersion)
+	}
+
+	loaded, err := util.LoadManagerConfig(configFile)
+	if err != nil {
+		glog.Fatalf("Error loading config file: %v", err)
+	}
+	managerConfig = loaded
+	resourcescommon.SetThrottlePolicies(managerConfig.Throttle.Default, managerConfig.Throttle.Services)
+	auditSinks, err := managerConfig.AuditSinks()
+	if err != nil {
+		glog.Fatalf("Error opening the audit log: %v", err)
+	}
+	resourcescommon.SetAuditSinks(auditSinks...)
+
+	if dryRun {
+		glog.Infof("Dry-run mode: mutating OCI calls are recorded in the resource status plan and not sent")
+		resourcescommon.SetDryRun(true)
+	}
+
+	if metricsAddr != "" {
+		go serveMetrics(metricsAddr)
+	}
+
+	// Only the leader with synced caches and working OCI credentials is ready
+	healthChecker.AddReadyCheck("leader", leading.Check)
+	healthChecker.AddReadyCheck("informers", synced.Check)
+	if healthAddr != "" {
+		go serveHealth(healthAddr)
+	}
+
 	config := getKubeConfig()
-	var err error
 	kubeclient, err = kubernetes.NewForConfig(config)
 
-	host, err := os.Hostname()
-	glog.Infof("Got host %s", host)
-	id := "oci-manager-" + host
-
-	rl, err := resourcelock.New(resourcelock.ConfigMapsResourceLock,
-		namespace,
-		"oci-manager",
-		kubeclient.CoreV1(),
-		resourcelock.ResourceLockConfig{
-			Identity:      id,
-			EventRecorder: createRecorder(kubeclient, "oci-manager", namespace),
-		})
+	if clusterID == "" {
+		clusterID = defaultClusterID(kubeclient)
+	}
+	resourcescommon.SetClusterID(clusterID)
+
+	// Every replica serves the webhook, not only the leader
+	if webhookAddr != "" {
+		go serveWebhook(config, namespace)
+	}
+
+	if leaderElection.Namespace == "" {
+		leaderElection.Namespace = namespace
+	}
+	if leaderElection.Identity == "" {
+		host, _ := os.Hostname()
+		glog.Infof("Got host %s", host)
+		leaderElection.Identity = "oci-manager-" + host
+	}
+	leaseClient, err := leader.NewLeaseClient(config)
+	if err != nil {
+		glog.Fatalf("Error creating lease client: %v", err)
+	}
+	lock, err := leader.NewLock(leaderElection, kubeclient, leaseClient, createRecorder(kubeclient, "oci-manager", leaderElection.Namespace))
 	if err != nil {
-		glog.Fatalf("error creating lock: %v", err)
-	}
-
-	leaderelection.RunOrDie(leaderelection.LeaderElectionConfig{
-		Lock:          rl,
-		LeaseDuration: 45 * time.Second,
-		RenewDeadline: 30 * time.Second,
-		RetryPeriod:   10 * time.Second,
-		Callbacks: leaderelection.LeaderCallbacks{
-			OnStartedLeading: run,
-			OnStoppedLeading: func() {
-				glog.Fatalf("leader election lost")
-			},
+		glog.Fatalf("Error creating lock: %v", err)
+	}
+
+	// A replica stopping to lead lets its reconciles finish and releases the lock before it exits
+	stopCh := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		glog.Infof("Received %v", <-signals)
+		close(stopCh)
+	}()
+
+	err = leader.Run(leaderElection, lock, leader.Callbacks{
+		OnStartedLeading: func(stopCh <-chan struct{}) {
+			leading.Set(true)
+			run(stopCh, namespace)
+		},
+		OnStoppedLeading: func() {
+			leading.Set(false)
 		},
-	})
+		Drain: drainControllers,
+	}, stopCh)
+	glog.Flush()
+	if err != nil {
+		glog.Errorf("Leader election: %v", err)
+		glog.Flush()
+		os.Exit(1)
+	}
+}
 
-	panic("unreachable")
+// defaultClusterID returns the uid of the kube-system namespace, which is stable for the lifetime of the cluster
+func defaultClusterID(kubeclient kubernetes.Interface) string {
+	ns, err := kubeclient.CoreV1().Namespaces().Get(metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		glog.Warningf("Error getting the kube-system namespace, the created resources are not tagged with a cluster id: %v", err)
+		return ""
+	}
+	return string(ns.UID)
 }
 
-func run(stopCh <-chan struct{}) {
+func run(stopCh <-chan struct{}, namespace string) {
 
 	var (
 		ocicfg ocisdkcommon.ConfigurationProvider
@@ -195,6 +347,9 @@ func run(stopCh <-chan struct{}) {
 
 	//check client config with compartment list
 	checkCompartments(ocicfg)
+	ociProbe := health.NewProbe("oci", ociProbeInterval, func() error { return probeOCI(ocicfg) })
+	healthChecker.AddReadyCheck("oci", ociProbe.Check)
+	ociProbe.Run(stopCh)
 
 	clientset, err := clientset.NewForConfig(config)
 	if err != nil {
@@ -204,57 +359,74 @@ func run(stopCh <-chan struct{}) {
 	informersFactory := informers.NewSharedInformerFactory(clientset, time.Duration(resyncperiod)*time.Second)
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeclient, time.Duration(resyncperiod)*time.Second)
 
+	// The namespace controller runs the namespace informer of kubeInformerFactory itself,
+	// the paused annotation of namespaces is looked up from a separate informer
+	namespaceInformerFactory := kubeinformers.NewSharedInformerFactory(kubeclient, time.Duration(resyncperiod)*time.Second)
+	namespaces := namespaceInformerFactory.Core().V1().Namespaces()
+	namespaces.Informer()
+	namespaceInformerFactory.Start(stopCh)
+	namespaceInformerFactory.WaitForCacheSync(stopCh)
+
+	// Namespaces select other OCI credentials with an annotation, their secrets are only
+	// read from the oci-manager namespace
+	secretInformerFactory := kubeinformers.NewFilteredSharedInformerFactory(kubeclient, time.Duration(resyncperiod)*time.Second, namespace, nil)
+	credentials := resources.NewCredentials(namespace, namespaces,
+		resources.NewCredentialsInformer(clientset, time.Duration(resyncperiod)*time.Second), secretInformerFactory.Core().V1().Secrets())
+	if !credentials.Run(stopCh) {
+		glog.Fatalf("Timed out waiting for the OCI credentials caches to sync")
+	}
+	synced.Add("OciCredentials", credentials.HasSynced)
+
 	// Start cloud controllers first
 	if !disableCloud {
-		startCloudControllers(clientset, kubeclient, informersFactory, stopCh)
+		startCloudControllers(clientset, kubeclient, informersFactory, namespaces, stopCh)
 	}
 
 	// Start resource controllers
-	startResourceControllers(clientset, kubeclient, ocicfg, informersFactory, stopCh)
+	startResourceControllers(clientset, kubeclient, ocicfg, informersFactory, namespaces, credentials, stopCh)
 
 	// Start resource controllers
 	startKubernetesControllers(clientset, kubeclient, kubeInformerFactory, stopCh)
+	synced.Complete()
 
-	// Wait forever
-	select {}
+	<-stopCh
 }
 
-func startCloudControllers(clientSet clientset.Interface, kubeclient kubernetes.Interface, resourceIFactory informers.SharedInformerFactory, stopChan <-chan struct{}) {
+func startCloudControllers(clientSet clientset.Interface, kubeclient kubernetes.Interface, resourceIFactory informers.SharedInformerFactory, namespaces coreinformers.NamespaceInformer, stopChan <-chan struct{}) {
 
 	workQueues := make(map[string]workqueue.RateLimitingInterface)
 	cloudInformersFactory := informers.NewSharedInformerFactory(clientSet, time.Duration(resyncperiod)*time.Second)
 	for kind, cloudType := range cloudcommon.CloudTypes() {
 		glog.Infof("Starting cloud controller for %s\n", kind)
-		rateLimiter := workqueue.NewMaxOfRateLimiter(
-			workqueue.NewItemExponentialFailureRateLimiter(2*time.Second, 1000*time.Second),
-			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(float64(10)), 100)},
-		)
-		workQueues[kind] = workqueue.NewRateLimitingQueue(rateLimiter)
-
-		controller := cloudcontroller.New(cloudType.AdapterFactory, clientSet, kubeclient, cloudInformersFactory, resourceIFactory, workQueues)
-		controller.Run(stopChan)
+		workQueues[kind] = workqueue.NewNamedRateLimitingQueue(managerConfig.RetryPolicy(kind, cloudType.GroupName).RateLimiter(), "cloud_"+kind)
+
+		controller := cloudcontroller.New(cloudType.AdapterFactory, clientSet, kubeclient, cloudInformersFactory, resourceIFactory, namespaces, workQueues)
+		controller.Run(kindWorkers.For(kind, cloudType.GroupName, workers), stopChan)
+		synced.Add("cloud/"+kind, controller.HasSynced)
 		time.Sleep(3 * time.Second)
 	}
 
 }
 
-func startResourceControllers(clientset clientset.Interface, kubeclient kubernetes.Interface, ocicfg ocisdkcommon.ConfigurationProvider, informersFactory informers.SharedInformerFactory, stopCh <-chan struct{}) {
+func startResourceControllers(clientset clientset.Interface, kubeclient kubernetes.Interface, ocicfg ocisdkcommon.ConfigurationProvider, informersFactory informers.SharedInformerFactory, namespaces coreinformers.NamespaceInformer, credentials *resources.Credentials, stopCh <-chan struct{}) {
 
 	adapterSpecificArgs := make(map[string]interface{})
 	workQueues := make(map[string]workqueue.RateLimitingInterface)
 	controllers := make(map[string]*resources.Controller)
 	for kind, ocitype := range resourcescommon.ResourceTypes() {
 		glog.Infof("Starting resource controller for %s/%s\n", ocitype.GroupName, kind)
-		rateLimiter := workqueue.NewMaxOfRateLimiter(
-			workqueue.NewItemExponentialFailureRateLimiter(2*time.Second, 1000*time.Second),
-			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(float64(10)), 100)},
-		)
-		workQueues[kind] = workqueue.NewRateLimitingQueue(rateLimiter)
+		policy := managerConfig.RetryPolicy(kind, ocitype.GroupName)
+		workQueues[kind] = workqueue.NewNamedRateLimitingQueue(policy.RateLimiter(), "resources_"+kind)
 
-		controllers[kind] = resources.Start(clientset, kubeclient, ocicfg, informersFactory, stopCh, ocitype.AdapterFactory, adapterSpecificArgs, workQueues)
+		controllers[kind] = resources.Start(clientset, kubeclient, ocicfg, informersFactory, namespaces, credentials, stopCh, ocitype.AdapterFactory, adapterSpecificArgs, workQueues, kindWorkers.For(kind, ocitype.GroupName, workers), policy)
+		addDrainer(controllers[kind])
+		graphHandler.Register(controllers[kind])
+		synced.Add(kind, controllers[kind].HasSynced)
 		time.Sleep(5 * time.Second)
 	}
 
+	resources.NewSweeper(controllers, managerConfig.SweepPolicy()).Run(stopCh)
+
 }
 
 func startKubernetesControllers(clientset clientset.Interface, kubeclient kubernetes.Interface, informersFactory kubeinformers.SharedInformerFactory, stopCh <-chan struct{}) {
@@ -264,19 +436,107 @@ func startKubernetesControllers(clientset clientset.Interface, kubeclient kubern
 	controllers := make(map[string]*kubecontroller.Controller)
 	for key, kubetype := range kubecommon.KubernetesTypes() {
 		glog.Infof("Starting kubernetes controller for %s\n", key)
-		rateLimiter := workqueue.NewMaxOfRateLimiter(
-			workqueue.NewItemExponentialFailureRateLimiter(2*time.Second, 1000*time.Second),
-			&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(float64(10)), 100)},
-		)
 		objectType := reflect.TypeOf(kubetype.Type).String()
-		workQueues[objectType] = workqueue.NewRateLimitingQueue(rateLimiter)
+		workQueues[objectType] = workqueue.NewNamedRateLimitingQueue(managerConfig.RetryPolicy(key, kubetype.GroupName).RateLimiter(), "kubernetes_"+key)
 
-		controllers[key] = kubecontroller.Start(clientset, kubeclient, kubetype.Type, kubetype.AdapterFactory, adapterSpecificArgs, informersFactory, stopCh, workQueues)
+		controllers[key] = kubecontroller.Start(clientset, kubeclient, kubetype.Type, kubetype.AdapterFactory, adapterSpecificArgs, informersFactory, stopCh, workQueues, kindWorkers.For(key, kubetype.GroupName, workers))
+		synced.Add("kubernetes/"+key, controllers[key].HasSynced)
 		time.Sleep(5 * time.Second)
 	}
 
 }
 
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle(util.GraphPath, graphHandler)
+	glog.Infof("Serving metrics and dependency graph on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		glog.Errorf("Error serving metrics: %v", err)
+	}
+}
+
+func addDrainer(controller *resources.Controller) {
+	drainersLock.Lock()
+	defer drainersLock.Unlock()
+	drainers = append(drainers, controller)
+}
+
+// drainControllers waits up to timeout for the reconciles in flight of all resource controllers,
+// which are the ones issuing OCI calls
+func drainControllers(timeout time.Duration) bool {
+	drainersLock.Lock()
+	controllers := drainers
+	drainersLock.Unlock()
+
+	deadline := time.Now().Add(timeout)
+	drained := true
+	for _, controller := range controllers {
+		if !controller.Drain(time.Until(deadline)) {
+			drained = false
+		}
+	}
+	return drained
+}
+
+func serveHealth(addr string) {
+	mux := http.NewServeMux()
+	healthChecker.Register(mux)
+	if enablePprof {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+	glog.Infof("Serving health probes on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		glog.Errorf("Error serving health probes: %v", err)
+	}
+}
+
+func serveWebhook(config *rest.Config, namespace string) {
+	client, err := clientset.NewForConfig(config)
+	if err != nil {
+		glog.Fatalf("Error creating webhook client: %v", err)
+	}
+	factory := informers.NewSharedInformerFactory(client, time.Duration(resyncperiod)*time.Second)
+	kubeFactory := kubeinformers.NewSharedInformerFactory(kubeclient, time.Duration(resyncperiod)*time.Second)
+	server := webhook.NewServer(factory, kubeFactory, kubeclient)
+	stopCh := make(chan struct{})
+	factory.Start(stopCh)
+	kubeFactory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+	kubeFactory.WaitForCacheSync(stopCh)
+
+	if webhookService != "" {
+		if err := webhook.RegisterWebhooks(kubeclient, namespace, webhookService, webhookCABundle()); err != nil {
+			glog.Errorf("Error registering webhooks: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(webhook.ValidatePath, server)
+	mux.Handle(webhook.DefaultPath, server)
+	mux.Handle(webhook.ConvertPath, server)
+	glog.Infof("Serving validating, defaulting and conversion webhooks on %s", webhookAddr)
+	if err := http.ListenAndServeTLS(webhookAddr, webhookCertFile, webhookKeyFile, mux); err != nil {
+		glog.Fatalf("Error serving webhook: %v", err)
+	}
+}
+
+// webhookCABundle returns the CA bundle the apiserver verifies the webhooks with
+func webhookCABundle() []byte {
+	if webhookCAFile == "" {
+		return nil
+	}
+	caBundle, err := ioutil.ReadFile(webhookCAFile)
+	if err != nil {
+		glog.Fatalf("Error reading webhook CA bundle: %v", err)
+	}
+	return caBundle
+}
+
 func getKubeConfig() (config *rest.Config) {
 	var err error
 	// Create the kube object client config. Use kubeconfig if given, otherwise assume in-cluster.
@@ -299,14 +559,22 @@ func createCrdDefinitions(config *rest.Config) {
 	}
 	// Create resource definitions
 	for _, ocitype := range resourcescommon.ResourceTypes() {
-		_, err = util.CreateResourceDefinition(kubeclient, ocitype.ResourcePlural, ocitype.Kind, ocitype.GroupName, ocitype.Validation)
+		_, err = util.CreateResourceDefinition(kubeclient, ocitype.ResourcePlural, ocitype.Kind, ocitype.GroupName, ocitype.Validation,
+			ocitype.PrinterColumns, ocitype.Subresources, crdConversion)
 		if err != nil && !apierrors.IsAlreadyExists(err) {
 			panic(err)
 		}
 	}
 
+	_, err = util.CreateClusterResourceDefinition(kubeclient, ociidentityv1alpha1.OciCredentialsResourcePlural, ociidentityv1alpha1.OciCredentialsKind,
+		identitygroup.GroupName, &ociidentityv1alpha1.OciCredentialsValidation, ociidentityv1alpha1.OciCredentialsColumns)
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		panic(err)
+	}
+
 	for _, cloudtype := range cloudcommon.CloudTypes() {
-		_, err = util.CreateResourceDefinition(kubeclient, cloudtype.ResourcePlural, cloudtype.Kind, cloudtype.GroupName, cloudtype.Validation)
+		_, err = util.CreateResourceDefinition(kubeclient, cloudtype.ResourcePlural, cloudtype.Kind, cloudtype.GroupName, cloudtype.Validation,
+			cloudtype.PrinterColumns, cloudtype.Subresources, nil)
 		if err != nil && !apierrors.IsAlreadyExists(err) {
 			panic(err)
 		}
@@ -334,6 +602,22 @@ func checkCompartments(ocicfg ocisdkcommon.ConfigurationProvider) error {
 	return nil
 }
 
+// probeOCI checks that the default OCI credentials still work with a lookup of the tenancy
+func probeOCI(ocicfg ocisdkcommon.ConfigurationProvider) error {
+	client, err := ociidentity.NewIdentityClientWithConfigurationProvider(ocicfg)
+	if err != nil {
+		return err
+	}
+	tenancyID, err := ocicfg.TenancyOCID()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_, err = client.GetTenancy(ctx, ociidentity.GetTenancyRequest{TenancyId: &tenancyID})
+	return err
+}
+
 func createRecorder(kubecli kubernetes.Interface, name, namespace string) record.EventRecorder {
 	eventBroadcaster := record.NewBroadcaster()
 	//eventBroadcaster.StartLogging(glog.Infof)
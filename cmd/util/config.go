@@ -0,0 +1,157 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/ghodss/yaml"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ManagerConfig is the optional manager config file, e.g.
+//
+//	retry:
+//	  default:
+//	    maxRetries: 10
+//	  kinds:
+//	    Cluster.ocice.oracle.com:
+//	      pendingTimeouts:
+//	        Create: 90m
+//	throttle:
+//	  default:
+//	    qps: 5
+//	  services:
+//	    compute:
+//	      qps: 2
+//	      burst: 5
+//	sweep:
+//	  interval: 30m
+//	  delete: true
+//	audit:
+//	  file:
+//	    path: /var/log/oci-manager/audit.log
+type ManagerConfig struct {
+	Retry    RetryConfig                 `json:"retry,omitempty"`
+	Throttle ThrottleConfig              `json:"throttle,omitempty"`
+	Sweep    resourcescommon.SweepPolicy `json:"sweep,omitempty"`
+	Audit    AuditConfig                 `json:"audit,omitempty"`
+}
+
+// RetryConfig holds the retry policy for all kinds and per-kind overrides, kinds
+// are keyed by the bare kind for every group or by the group-qualified kind
+type RetryConfig struct {
+	Default resourcescommon.RetryPolicy            `json:"default,omitempty"`
+	Kinds   map[string]resourcescommon.RetryPolicy `json:"kinds,omitempty"`
+}
+
+// ThrottleConfig holds the OCI call throttle policy for all services and per-service
+// overrides, services are named as in the ocimanager_oci_requests_total metric
+type ThrottleConfig struct {
+	Default  resourcescommon.ThrottlePolicy            `json:"default,omitempty"`
+	Services map[string]resourcescommon.ThrottlePolicy `json:"services,omitempty"`
+}
+
+// AuditConfig selects the sinks the audit records of the mutating OCI calls are written to,
+// the audit log is off without any
+type AuditConfig struct {
+	// Stdout writes the records to stdout
+	Stdout bool `json:"stdout,omitempty"`
+	// File appends the records to a rotated file
+	File *AuditFileConfig `json:"file,omitempty"`
+	// Webhook posts every record to a URL
+	Webhook *AuditWebhookConfig `json:"webhook,omitempty"`
+}
+
+// AuditFileConfig is the file of the audit log, it is rotated once it exceeds MaxSizeMB
+// (default 100) keeping MaxBackups (default 5) rotated files
+type AuditFileConfig struct {
+	Path       string `json:"path"`
+	MaxSizeMB  int    `json:"maxSizeMB,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty"`
+}
+
+// AuditWebhookConfig is the URL audit records are posted to, Timeout (default 5s) bounds
+// how long an OCI call waits for the webhook
+type AuditWebhookConfig struct {
+	URL     string          `json:"url"`
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+// LoadManagerConfig reads the manager config file, an empty path returns the defaults
+func LoadManagerConfig(path string) (*ManagerConfig, error) {
+	config := &ManagerConfig{}
+	if path == "" {
+		return config, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// RetryPolicy returns the retry policy of a kind of a group, settings of the
+// group-qualified kind take precedence over those of the bare kind, the configured
+// default and the built-in policy
+func (c *ManagerConfig) RetryPolicy(kind, group string) resourcescommon.RetryPolicy {
+	return resourcescommon.DefaultRetryPolicy().Merge(c.Retry.Default).Merge(c.Retry.Kinds[kind]).
+		Merge(c.Retry.Kinds[QualifiedKind(kind, group)])
+}
+
+// SweepPolicy returns the policy of the orphan sweeper, the configured settings take
+// precedence over the built-in policy
+func (c *ManagerConfig) SweepPolicy() resourcescommon.SweepPolicy {
+	return resourcescommon.DefaultSweepPolicy().Merge(c.Sweep)
+}
+
+// AuditSinks returns the configured audit sinks
+func (c *ManagerConfig) AuditSinks() ([]resourcescommon.AuditSink, error) {
+	var sinks []resourcescommon.AuditSink
+	if c.Audit.Stdout {
+		sinks = append(sinks, resourcescommon.NewWriterAuditSink(os.Stdout))
+	}
+	if file := c.Audit.File; file != nil {
+		maxSize, maxBackups := file.MaxSizeMB, file.MaxBackups
+		if maxSize == 0 {
+			maxSize = 100
+		}
+		if maxBackups == 0 {
+			maxBackups = 5
+		}
+		sink, err := resourcescommon.NewFileAuditSink(file.Path, int64(maxSize)<<20, maxBackups)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if webhook := c.Audit.Webhook; webhook != nil {
+		timeout := webhook.Timeout.Duration
+		if timeout == 0 {
+			timeout = 5 * time.Second
+		}
+		sinks = append(sinks, resourcescommon.NewWebhookAuditSink(webhook.URL, timeout))
+	}
+	return sinks, nil
+}
@@ -17,22 +17,66 @@ limitations under the License.
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/golang/glog"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	"github.com/oracle/oci-manager/pkg/webhook"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	utilErrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"reflect"
+	"strings"
 	"time"
 )
 
-func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, kind, groupName string, validation *apiextensionsv1beta1.CustomResourceValidation) (*apiextensionsv1beta1.CustomResourceDefinition, error) {
+// Versions of the oci resource CRDs
+const (
+	V1alpha1 = "v1alpha1"
+	V1beta1  = "v1beta1"
+)
+
+// ConversionConfig serves the v1beta1 version of a CRD next to v1alpha1, the objects are
+// converted by the conversion webhook of the service
+type ConversionConfig struct {
+	// StorageVersion is the version objects are written to etcd in
+	StorageVersion   string
+	ServiceNamespace string
+	ServiceName      string
+	CABundle         []byte
+}
+
+// CreateResourceDefinition creates or updates the CRD of a kind with its schema, printer
+// columns and subresources. Without a conversion config only v1alpha1 is served.
+func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, kind, groupName string,
+	validation *apiextensionsv1beta1.CustomResourceValidation, columns []apiextensionsv1beta1.CustomResourceColumnDefinition,
+	subresources *apiextensionsv1beta1.CustomResourceSubresources, conversion *ConversionConfig) (*apiextensionsv1beta1.CustomResourceDefinition, error) {
+	return createResourceDefinition(clientset, plural, kind, groupName, apiextensionsv1beta1.NamespaceScoped, validation, columns, subresources, conversion)
+}
+
+// CreateClusterResourceDefinition creates or updates the CRD of a cluster scoped kind, only
+// v1alpha1 is served
+func CreateClusterResourceDefinition(clientset apiextensionsclient.Interface, plural, kind, groupName string,
+	validation *apiextensionsv1beta1.CustomResourceValidation, columns []apiextensionsv1beta1.CustomResourceColumnDefinition) (*apiextensionsv1beta1.CustomResourceDefinition, error) {
+	return createResourceDefinition(clientset, plural, kind, groupName, apiextensionsv1beta1.ClusterScoped, validation, columns, nil, nil)
+}
+
+func createResourceDefinition(clientset apiextensionsclient.Interface, plural, kind, groupName string, scope apiextensionsv1beta1.ResourceScope,
+	validation *apiextensionsv1beta1.CustomResourceValidation, columns []apiextensionsv1beta1.CustomResourceColumnDefinition,
+	subresources *apiextensionsv1beta1.CustomResourceSubresources, conversion *ConversionConfig) (*apiextensionsv1beta1.CustomResourceDefinition, error) {
 	var crdname = plural + "." + groupName
-	var schemeGroupVersion = schema.GroupVersion{Group: groupName, Version: "v1alpha1"}
+	var schemeGroupVersion = schema.GroupVersion{Group: groupName, Version: V1alpha1}
+	versions := []apiextensionsv1beta1.CustomResourceDefinitionVersion{{Name: V1alpha1, Served: true, Storage: true}}
+	if conversion != nil {
+		schemeGroupVersion.Version = conversion.StorageVersion
+		versions = servedVersions(conversion.StorageVersion)
+		validation = versionedValidation(validation, webhook.FieldRenames(groupName, kind))
+	}
 
 	crd := &apiextensionsv1beta1.CustomResourceDefinition{
 		ObjectMeta: metav1.ObjectMeta{
@@ -41,17 +85,22 @@ func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, k
 		Spec: apiextensionsv1beta1.CustomResourceDefinitionSpec{
 			Group:   groupName,
 			Version: schemeGroupVersion.Version,
-			Scope:   apiextensionsv1beta1.NamespaceScoped,
+			Scope:   scope,
 			Names: apiextensionsv1beta1.CustomResourceDefinitionNames{
 				Plural: plural,
 				Kind:   kind,
 			},
-			Validation: validation,
+			Validation:               validation,
+			Versions:                 versions,
+			Subresources:             subresources,
+			AdditionalPrinterColumns: columns,
 		},
 	}
 	_, err := clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Create(crd)
 	if err != nil {
-		if errors.IsAlreadyExists(err) {
+		if errors.IsAlreadyExists(err) && conversion != nil {
+			glog.V(4).Infof("Resource definition for %s/%s exists", groupName, kind)
+		} else if errors.IsAlreadyExists(err) {
 			found, err := clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Get(crdname, metav1.GetOptions{})
 			if err != nil {
 				return nil, err
@@ -60,8 +109,11 @@ func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, k
 			updCrd := found.DeepCopy()
 			updCrd.Spec.Group = groupName
 			updCrd.Spec.Version = schemeGroupVersion.Version
-			updCrd.Spec.Scope = apiextensionsv1beta1.NamespaceScoped
+			updCrd.Spec.Scope = scope
 			updCrd.Spec.Validation = validation
+			updCrd.Spec.Versions = versions
+			updCrd.Spec.Subresources = subresources
+			updCrd.Spec.AdditionalPrinterColumns = columns
 			updCrd.Spec.Names.Plural = plural
 			updCrd.Spec.Names.Kind = kind
 
@@ -82,6 +134,12 @@ func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, k
 		glog.Infof("Created resource definition for %s/%s\n", groupName, kind)
 	}
 
+	if conversion != nil {
+		if err = patchVersions(clientset, crdname, crd.Spec, conversion); err != nil {
+			return nil, err
+		}
+	}
+
 	// wait for CRD being established
 	err = wait.Poll(500*time.Millisecond, 60*time.Second, func() (bool, error) {
 		crd, err = clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Get(crdname, metav1.GetOptions{})
@@ -113,3 +171,91 @@ func CreateResourceDefinition(clientset apiextensionsclient.Interface, plural, k
 	}
 	return crd, nil
 }
+
+// servedVersions serves v1alpha1 and v1beta1, the storage version comes first so
+// discovery prefers it
+func servedVersions(storageVersion string) []apiextensionsv1beta1.CustomResourceDefinitionVersion {
+	versions := []apiextensionsv1beta1.CustomResourceDefinitionVersion{
+		{Name: V1alpha1, Served: true, Storage: storageVersion == V1alpha1},
+		{Name: V1beta1, Served: true, Storage: storageVersion == V1beta1},
+	}
+	if storageVersion == V1beta1 {
+		versions[0], versions[1] = versions[1], versions[0]
+	}
+	return versions
+}
+
+// versionedValidation returns a schema accepting both versions, which share the top
+// level schema of the CRD. The renamed fields are described under both names and are
+// no longer required, since an object carries only one of them.
+func versionedValidation(validation *apiextensionsv1beta1.CustomResourceValidation, renames []commonv1beta1.FieldRename) *apiextensionsv1beta1.CustomResourceValidation {
+	if validation == nil || validation.OpenAPIV3Schema == nil {
+		return validation
+	}
+	validation = validation.DeepCopy()
+	for _, rename := range renames {
+		path := strings.Split(rename.V1beta1, ".")
+		from := rename.V1alpha1[strings.LastIndex(rename.V1alpha1, ".")+1:]
+		*validation.OpenAPIV3Schema = renameProperty(*validation.OpenAPIV3Schema, path[:len(path)-1], from, path[len(path)-1])
+	}
+	return validation
+}
+
+func renameProperty(props apiextensionsv1beta1.JSONSchemaProps, parents []string, from, to string) apiextensionsv1beta1.JSONSchemaProps {
+	if len(parents) > 0 && parents[0] == "*" {
+		// the renamed field is in the values of a map or the items of a list
+		if props.AdditionalProperties != nil && props.AdditionalProperties.Schema != nil {
+			values := renameProperty(*props.AdditionalProperties.Schema, parents[1:], from, to)
+			props.AdditionalProperties = &apiextensionsv1beta1.JSONSchemaPropsOrBool{Allows: true, Schema: &values}
+		}
+		if props.Items != nil && props.Items.Schema != nil {
+			items := renameProperty(*props.Items.Schema, parents[1:], from, to)
+			props.Items = &apiextensionsv1beta1.JSONSchemaPropsOrArray{Schema: &items}
+		}
+		return props
+	}
+	if len(parents) > 0 {
+		if child, ok := props.Properties[parents[0]]; ok {
+			props.Properties[parents[0]] = renameProperty(child, parents[1:], from, to)
+		}
+		return props
+	}
+	if property, ok := props.Properties[from]; ok {
+		props.Properties[to] = property
+	}
+	var required []string
+	for _, name := range props.Required {
+		if name != from {
+			required = append(required, name)
+		}
+	}
+	props.Required = required
+	return props
+}
+
+// patchVersions sets the served versions, the schema, the printer columns, the subresources
+// and the conversion webhook in one JSON patch. The vendored CRD type predates
+// spec.conversion, a typed update would drop it.
+func patchVersions(clientset apiextensionsclient.Interface, crdname string, spec apiextensionsv1beta1.CustomResourceDefinitionSpec, conversion *ConversionConfig) error {
+	path := webhook.ConvertPath
+	patch := []map[string]interface{}{
+		{"op": "add", "path": "/spec/version", "value": spec.Version},
+		{"op": "add", "path": "/spec/versions", "value": spec.Versions},
+		{"op": "add", "path": "/spec/validation", "value": spec.Validation},
+		{"op": "add", "path": "/spec/subresources", "value": spec.Subresources},
+		{"op": "add", "path": "/spec/additionalPrinterColumns", "value": spec.AdditionalPrinterColumns},
+		{"op": "add", "path": "/spec/conversion", "value": map[string]interface{}{
+			"strategy": "Webhook",
+			"webhookClientConfig": map[string]interface{}{
+				"service":  map[string]interface{}{"namespace": conversion.ServiceNamespace, "name": conversion.ServiceName, "path": &path},
+				"caBundle": conversion.CABundle,
+			},
+		}},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	_, err = clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Patch(crdname, types.JSONPatchType, data)
+	return err
+}
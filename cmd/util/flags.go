@@ -0,0 +1,79 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// QualifiedKind returns the kind qualified by its API group, e.g. Cluster.ocice.oracle.com,
+// the kinds of the core group stay unqualified
+func QualifiedKind(kind, group string) string {
+	if group == "" {
+		return kind
+	}
+	return kind + "." + group
+}
+
+// KindWorkers is a flag value holding per-kind worker count overrides in the
+// form Kind=N,Kind.group=N. A bare kind applies to the kinds of every group,
+// a group-qualified kind only to that group and takes precedence
+type KindWorkers map[string]int
+
+// String returns the flag value in its command line form
+func (k KindWorkers) String() string {
+	pairs := make([]string, 0, len(k))
+	for kind, count := range k {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", kind, count))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Set parses a comma separated list of Kind=N pairs
+func (k KindWorkers) Set(value string) error {
+	for _, pair := range strings.Split(value, ",") {
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid worker override %q, expected Kind=N or Kind.group=N", pair)
+		}
+		count, err := strconv.Atoi(parts[1])
+		if err != nil || count < 1 {
+			return fmt.Errorf("invalid worker count %q for %s", parts[1], parts[0])
+		}
+		k[parts[0]] = count
+	}
+	return nil
+}
+
+// For returns the worker count for a kind of a group, falling back to the
+// count of the bare kind and then to the default
+func (k KindWorkers) For(kind, group string, defaultWorkers int) int {
+	if count, ok := k[QualifiedKind(kind, group)]; ok {
+		return count
+	}
+	if count, ok := k[kind]; ok {
+		return count
+	}
+	return defaultWorkers
+}
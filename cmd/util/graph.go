@@ -0,0 +1,58 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GraphPath is the debug endpoint serving the dependency graph next to the metrics
+const GraphPath = "/debug/dependencies"
+
+// RunGraphCommand implements the graph subcommand. It prints the dependency graph
+// of a running manager, e.g. oci-manager graph --format dot | dot -Tsvg
+func RunGraphCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("graph", flag.ContinueOnError)
+	address := flags.String("address", "localhost:8080", "metrics address of the running oci-manager")
+	format := flags.String("format", "json", "output format, json or dot")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	host := *address
+	if strings.HasPrefix(host, ":") {
+		host = "localhost" + host
+	}
+	resp, err := http.Get(fmt.Sprintf("http://%s%s?format=%s", host, GraphPath, url.QueryEscape(*format)))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
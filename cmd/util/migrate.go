@@ -0,0 +1,169 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1beta1"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	ocidbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1beta1"
+	ociidentityv1beta1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1beta1"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1beta1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/util/retry"
+)
+
+// versionedGroups are the groups served in v1alpha1 and v1beta1
+var versionedGroups = map[string]bool{
+	ocicorev1beta1.SchemeGroupVersion.Group:     true,
+	ocilbv1beta1.SchemeGroupVersion.Group:       true,
+	ocicev1beta1.SchemeGroupVersion.Group:       true,
+	ocidbv1beta1.SchemeGroupVersion.Group:       true,
+	ociidentityv1beta1.SchemeGroupVersion.Group: true,
+}
+
+// RunMigrateCommand implements the migrate-storage subcommand. Once the manager stores
+// the oci resources in a new version it rewrites every object so etcd only holds the
+// new version, then drops the old versions from the stored versions of the CRDs.
+func RunMigrateCommand(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("migrate-storage", flag.ContinueOnError)
+	kubeconfig := flags.String("kubeconfig", os.Getenv("KUBECONFIG"), "kubeconfig file, in-cluster config if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var config *rest.Config
+	var err error
+	if *kubeconfig != "" {
+		config, err = clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	} else {
+		config, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return err
+	}
+	clientset, err := apiextensionsclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	crds, err := clientset.ApiextensionsV1beta1().CustomResourceDefinitions().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		version := storageVersion(crd)
+		if !versionedGroups[crd.Spec.Group] || version == "" || !hasVersion(crd, V1beta1) || (len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == version) {
+			continue
+		}
+		count, err := MigrateStorage(clientset.ApiextensionsV1beta1().RESTClient(), crd.Spec.Group, version, crd.Spec.Names.Plural)
+		if err != nil {
+			return fmt.Errorf("migrating %s: %v", crd.Name, err)
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest, err := clientset.ApiextensionsV1beta1().CustomResourceDefinitions().Get(crd.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			latest.Status.StoredVersions = []string{version}
+			_, err = clientset.ApiextensionsV1beta1().CustomResourceDefinitions().UpdateStatus(latest)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("updating the stored versions of %s: %v", crd.Name, err)
+		}
+		fmt.Fprintf(out, "%s: rewrote %d objects in %s\n", crd.Name, count, version)
+	}
+	return nil
+}
+
+// MigrateStorage rewrites every object of a resource unchanged, the apiserver stores
+// it in the current storage version. It returns the number of objects written.
+func MigrateStorage(client rest.Interface, group, version, plural string) (int, error) {
+	raw, err := client.Get().AbsPath("/apis", group, version, plural).DoRaw()
+	if err != nil {
+		return 0, err
+	}
+	list := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return 0, err
+	}
+
+	for _, item := range list.Items {
+		objectmeta := struct {
+			Metadata metav1.ObjectMeta `json:"metadata"`
+		}{}
+		if err := json.Unmarshal(item, &objectmeta); err != nil {
+			return 0, err
+		}
+		path := []string{"/apis", group, version, plural, objectmeta.Metadata.Name}
+		if objectmeta.Metadata.Namespace != "" {
+			path = []string{"/apis", group, version, "namespaces", objectmeta.Metadata.Namespace, plural, objectmeta.Metadata.Name}
+		}
+
+		body := []byte(item)
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			_, err := client.Put().AbsPath(path...).Body(body).DoRaw()
+			if apierrors.IsConflict(err) {
+				// refetch to write the latest resource version
+				if latest, getErr := client.Get().AbsPath(path...).DoRaw(); getErr == nil {
+					body = latest
+				}
+			}
+			return err
+		})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("%s/%s: %v", objectmeta.Metadata.Namespace, objectmeta.Metadata.Name, err)
+		}
+	}
+	return len(list.Items), nil
+}
+
+func storageVersion(crd *apiextensionsv1beta1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+	return ""
+}
+
+func hasVersion(crd *apiextensionsv1beta1.CustomResourceDefinition, name string) bool {
+	for _, version := range crd.Spec.Versions {
+		if version.Name == name && version.Served {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// gen-ociclient generates the instrumented wrappers for the OCI client
+// interfaces declared in pkg/controller/oci/resources/common/ociclient.go.
+// Every wrapped method routes the call through the registered OciCallInterceptor
+// chain so cross cutting concerns don't need to be repeated in each adapter.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+var (
+	input  = flag.String("input", "pkg/controller/oci/resources/common/ociclient.go", "file declaring the client interfaces")
+	output = flag.String("output", "pkg/controller/oci/resources/common/zz_generated.ociclient.go", "generated file")
+	header = flag.String("go-header-file", "hack/custom-boilerplate.go.txt", "license header")
+)
+
+const interfaceSuffix = "ClientInterface"
+
+type method struct {
+	name     string
+	request  string
+	response string
+}
+
+func main() {
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, *input, nil, 0)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", *input, err)
+	}
+
+	boilerplate, err := ioutil.ReadFile(*header)
+	if err != nil {
+		log.Fatalf("reading %s: %v", *header, err)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(boilerplate)
+	fmt.Fprintf(&buf, "\n// Code generated by hack/gen-ociclient. DO NOT EDIT.\n\npackage %s\n\nimport (\n", file.Name.Name)
+	for _, imp := range file.Imports {
+		if imp.Name != nil {
+			fmt.Fprintf(&buf, "\t%s %s\n", imp.Name.Name, imp.Path.Value)
+		} else {
+			fmt.Fprintf(&buf, "\t%s\n", imp.Path.Value)
+		}
+	}
+	buf.WriteString(")\n")
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			iface, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !strings.HasSuffix(ts.Name.Name, interfaceSuffix) {
+				continue
+			}
+			writeWrapper(&buf, ts.Name.Name, methods(fset, iface))
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated code: %v", err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("writing %s: %v", *output, err)
+	}
+}
+
+func methods(fset *token.FileSet, iface *ast.InterfaceType) []method {
+	var result []method
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			continue
+		}
+		if len(fn.Params.List) != 2 || fn.Results == nil || len(fn.Results.List) != 2 {
+			log.Fatalf("method %s does not match the (ctx, request) (response, err) signature", field.Names[0].Name)
+		}
+		result = append(result, method{
+			name:     field.Names[0].Name,
+			request:  expr(fset, fn.Params.List[1].Type),
+			response: expr(fset, fn.Results.List[0].Type),
+		})
+	}
+	return result
+}
+
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, e)
+	return buf.String()
+}
+
+func writeWrapper(buf *bytes.Buffer, ifaceName string, methods []method) {
+	name := strings.TrimSuffix(ifaceName, interfaceSuffix)
+	service := strings.ToLower(name)
+	wrapper := "instrumented" + name + "Client"
+
+	fmt.Fprintf(buf, "\n// Instrument%[1]sClient wraps a %[2]s so every call goes through the OCI call interceptors\n", name, ifaceName)
+	fmt.Fprintf(buf, "func Instrument%sClient(client %s) %s {\n\treturn &%s{client: client}\n}\n", name, ifaceName, ifaceName, wrapper)
+	fmt.Fprintf(buf, "\ntype %s struct {\n\tclient %s\n}\n", wrapper, ifaceName)
+
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\n// %s calls %s through the OCI call interceptors\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (c *%s) %s(ctx context.Context, request %s) (%s, error) {\n", wrapper, m.name, m.request, m.response)
+		fmt.Fprintf(buf, "\tcall := OciCall{Service: %q, Operation: %q, Request: request}\n", service, m.name)
+		fmt.Fprintf(buf, "\tr, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {\n\t\treturn c.client.%s(ctx, request)\n\t})\n", m.name)
+		fmt.Fprintf(buf, "\tresponse, _ := r.(%s)\n\treturn response, err\n}\n", m.response)
+	}
+}
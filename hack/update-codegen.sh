@@ -10,12 +10,12 @@ CODEGEN_PKG=${CODEGEN_PKG:-$(cd ${SCRIPT_ROOT}; ls -d -1 ./vendor/k8s.io/code-ge
 # oci
 ${SCRIPT_ROOT}/hack/generate-groups.sh deepcopy \
   github.com/oracle/oci-manager/pkg/client github.com/oracle/oci-manager/pkg/apis \
-  "ocicommon.oracle.com:v1alpha1"\
+  "ocicommon.oracle.com:v1alpha1,v1beta1"\
   --go-header-file ${SCRIPT_ROOT}/hack/custom-boilerplate.go.txt
 
 ${SCRIPT_ROOT}/hack/generate-groups.sh all \
   github.com/oracle/oci-manager/pkg/client github.com/oracle/oci-manager/pkg/apis \
-  "ocidb.oracle.com:v1alpha1 ocicore.oracle.com:v1alpha1 ocilb.oracle.com:v1alpha1 ocice.oracle.com:v1alpha1 ociidentity.oracle.com:v1alpha1 cloud.k8s.io:v1alpha1"\
+  "ocidb.oracle.com:v1alpha1,v1beta1 ocicore.oracle.com:v1alpha1,v1beta1 ocilb.oracle.com:v1alpha1,v1beta1 ocice.oracle.com:v1alpha1,v1beta1 ociidentity.oracle.com:v1alpha1,v1beta1 cloud.k8s.io:v1alpha1"\
   --go-header-file ${SCRIPT_ROOT}/hack/custom-boilerplate.go.txt
 
 # cloud
@@ -24,5 +24,11 @@ ${SCRIPT_ROOT}/hack/generate-groups.sh all \
 #  "cloud.k8s.io:v1alpha1"\
 #  --go-header-file ${SCRIPT_ROOT}/hack/custom-boilerplate.go.txt
 
+echo "generating oci client wrappers..."
+go run ${SCRIPT_ROOT}/hack/gen-ociclient/main.go \
+  --input ${SCRIPT_ROOT}/pkg/controller/oci/resources/common/ociclient.go \
+  --output ${SCRIPT_ROOT}/pkg/controller/oci/resources/common/zz_generated.ociclient.go \
+  --go-header-file ${SCRIPT_ROOT}/hack/custom-boilerplate.go.txt
+
 echo "go fmt..."
 go fmt github.com/oracle/oci-manager/pkg/client/... github.com/oracle/oci-manager/pkg/apis/...
@@ -30,13 +30,12 @@ const (
 )
 
 // ClusterValidation describes the Cluster validation schema
-var ClusterValidation = apiextv1beta1.CustomResourceValidation{
+var ClusterValidation = common.StructuralValidation(&Cluster{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 		},
 	},
-}
+})
 
 // +genclient
 // +genclient:noStatus
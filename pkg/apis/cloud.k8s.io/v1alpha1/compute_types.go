@@ -30,10 +30,9 @@ const (
 )
 
 // ComputeValidation describes the compute validation schema
-var ComputeValidation = apiextv1beta1.CustomResourceValidation{
+var ComputeValidation = common.StructuralValidation(&Compute{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"network"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
@@ -51,7 +50,7 @@ var ComputeValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
 // +genclient:noStatus
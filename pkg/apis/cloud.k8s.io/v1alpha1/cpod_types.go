@@ -16,7 +16,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,6 +28,9 @@ const (
 	CpodControllerName = "cpods"
 )
 
+// CpodValidation describes the cpod validation schema
+var CpodValidation = common.StructuralValidation(&Cpod{}, apiextv1beta1.CustomResourceValidation{})
+
 // +genclient
 // +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -33,10 +33,9 @@ var minTCPPort = float64(1)
 var maxTCPPort = float64(65535)
 
 // LoadBalancerValidation describes the loadbalancer validation schema
-var LoadBalancerValidation = apiextv1beta1.CustomResourceValidation{
+var LoadBalancerValidation = common.StructuralValidation(&LoadBalancer{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"listeners", "backendPort", "computeSelector", "securitySelector"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
@@ -56,7 +55,7 @@ var LoadBalancerValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
 // +genclient:noStatus
@@ -29,10 +29,9 @@ const (
 )
 
 // NetworkValidation describes the network validation schema
-var NetworkValidation = apiextv1beta1.CustomResourceValidation{
+var NetworkValidation = common.StructuralValidation(&Network{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
 					"cirdBlock": {
@@ -43,7 +42,7 @@ var NetworkValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
 // +genclient:noStatus
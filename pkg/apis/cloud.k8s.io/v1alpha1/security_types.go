@@ -29,13 +29,12 @@ const (
 )
 
 // SecurityValidation describes the security validation schema
-var SecurityValidation = apiextv1beta1.CustomResourceValidation{
+var SecurityValidation = common.StructuralValidation(&Security{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 		},
 	},
-}
+})
 
 // +genclient
 // +genclient:noStatus
@@ -36,6 +36,9 @@ const (
 
 type OperatorConditionType string
 
+// OperatorConditionPaused is set while the controller skips the cloud object because it or its namespace is paused
+const OperatorConditionPaused OperatorConditionType = "Paused"
+
 type OperatorCondition struct {
 	Type               OperatorConditionType `json:"type"`
 	Reason             string                `json:"reason,omitempty"`
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // ClusterValidation describes the cluster validation schema
-var ClusterValidation = apiextv1beta1.CustomResourceValidation{
+var ClusterValidation = common.StructuralValidation(&Cluster{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "vcnRef", "serviceLbSubnetRefs", "kubernetesVersion"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var ClusterValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Cluster describes a cluster
@@ -83,6 +83,7 @@ type ClusterSpec struct {
 	Options           *ocice.ClusterCreateOptions `mandatory:"false" json:"options"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // ClusterStatus describes a cluster status
@@ -145,24 +146,37 @@ func (s *Cluster) SetResource(r *ocice.Cluster) *Cluster {
 	return s
 }
 
+// SetResourceID points the cluster status at an existing OCI resource
+func (s *Cluster) SetResourceID(id string) {
+	s.SetResource(&ocice.Cluster{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the cluster spec
+func (s *Cluster) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the cluster resource in oci
+func (s *Cluster) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.Cluster != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *Cluster) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a cluster dependent
-func (s *Cluster) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a cluster dependent
-func (s *Cluster) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the cluster
+func (s *Cluster) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the cluster dependent is registered
-func (s *Cluster) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the cluster
+func (s *Cluster) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the cluster spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // NodePoolValidation describes the nodePool validation schema
-var NodePoolValidation = apiextv1beta1.CustomResourceValidation{
+var NodePoolValidation = common.StructuralValidation(&NodePool{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "clusterRef", "subnetRefs", "kubernetesVersion"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var NodePoolValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // NodePool describes a nodePool
@@ -100,6 +100,7 @@ type NodePoolSpec struct {
 	QuantityPerSubnet *int `mandatory:"false" json:"quantityPerSubnet"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // NodePoolStatus describes a nodePool status
@@ -160,24 +161,37 @@ func (s *NodePool) SetResource(r *ocice.NodePool) *NodePool {
 	return s
 }
 
+// SetResourceID points the node pool status at an existing OCI resource
+func (s *NodePool) SetResourceID(id string) {
+	s.SetResource(&ocice.NodePool{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the node pool spec
+func (s *NodePool) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the node pool resource in oci
+func (s *NodePool) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.NodePool != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *NodePool) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a nodePool dependent
-func (s *NodePool) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a nodePool dependent
-func (s *NodePool) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the node pool
+func (s *NodePool) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the nodePool dependent is registered
-func (s *NodePool) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the node pool
+func (s *NodePool) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the nodePool spec
@@ -0,0 +1,89 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Cluster describes a kubernetes cluster of the container engine
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ClusterSpec   `json:"spec"`
+	Status            ClusterStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec describes a cluster spec
+type ClusterSpec struct {
+	CompartmentRef      string   `json:"compartmentRef"`
+	ServiceLbSubnetRefs []string `json:"serviceLbSubnetRefs"`
+	VcnRef              string   `json:"vcnRef"`
+
+	KubernetesVersion string          `json:"kubernetesVersion"`
+	Options           *ClusterOptions `json:"options,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// ClusterOptions are the optional settings of a cluster. The service load balancer
+// subnets are taken from the ServiceLbSubnetRefs of the spec.
+type ClusterOptions struct {
+	ServiceLbSubnetIds      []string                 `json:"serviceLbSubnetIds,omitempty"`
+	KubernetesNetworkConfig *KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+	AddOns                  *AddOnOptions            `json:"addOns,omitempty"`
+}
+
+// KubernetesNetworkConfig describes the pod and service networks of a cluster
+type KubernetesNetworkConfig struct {
+	PodsCidr     string `json:"podsCidr,omitempty"`
+	ServicesCidr string `json:"servicesCidr,omitempty"`
+}
+
+// AddOnOptions enables the add-ons of a cluster
+type AddOnOptions struct {
+	IsKubernetesDashboardEnabled *bool `json:"isKubernetesDashboardEnabled,omitempty"`
+	IsTillerEnabled              *bool `json:"isTillerEnabled,omitempty"`
+}
+
+// WorkRequest is the last container engine work request of an object
+type WorkRequest struct {
+	WorkRequestId     *string `json:"workRequestId,omitempty"`
+	WorkRequestStatus string  `json:"workRequestStatus,omitempty"`
+}
+
+// ClusterStatus describes a cluster status
+type ClusterStatus struct {
+	commonv1beta1.ResourceStatus
+	KubeConfig  *string `json:"kubeConfig,omitempty"`
+	WorkRequest `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterList is a list of Cluster items
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Cluster `json:"items"`
+}
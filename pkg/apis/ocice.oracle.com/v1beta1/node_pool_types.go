@@ -0,0 +1,72 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodePool describes a node pool of a cluster
+type NodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              NodePoolSpec   `json:"spec"`
+	Status            NodePoolStatus `json:"status,omitempty"`
+}
+
+// NodePoolSpec describes a node pool spec
+type NodePoolSpec struct {
+	CompartmentRef string   `json:"compartmentRef"`
+	ClusterRef     string   `json:"clusterRef"`
+	SubnetRefs     []string `json:"subnetRefs"`
+
+	KubernetesVersion string     `json:"kubernetesVersion"`
+	NodeImageName     string     `json:"nodeImageName"`
+	NodeShape         string     `json:"nodeShape"`
+	InitialNodeLabels []KeyValue `json:"initialNodeLabels,omitempty"`
+	SshPublicKey      string     `json:"sshPublicKey,omitempty"`
+	QuantityPerSubnet *int       `json:"quantityPerSubnet,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// KeyValue is a label of the nodes
+type KeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NodePoolStatus describes a node pool status
+type NodePoolStatus struct {
+	commonv1beta1.ResourceStatus
+	WorkRequest `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodePoolList is a list of NodePool items
+type NodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []NodePool `json:"items"`
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Validation types of the generated schemas, next to the ones of the hand-written validations
+const (
+	ValidationTypeNumber = "number"
+	ValidationTypeObject = "object"
+)
+
+// knownSchemas are the types with their own json encoding
+var knownSchemas = map[reflect.Type]apiextv1beta1.JSONSchemaProps{
+	reflect.TypeOf(time.Time{}):            {Type: ValidationTypeString, Format: "date-time"},
+	reflect.TypeOf(metav1.Time{}):          {Format: "date-time"}, // the zero time is null
+	reflect.TypeOf(ocisdkcommon.SDKTime{}): {Type: ValidationTypeString, Format: "date-time"},
+	reflect.TypeOf(ocisdkcommon.SDKDate{}): {Type: ValidationTypeString, Format: "date"},
+	reflect.TypeOf(metav1.ObjectMeta{}):    {Type: ValidationTypeObject},
+	reflect.TypeOf(metav1.ListMeta{}):      {Type: ValidationTypeObject},
+	reflect.TypeOf(json.RawMessage{}):      {},
+}
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// StructuralValidation returns the schema of a CRD generated from the go type of its
+// objects, with the constraints of the hand-written validation (patterns, enums, required
+// fields) merged in. Every field is described with the type of its json encoding, only
+// interfaces, types with a custom encoding and fields which may be null are left untyped.
+// The apiserver refuses null for a typed field and the vendored schema has no nullable.
+//
+// The root of the schema has no type, the apiserver allows nothing but properties at
+// the root of a CRD with the status subresource.
+func StructuralValidation(obj interface{}, validation apiextv1beta1.CustomResourceValidation) apiextv1beta1.CustomResourceValidation {
+	generated := schemaOf(reflect.TypeOf(obj), map[reflect.Type]bool{})
+	if validation.OpenAPIV3Schema != nil {
+		generated = mergeSchema(generated, *validation.OpenAPIV3Schema)
+	}
+	generated.Type = ""
+	return apiextv1beta1.CustomResourceValidation{OpenAPIV3Schema: &generated}
+}
+
+// schemaOf describes the json encoding of a type, seen holds the structs being
+// described to stop at recursive types
+func schemaOf(t reflect.Type, seen map[reflect.Type]bool) apiextv1beta1.JSONSchemaProps {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if known, ok := knownSchemas[t]; ok {
+		return known
+	}
+	if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+		return apiextv1beta1.JSONSchemaProps{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return apiextv1beta1.JSONSchemaProps{Type: ValidationTypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return apiextv1beta1.JSONSchemaProps{Type: ValidationTypeInteger}
+	case reflect.Float32, reflect.Float64:
+		return apiextv1beta1.JSONSchemaProps{Type: ValidationTypeNumber}
+	case reflect.String:
+		return apiextv1beta1.JSONSchemaProps{Type: ValidationTypeString}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return apiextv1beta1.JSONSchemaProps{Type: ValidationTypeString, Format: "byte"}
+		}
+		items := nullableSchemaOf(t.Elem(), false, seen)
+		return apiextv1beta1.JSONSchemaProps{
+			Type:  ValidationTypeArray,
+			Items: &apiextv1beta1.JSONSchemaPropsOrArray{Schema: &items},
+		}
+	case reflect.Map:
+		props := apiextv1beta1.JSONSchemaProps{Type: ValidationTypeObject}
+		if values := nullableSchemaOf(t.Elem(), false, seen); !reflect.DeepEqual(values, apiextv1beta1.JSONSchemaProps{}) {
+			props.AdditionalProperties = &apiextv1beta1.JSONSchemaPropsOrBool{Allows: true, Schema: &values}
+		}
+		return props
+	case reflect.Struct:
+		props := apiextv1beta1.JSONSchemaProps{Type: ValidationTypeObject}
+		if seen[t] {
+			return props
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		props.Properties = map[string]apiextv1beta1.JSONSchemaProps{}
+		addFields(props.Properties, t, seen)
+		return props
+	}
+	// interfaces hold any value
+	return apiextv1beta1.JSONSchemaProps{}
+}
+
+// addFields adds the json encoded fields of a struct, the fields of embedded structs
+// are inlined the way encoding/json does
+func addFields(properties map[string]apiextv1beta1.JSONSchemaProps, t reflect.Type, seen map[reflect.Type]bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		options := ""
+		if comma := strings.Index(tag, ","); comma >= 0 {
+			name, options = tag[:comma], tag[comma:]
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if name == "" && field.Anonymous && fieldType.Kind() == reflect.Struct {
+			if _, known := knownSchemas[fieldType]; !known && !seen[fieldType] {
+				seen[fieldType] = true
+				addFields(properties, fieldType, seen)
+				delete(seen, fieldType)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.Contains(options, ",string") {
+			properties[name] = apiextv1beta1.JSONSchemaProps{Type: ValidationTypeString}
+			continue
+		}
+		properties[name] = nullableSchemaOf(field.Type, strings.Contains(options, ",omitempty"), seen)
+	}
+}
+
+// nullableSchemaOf describes a value which is encoded as null if it is nil and not omitted
+func nullableSchemaOf(t reflect.Type, omitempty bool, seen map[reflect.Type]bool) apiextv1beta1.JSONSchemaProps {
+	props := schemaOf(t, seen)
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		if !omitempty {
+			props.Type = ""
+		}
+	}
+	return props
+}
+
+// mergeSchema lays the hand-written constraints over the generated schema, the
+// hand-written ones win where both describe a field
+func mergeSchema(generated, written apiextv1beta1.JSONSchemaProps) apiextv1beta1.JSONSchemaProps {
+	merged := written
+	if merged.Type == "" {
+		merged.Type = generated.Type
+	}
+	if merged.Format == "" {
+		merged.Format = generated.Format
+	}
+
+	if len(generated.Properties) > 0 {
+		merged.Properties = make(map[string]apiextv1beta1.JSONSchemaProps, len(generated.Properties))
+		for name, props := range generated.Properties {
+			merged.Properties[name] = props
+		}
+		for name, props := range written.Properties {
+			if g, ok := generated.Properties[name]; ok {
+				props = mergeSchema(g, props)
+			}
+			merged.Properties[name] = props
+		}
+	}
+
+	if merged.Items == nil {
+		merged.Items = generated.Items
+	} else if merged.Items.Schema != nil && generated.Items != nil && generated.Items.Schema != nil {
+		items := mergeSchema(*generated.Items.Schema, *merged.Items.Schema)
+		merged.Items = &apiextv1beta1.JSONSchemaPropsOrArray{Schema: &items}
+	}
+
+	// properties and additionalProperties exclude each other, the hand-written properties
+	// of a map are kept
+	if merged.AdditionalProperties == nil && len(merged.Properties) == 0 {
+		merged.AdditionalProperties = generated.AdditionalProperties
+	}
+	return merged
+}
@@ -20,17 +20,106 @@ import (
 	"github.com/golang/glog"
 	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
-	"k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/tools/cache"
 )
 
 // ResourceStatus is a generic struct to store status information about any OCI resource
 type ResourceStatus struct {
-	State        ResourceState       `json:"state,omitempty"`
-	ResetCounter int                 `json:"resetcounter,omitempty"`
-	Message      string              `json:"message,omitempty"`
-	Dependents   map[string][]string `json:"dependents,omitempty"`
+	State              ResourceState       `json:"state,omitempty"`
+	ResetCounter       int                 `json:"resetcounter,omitempty"`
+	Message            string              `json:"message,omitempty"`
+	ObservedGeneration int64               `json:"observedGeneration,omitempty"`
+	Conditions         []ResourceCondition `json:"conditions,omitempty"`
+	Drift              []FieldDrift        `json:"drift,omitempty"`
+	Plan               *ResourcePlan       `json:"plan,omitempty"`
+	WorkRequest        *WorkRequestStatus  `json:"workRequest,omitempty"`
+}
+
+// WorkRequestStatus is the progress of the OCI work request of a pending create, update or delete
+type WorkRequestStatus struct {
+	Id        string `json:"id"`
+	Service   string `json:"service"`
+	Operation string `json:"operation,omitempty"`
+	// Status is the work request state as reported by OCI, e.g. ACCEPTED, IN_PROGRESS or SUCCEEDED
+	Status   string       `json:"status"`
+	Message  string       `json:"message,omitempty"`
+	Errors   []string     `json:"errors,omitempty"`
+	Accepted *metav1.Time `json:"accepted,omitempty"`
+}
+
+// Work request states common to the OCI services
+const (
+	WorkRequestSucceeded = "SUCCEEDED"
+	WorkRequestFailed    = "FAILED"
+	WorkRequestCanceled  = "CANCELED"
+)
+
+// Done reports if the work request reached a final state
+func (w *WorkRequestStatus) Done() bool {
+	return w.Status == WorkRequestSucceeded || w.Failed()
+}
+
+// Failed reports if the work request ended without success
+func (w *WorkRequestStatus) Failed() bool {
+	return w.Status == WorkRequestFailed || w.Status == WorkRequestCanceled
+}
+
+// ResourcePlan is the mutating OCI call the controller held back in dry-run mode
+type ResourcePlan struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	// Request is the JSON encoded request payload
+	Request string `json:"request,omitempty"`
+}
+
+// FieldDrift describes a field of the OCI resource that differs from the spec
+type FieldDrift struct {
+	Field   string `json:"field"`
+	Desired string `json:"desired"`
+	Actual  string `json:"actual"`
+}
+
+// ConditionType is the type of a resource condition
+type ConditionType string
+
+const (
+	// ConditionReady indicates the OCI resource exists and is available
+	ConditionReady ConditionType = "Ready"
+	// ConditionSynced indicates the last reconcile with OCI succeeded
+	ConditionSynced ConditionType = "Synced"
+	// ConditionDependenciesReady indicates all the objects this object depends on are ready
+	ConditionDependenciesReady ConditionType = "DependenciesReady"
+	// ConditionDeleting indicates the object is being deleted
+	ConditionDeleting ConditionType = "Deleting"
+	// ConditionDrifted indicates the OCI resource differs from the spec and the drift is only reported
+	ConditionDrifted ConditionType = "Drifted"
+	// ConditionPaused indicates the controller skips create, update and delete of the OCI resource
+	ConditionPaused ConditionType = "Paused"
+	// ConditionStalled indicates the controller gave up retrying the object, the message holds the last error
+	ConditionStalled ConditionType = "Stalled"
+	// ConditionCredentialsReady indicates the OCI credentials selected by the namespace are usable
+	ConditionCredentialsReady ConditionType = "CredentialsReady"
+)
+
+// ConditionStatus is the status of a condition
+type ConditionStatus string
+
+// Condition status values
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// ResourceCondition describes the state of a resource at a certain point
+type ResourceCondition struct {
+	Type               ConditionType   `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	Reason             string          `json:"reason,omitempty"`
+	Message            string          `json:"message,omitempty"`
+	LastTransitionTime metav1.Time     `json:"lastTransitionTime,omitempty"`
+	ObservedGeneration int64           `json:"observedGeneration,omitempty"`
 }
 
 // ResourceState stores state for any OCI resource
@@ -58,6 +147,7 @@ const (
 	Ipv4ValidationRegex               = "^$|^(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[0-9]{1,2})(\\.(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[0-9]{1,2})){3}$"
 	LoadBalancerProtocolRegex         = "^HTTP$|^HTTP2$|^TCP$"
 	NoOrAnyStringValidationRegex      = "^$|.+"
+	OcidValidationRegex               = "^ocid1\\.[a-z0-9]+\\.[a-z0-9\\-]+\\.[a-z0-9\\-]*\\..+$"
 
 	ValidationTypeArray   = "array"
 	ValidationTypeBoolean = "boolean"
@@ -65,30 +155,221 @@ const (
 	ValidationTypeString  = "string"
 )
 
-// MetaDataValidation is variable used to construct the schema validation property for the CRDs
-var MetaDataValidation = apiextv1beta1.JSONSchemaProps{
-	Required: []string{"name"},
-	Properties: map[string]apiextv1beta1.JSONSchemaProps{
-		"name": {
-			Type:    ValidationTypeString,
-			Pattern: HostnameValidationRegex,
-		},
-		"namespace": {
-			Type:    ValidationTypeString,
-			Pattern: HostnameValidationRegex,
-		},
+// Printer columns kubectl get shows for the CRDs, StateColumn and AgeColumn are added to the
+// columns of every kind by PrinterColumns
+var (
+	StateColumn = apiextv1beta1.CustomResourceColumnDefinition{
+		Name:        "State",
+		Type:        ValidationTypeString,
+		Description: "State of the reconcile",
+		JSONPath:    ".status.state",
+	}
+	LifecycleColumn = apiextv1beta1.CustomResourceColumnDefinition{
+		Name:        "Lifecycle",
+		Type:        ValidationTypeString,
+		Description: "Lifecycle state of the OCI resource",
+		JSONPath:    ".status.resource.lifecycleState",
+	}
+	OcidColumn = apiextv1beta1.CustomResourceColumnDefinition{
+		Name:        "OCID",
+		Type:        ValidationTypeString,
+		Description: "OCID of the OCI resource",
+		Priority:    1,
+		JSONPath:    ".status.resource.id",
+	}
+	AvailabilityDomainColumn = apiextv1beta1.CustomResourceColumnDefinition{
+		Name:        "AD",
+		Type:        ValidationTypeString,
+		Description: "Availability domain of the OCI resource",
+		JSONPath:    ".status.resource.availabilityDomain",
+	}
+	AgeColumn = apiextv1beta1.CustomResourceColumnDefinition{
+		Name:     "Age",
+		Type:     "date",
+		JSONPath: ".metadata.creationTimestamp",
+	}
+)
+
+// IPColumn is the column of the IP address at the json path
+func IPColumn(jsonPath string) apiextv1beta1.CustomResourceColumnDefinition {
+	return apiextv1beta1.CustomResourceColumnDefinition{
+		Name:        "IP",
+		Type:        ValidationTypeString,
+		Description: "IP address of the OCI resource",
+		JSONPath:    jsonPath,
+	}
+}
+
+// PrinterColumns returns the columns of a kind between the state and the age, the age is
+// only shown by kubectl if it is listed with the other columns
+func PrinterColumns(columns ...apiextv1beta1.CustomResourceColumnDefinition) []apiextv1beta1.CustomResourceColumnDefinition {
+	result := []apiextv1beta1.CustomResourceColumnDefinition{StateColumn}
+	result = append(result, columns...)
+	return append(result, AgeColumn)
+}
+
+// DeletionPolicyValidation is the schema validation property for the deletion policy of the CRDs
+var DeletionPolicyValidation = apiextv1beta1.JSONSchemaProps{
+	Type: ValidationTypeString,
+	Enum: []apiextv1beta1.JSON{
+		{Raw: []byte(`"` + DeletionPolicyDelete + `"`)},
+		{Raw: []byte(`"` + DeletionPolicyOrphan + `"`)},
 	},
 }
 
+// DriftPolicyValidation is the schema validation property for the drift policy of the CRDs
+var DriftPolicyValidation = apiextv1beta1.JSONSchemaProps{
+	Type: ValidationTypeString,
+	Enum: []apiextv1beta1.JSON{
+		{Raw: []byte(`"` + DriftPolicyEnforce + `"`)},
+		{Raw: []byte(`"` + DriftPolicyReport + `"`)},
+		{Raw: []byte(`"` + DriftPolicyIgnore + `"`)},
+	},
+}
+
+// ImportOcidValidation is the schema validation property for the import OCID of the CRDs
+var ImportOcidValidation = apiextv1beta1.JSONSchemaProps{
+	Type:    ValidationTypeString,
+	Pattern: OcidValidationRegex,
+}
+
 // Dependency is an array of explicit DependsOn relations between objects
 type Dependency struct {
 	DependsOn map[string]DependsOn `json:"dependson,omitempty"`
 }
 
-// DependsOn is user-defined explicit relationship between objects using selectors
+// ResourceTags are the user tags of an OCI resource. The controller adds its
+// ownership tags to the freeform tags.
+// +k8s:deepcopy-gen=false
+type ResourceTags struct {
+	// Free-form tags for this resource. Each tag is a simple key-value pair with no predefined name, type, or namespace.
+	// Example: `{"Department": "Finance"}`
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+	// Defined tags for this resource. Each key is predefined and scoped to a namespace.
+	// Example: `{"Operations": {"CostCenter": "42"}}`
+	DefinedTags map[string]map[string]interface{} `json:"definedTags,omitempty"`
+}
+
+// DeepCopyInto copies the tags, deepcopy-gen cannot copy the interface values of the defined
+// tags. They are json scalars and copied as is.
+func (in *ResourceTags) DeepCopyInto(out *ResourceTags) {
+	*out = *in
+	if in.FreeformTags != nil {
+		out.FreeformTags = make(map[string]string, len(in.FreeformTags))
+		for key, val := range in.FreeformTags {
+			out.FreeformTags[key] = val
+		}
+	}
+	if in.DefinedTags != nil {
+		out.DefinedTags = make(map[string]map[string]interface{}, len(in.DefinedTags))
+		for namespace, tags := range in.DefinedTags {
+			if tags == nil {
+				out.DefinedTags[namespace] = nil
+				continue
+			}
+			out.DefinedTags[namespace] = make(map[string]interface{}, len(tags))
+			for key, val := range tags {
+				out.DefinedTags[namespace][key] = val
+			}
+		}
+	}
+}
+
+// DeepCopy copies the tags into a new ResourceTags
+func (in *ResourceTags) DeepCopy() *ResourceTags {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DependsOn is user-defined explicit relationship between objects using selectors.
+// An object depends on all objects matching the labels, the set-based label
+// expressions and the fields. Fields are dotted paths into the object such as
+// metadata.name, metadata.namespace or status.state.
 type DependsOn struct {
-	LabelSelector map[string]string `json:"labelselector,omitempty"`
-	FieldSelector map[string]string `json:"fieldselector,omitempty"`
+	LabelSelector    map[string]string                 `json:"labelselector,omitempty"`
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchexpressions,omitempty"`
+	FieldSelector    map[string]string                 `json:"fieldselector,omitempty"`
+}
+
+// DeletionPolicy controls what happens to the OCI resource when its object is deleted
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete removes the OCI resource together with the object, this is the default
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the OCI resource in place and only removes the object
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+
+	// DeletionPolicyAnnotation overrides the deletion policy of the spec
+	DeletionPolicyAnnotation = "oci.oracle.com/deletion-policy"
+	// ImportOcidAnnotation overrides the OCID of the spec to adopt instead of creating a new resource
+	ImportOcidAnnotation = "oci.oracle.com/import-ocid"
+)
+
+// DriftPolicy controls how the controller reacts when the OCI resource differs from the spec
+type DriftPolicy string
+
+const (
+	// DriftPolicyEnforce updates the OCI resource back to the spec, this is the default
+	DriftPolicyEnforce DriftPolicy = "Enforce"
+	// DriftPolicyReport records the drifted fields in the status and an event without updating
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyIgnore neither updates the OCI resource nor reports the drift
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+
+	// DriftPolicyAnnotation overrides the drift policy of the spec
+	DriftPolicyAnnotation = "oci.oracle.com/drift-policy"
+)
+
+// PausedAnnotation set to "true" on an object or its namespace stops the controllers
+// from changing anything while the status is still reported
+const PausedAnnotation = "oci.oracle.com/paused"
+
+// IsPaused reports if the object carries the paused annotation
+func IsPaused(meta metav1.Object) bool {
+	return meta != nil && meta.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// RetryAnnotation forces an immediate retry of a stalled object whenever its value changes
+const RetryAnnotation = "oci.oracle.com/retry"
+
+// CredentialsAnnotation on a namespace names the OciCredentials its objects are managed
+// with, namespaces without it use the credentials oci-manager was started with
+const CredentialsAnnotation = "oci.oracle.com/credentials"
+
+// RegionAnnotation on an object, the Compartment its compartmentRef names or its namespace
+// selects the region of the OCI resource, the first one set wins
+const RegionAnnotation = "oci.oracle.com/region"
+
+// ResourcePolicy holds the policies the controller honours for an OCI resource
+type ResourcePolicy struct {
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// ImportOcid is an existing OCI resource to bring under management instead of creating one
+	ImportOcid  string      `json:"importOcid,omitempty"`
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// IsValid reports if the policy is Delete or Orphan
+func (p DeletionPolicy) IsValid() bool {
+	return p == DeletionPolicyDelete || p == DeletionPolicyOrphan
+}
+
+// GetDeletionPolicy returns the effective deletion policy, the annotation takes precedence over the spec.
+// An unknown annotation value is returned as is, callers must check it with IsValid.
+func (p *ResourcePolicy) GetDeletionPolicy(meta metav1.Object) DeletionPolicy {
+	if meta != nil {
+		if policy, ok := meta.GetAnnotations()[DeletionPolicyAnnotation]; ok && policy != "" {
+			return DeletionPolicy(policy)
+		}
+	}
+	if p == nil || p.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return p.DeletionPolicy
 }
 
 // HandleError updates the object with errors
@@ -101,6 +382,16 @@ func (s *ResourceStatus) HandleError(e error) error {
 				return nil
 			}
 		}
+		if planned, ok := e.(interface{ Planned() bool }); ok && planned.Planned() {
+			// held back in dry-run mode, not a failure of the resource
+			s.Message = e.Error()
+			return e
+		}
+		if temporary, ok := e.(interface{ Temporary() bool }); ok && temporary.Temporary() {
+			// throttled or otherwise transient, the resource keeps its state
+			glog.V(2).Infof("OCI temporary error: %v", e)
+			return e
+		}
 		glog.Errorf("OCI Error: %v", e)
 		s.State = ResourceStateError
 		s.Message = e.Error()
@@ -113,79 +404,47 @@ func (s *ResourceStatus) HandleError(e error) error {
 	return nil
 }
 
-// AddDependent adds a dependent to the resource status
-func (s *ResourceStatus) AddDependent(kind string, obj runtime.Object) error {
-	if obj != nil {
-
-		if s.Dependents == nil {
-			s.Dependents = make(map[string][]string)
-		} else if objRegistered, _ := s.IsDependentRegistered(kind, obj); objRegistered {
-			return nil
-		}
-		ref, err := cache.MetaNamespaceKeyFunc(obj)
-		if err != nil {
-			return err
-		}
-		if len(kind) > 0 {
-			s.Dependents[kind] = append(s.Dependents[kind], ref)
+// GetCondition returns the condition of the given type or nil if it is not set
+func (s *ResourceStatus) GetCondition(conditionType ConditionType) *ResourceCondition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == conditionType {
+			return &s.Conditions[i]
 		}
 	}
-
 	return nil
 }
 
-// IsDependentRegistered checks if a dependent exists in the resource status
-func (s *ResourceStatus) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	if obj != nil {
-		if s.Dependents == nil || len(s.Dependents) == 0 {
-			return false, nil
-		}
-
-		dependentKey, err := cache.MetaNamespaceKeyFunc(obj)
-		if err != nil {
-			return false, err
-		}
+// IsConditionTrue returns true if the condition of the given type is set to true
+func (s *ResourceStatus) IsConditionTrue(conditionType ConditionType) bool {
+	condition := s.GetCondition(conditionType)
+	return condition != nil && condition.Status == ConditionTrue
+}
 
-		if len(kind) > 0 {
-			if refs, ok := s.Dependents[kind]; ok {
-				for _, ref := range refs {
-					if ref == dependentKey {
-						return true, nil
-					}
-				}
-			}
-		}
+// SetCondition adds or updates a condition, the transition time only changes with the status
+func (s *ResourceStatus) SetCondition(conditionType ConditionType, status ConditionStatus, reason, message string, generation int64) {
+	condition := s.GetCondition(conditionType)
+	if condition == nil {
+		s.Conditions = append(s.Conditions, ResourceCondition{Type: conditionType})
+		condition = &s.Conditions[len(s.Conditions)-1]
 	}
-
-	return false, nil
+	if condition.Status != status {
+		condition.LastTransitionTime = metav1.Now()
+	}
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+	condition.ObservedGeneration = generation
 }
 
-// RemoveDependent removes a dependant from the resource status
-func (s *ResourceStatus) RemoveDependent(kind string, obj runtime.Object) error {
-	if obj != nil {
-		if s.Dependents != nil {
-			dependentKey, err := cache.MetaNamespaceKeyFunc(obj)
-			if err != nil {
-				return err
-			}
-			if refs, ok := s.Dependents[kind]; ok {
-				newRefs := make([]string, 0)
-				for _, ref := range refs {
-					if ref != dependentKey {
-						newRefs := append(newRefs, ref)
-						s.Dependents[kind] = newRefs
-						return nil
-					}
-				}
-				if len(newRefs) > 0 {
-					s.Dependents[kind] = newRefs
-				} else {
-					delete(s.Dependents, kind)
-				}
-			}
+// RemoveCondition removes the condition of the given type
+func (s *ResourceStatus) RemoveCondition(conditionType ConditionType) {
+	conditions := make([]ResourceCondition, 0, len(s.Conditions))
+	for _, condition := range s.Conditions {
+		if condition.Type != conditionType {
+			conditions = append(conditions, condition)
 		}
 	}
-	return nil
+	s.Conditions = conditions
 }
 
 // GetDependsOn is getter for DependsOn
@@ -193,14 +452,52 @@ func (d *Dependency) GetDependsOn() map[string]DependsOn {
 	return d.DependsOn
 }
 
+// IsValid reports if the policy is Enforce, Report or Ignore
+func (p DriftPolicy) IsValid() bool {
+	return p == DriftPolicyEnforce || p == DriftPolicyReport || p == DriftPolicyIgnore
+}
+
+// GetDriftPolicy returns the effective drift policy, the annotation takes precedence over the spec.
+// An unknown annotation value is returned as is, callers must check it with IsValid.
+func (p *ResourcePolicy) GetDriftPolicy(meta metav1.Object) DriftPolicy {
+	if meta != nil {
+		if policy, ok := meta.GetAnnotations()[DriftPolicyAnnotation]; ok && policy != "" {
+			return DriftPolicy(policy)
+		}
+	}
+	if p == nil || p.DriftPolicy == "" {
+		return DriftPolicyEnforce
+	}
+	return p.DriftPolicy
+}
+
+// GetImportOcid returns the OCID to adopt, the annotation takes precedence over the spec
+func (p *ResourcePolicy) GetImportOcid(meta metav1.Object) string {
+	if meta != nil {
+		if ocid, ok := meta.GetAnnotations()[ImportOcidAnnotation]; ok && ocid != "" {
+			return ocid
+		}
+	}
+	if p == nil {
+		return ""
+	}
+	return p.ImportOcid
+}
+
 // ObjectInterface is an interface for resource objects that supports dependencies
 type ObjectInterface interface {
-	AddDependent(kind string, obj runtime.Object) error
-	RemoveDependent(kind string, obj runtime.Object) error
-	IsDependentRegistered(kind string, obj runtime.Object) (bool, error)
 	GetResourcePlural() string
 	GetResourceID() string
 	IsResource() bool
 	GetGroupVersionResource() schema.GroupVersionResource
 	GetResourceState() ResourceState
+	GetResourceStatus() *ResourceStatus
+	GetResourcePolicy() *ResourcePolicy
+}
+
+// ImportInterface is implemented by objects whose OCI resource can be adopted by OCID
+type ImportInterface interface {
+	SetResourceID(id string)
+	GetCompartmentRef() string
+	GetResourceCompartmentID() string
 }
@@ -20,6 +20,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Dependency) DeepCopyInto(out *Dependency) {
 	*out = *in
@@ -55,6 +59,13 @@ func (in *DependsOn) DeepCopyInto(out *DependsOn) {
 			(*out)[key] = val
 		}
 	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]v1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.FieldSelector != nil {
 		in, out := &in.FieldSelector, &out.FieldSelector
 		*out = make(map[string]string, len(*in))
@@ -75,19 +86,102 @@ func (in *DependsOn) DeepCopy() *DependsOn {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldDrift) DeepCopyInto(out *FieldDrift) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldDrift.
+func (in *FieldDrift) DeepCopy() *FieldDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCondition) DeepCopyInto(out *ResourceCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCondition.
+func (in *ResourceCondition) DeepCopy() *ResourceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePlan) DeepCopyInto(out *ResourcePlan) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlan.
+func (in *ResourcePlan) DeepCopy() *ResourcePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicy.
+func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
-	if in.Dependents != nil {
-		in, out := &in.Dependents, &out.Dependents
-		*out = make(map[string][]string, len(*in))
-		for key, val := range *in {
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				(*out)[key] = make([]string, len(val))
-				copy((*out)[key], val)
-			}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]FieldDrift, len(*in))
+		copy(*out, *in)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ResourcePlan)
+			**out = **in
+		}
+	}
+	if in.WorkRequest != nil {
+		in, out := &in.WorkRequest, &out.WorkRequest
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkRequestStatus)
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	return
@@ -102,3 +196,33 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkRequestStatus) DeepCopyInto(out *WorkRequestStatus) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Accepted != nil {
+		in, out := &in.Accepted, &out.Accepted
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Time)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkRequestStatus.
+func (in *WorkRequestStatus) DeepCopy() *WorkRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FieldRename is a field whose JSON name differs between v1alpha1 and v1beta1. The
+// paths are dotted and only differ in their last step, a * step descends into every
+// value of a map or item of a list. A rename of a parent comes before the renames of
+// its fields.
+// +k8s:deepcopy-gen=false
+type FieldRename struct {
+	V1alpha1 string
+	V1beta1  string
+}
+
+// CommonFieldRenames are the renames of the fields shared by all kinds
+var CommonFieldRenames = []FieldRename{
+	{V1alpha1: "spec.dependson", V1beta1: "spec.dependsOn"},
+	{V1alpha1: "spec.dependson.*.labelselector", V1beta1: "spec.dependsOn.*.labelSelector"},
+	{V1alpha1: "spec.dependson.*.matchexpressions", V1beta1: "spec.dependsOn.*.matchExpressions"},
+	{V1alpha1: "spec.dependson.*.fieldselector", V1beta1: "spec.dependsOn.*.fieldSelector"},
+	{V1alpha1: "status.resetcounter", V1beta1: "status.resetCounter"},
+}
+
+// Convert converts a decoded JSON object in place between the v1alpha1 and the v1beta1
+// version of its group. Besides the renames of the kind and the common renames both
+// versions share their JSON layout, so no field is lost in either direction.
+func Convert(obj map[string]interface{}, version string, renames []FieldRename) error {
+	apiVersion, _ := obj["apiVersion"].(string)
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return err
+	}
+	if gv.Version == version {
+		return nil
+	}
+
+	all := append(append([]FieldRename{}, CommonFieldRenames...), renames...)
+	switch {
+	case gv.Version == "v1alpha1" && version == SchemeGroupVersion.Version:
+		for _, rename := range all {
+			renameField(obj, rename, true)
+		}
+	case gv.Version == SchemeGroupVersion.Version && version == "v1alpha1":
+		for i := len(all) - 1; i >= 0; i-- {
+			renameField(obj, all[i], false)
+		}
+	default:
+		return fmt.Errorf("cannot convert %s to version %s", apiVersion, version)
+	}
+	obj["apiVersion"] = schema.GroupVersion{Group: gv.Group, Version: version}.String()
+	return nil
+}
+
+// renameField renames the last step of the rename. The parents are looked up by their
+// v1beta1 names since parents are renamed first on the way to v1beta1 and last on the
+// way back.
+func renameField(obj map[string]interface{}, rename FieldRename, toV1beta1 bool) {
+	path := strings.Split(rename.V1beta1, ".")
+	from := rename.V1alpha1[strings.LastIndex(rename.V1alpha1, ".")+1:]
+	to := path[len(path)-1]
+	if !toV1beta1 {
+		from, to = to, from
+	}
+	renameIn(obj, path[:len(path)-1], from, to)
+}
+
+func renameIn(node interface{}, parents []string, from, to string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if len(parents) == 0 {
+			if value, ok := n[from]; ok {
+				delete(n, from)
+				n[to] = value
+			}
+			return
+		}
+		if parents[0] != "*" {
+			renameIn(n[parents[0]], parents[1:], from, to)
+			return
+		}
+		for _, value := range n {
+			renameIn(value, parents[1:], from, to)
+		}
+	case []interface{}:
+		if len(parents) == 0 || parents[0] != "*" {
+			return
+		}
+		for _, item := range n {
+			renameIn(item, parents[1:], from, to)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ResourceStatus is the status every OCI resource object reports. The state,
+// conditions and drift keep their v1alpha1 types.
+type ResourceStatus struct {
+	State              common.ResourceState       `json:"state,omitempty"`
+	ResetCounter       int                        `json:"resetCounter,omitempty"`
+	Message            string                     `json:"message,omitempty"`
+	ObservedGeneration int64                      `json:"observedGeneration,omitempty"`
+	Conditions         []common.ResourceCondition `json:"conditions,omitempty"`
+	Drift              []common.FieldDrift        `json:"drift,omitempty"`
+	Plan               *common.ResourcePlan       `json:"plan,omitempty"`
+	WorkRequest        *common.WorkRequestStatus  `json:"workRequest,omitempty"`
+	// Resource is the last OCI resource read by the controller. Its layout follows
+	// the OCI API and is not covered by the compatibility of this version.
+	Resource *runtime.RawExtension `json:"resource,omitempty"`
+}
+
+// Dependency is an array of explicit DependsOn relations between objects
+type Dependency struct {
+	DependsOn map[string]DependsOn `json:"dependsOn,omitempty"`
+}
+
+// DependsOn is user-defined explicit relationship between objects using selectors,
+// see the v1alpha1 DependsOn for the meaning of the selectors.
+type DependsOn struct {
+	LabelSelector    map[string]string                 `json:"labelSelector,omitempty"`
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchExpressions,omitempty"`
+	FieldSelector    map[string]string                 `json:"fieldSelector,omitempty"`
+}
+
+// ResourceTags are the user tags of an OCI resource, see the v1alpha1 ResourceTags
+type ResourceTags struct {
+	FreeformTags map[string]string `json:"freeformTags,omitempty"`
+	DefinedTags  map[string]Tags   `json:"definedTags,omitempty"`
+}
+
+// Tags are the defined tags of one tag namespace
+type Tags map[string]string
+
+// DeepCopy copies the tags, deepcopy-gen only copies maps of maps through a DeepCopy of the values
+func (in Tags) DeepCopy() *Tags {
+	out := new(Tags)
+	if in != nil {
+		*out = make(Tags, len(in))
+		for key, val := range in {
+			(*out)[key] = val
+		}
+	}
+	return out
+}
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // DhcpOptionValidation describes the dhcp options validation schema
-var DhcpOptionValidation = apiextv1beta1.CustomResourceValidation{
+var DhcpOptionValidation = common.StructuralValidation(&DhcpOption{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "vcnRef"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var DhcpOptionValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DhcpOption describes a dhcp options
@@ -82,7 +82,9 @@ type DhcpOptionSpec struct {
 	DisplayName string                  `json:"displayName,omitempty"`
 	Options     []ocisdkcore.DhcpOption `json:"options"`
 
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // DhcpOptionStatus describes a dhcp options status
@@ -131,27 +133,30 @@ func (s *DhcpOption) GetGroupVersionResource() schema.GroupVersionResource {
 	return SchemeGroupVersion.WithResource(DhcpOptionResourcePlural)
 }
 
-// AddDependent adds a dhcp options dependent
-func (s *DhcpOption) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
+// SetResource sets the resource in the status of the dhcp options
+func (s *DhcpOption) SetResource(r *ocisdkcore.DhcpOptions) *DhcpOption {
+	if r != nil {
+		s.Status.Resource = &DhcpOptionResource{*r}
+	}
+	return s
 }
 
-// RemoveDependent removes a dhcp options dependent
-func (s *DhcpOption) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// SetResourceID points the dhcp option status at an existing OCI resource
+func (s *DhcpOption) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.DhcpOptions{Id: &id})
 }
 
-// IsDependentRegistered returns true if the dhcp options dependent is registered
-func (s *DhcpOption) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetCompartmentRef returns the compartment reference of the dhcp option spec
+func (s *DhcpOption) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
 }
 
-// SetResource sets the resource in the status of the dhcp options
-func (s *DhcpOption) SetResource(r *ocisdkcore.DhcpOptions) *DhcpOption {
-	if r != nil {
-		s.Status.Resource = &DhcpOptionResource{*r}
+// GetResourceCompartmentID returns the compartment id of the dhcp option resource in oci
+func (s *DhcpOption) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
 	}
-	return s
+	return ""
 }
 
 // GetResourceState returns the current state of the resource
@@ -159,6 +164,16 @@ func (s *DhcpOption) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
+// GetResourceStatus returns the common status of the dhcp option
+func (s *DhcpOption) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
+}
+
+// GetResourcePolicy returns the common policy of the dhcp option
+func (s *DhcpOption) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
+}
+
 // DeepCopy the dhcp options spec
 func (in *DhcpOptionSpec) DeepCopy() (out *DhcpOptionSpec) {
 	if in == nil {
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // InstanceValidation describes the instance validation schema
-var InstanceValidation = apiextv1beta1.CustomResourceValidation{
+var InstanceValidation = common.StructuralValidation(&Instance{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "subnetRef", "image", "shape"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -76,10 +77,9 @@ var InstanceValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Instance describes an instance
@@ -104,7 +104,9 @@ type InstanceSpec struct {
 
 	Metadata         map[string]string      `json:"metadata,omitempty"`
 	ExtendedMetadata map[string]interface{} `json:"extendedMetadata,omitempty"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // InstanceStatus describes an instance status
@@ -179,6 +181,16 @@ func (s *Instance) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
+// GetResourceStatus returns the common status of the instance
+func (s *Instance) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
+}
+
+// GetResourcePolicy returns the common policy of the instance
+func (s *Instance) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
+}
+
 // SetResource sets the resource in status of the instance
 func (s *Instance) SetResource(r *ocisdkcore.Instance) *Instance {
 	if r != nil {
@@ -187,19 +199,27 @@ func (s *Instance) SetResource(r *ocisdkcore.Instance) *Instance {
 	return s
 }
 
-// AddDependent adds an instance dependent
-func (s *Instance) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
+// SetResourceID points the instance status at an existing OCI resource
+func (s *Instance) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.Instance{Id: &id})
 }
 
-// RemoveDependent removes an instance dependent
-func (s *Instance) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetCompartmentRef returns the compartment reference of the instance spec
+func (s *Instance) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
 }
 
-// IsDependentRegistered returns true if the instance dependent is registered
-func (s *Instance) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetAvailabilityDomain returns the availability domain of the instance spec
+func (s *Instance) GetAvailabilityDomain() string {
+	return s.Spec.AvailabilityDomain
+}
+
+// GetResourceCompartmentID returns the compartment id of the instance resource in oci
+func (s *Instance) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
 }
 
 // DeepCopy the instance spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // InternetGatewayValidation describes the internet gateway validation schema
-var InternetGatewayValidation = apiextv1beta1.CustomResourceValidation{
+var InternetGatewayValidation = common.StructuralValidation(&InternetGateway{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "vcnRef", "isEnabled"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var InternetGatewayValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InternetGateway describes an internet gateway
@@ -79,7 +79,9 @@ type InternetGatewaySpec struct {
 	VcnRef         string `json:"vcnRef"`
 	DisplayName    string `json:"displayName,omitempty"`
 	IsEnabled      bool   `json:"isEnabled"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // InternetGatewayStatus describes an internet gateway status
@@ -136,24 +138,37 @@ func (s *InternetGateway) SetResource(r *ocisdkcore.InternetGateway) *InternetGa
 	return s
 }
 
+// SetResourceID points the internet gateway status at an existing OCI resource
+func (s *InternetGateway) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.InternetGateway{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the internet gateway spec
+func (s *InternetGateway) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the internet gateway resource in oci
+func (s *InternetGateway) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *InternetGateway) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds an internet gateway dependent
-func (s *InternetGateway) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes an internet gateway dependent
-func (s *InternetGateway) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the internet gateway
+func (s *InternetGateway) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the internet gateway dependent is registered
-func (s *InternetGateway) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the internet gateway
+func (s *InternetGateway) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the internet gateway oci resource
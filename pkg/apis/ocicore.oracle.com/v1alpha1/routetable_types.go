@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // RouteTableValidation describes the route table validation schema
-var RouteTableValidation = apiextv1beta1.CustomResourceValidation{
+var RouteTableValidation = common.StructuralValidation(&RouteTable{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "vcnRef", "routeRules"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var RouteTableValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // RouteTable describes a route table
@@ -79,7 +79,9 @@ type RouteTableSpec struct {
 	VcnRef         string      `json:"vcnRef"`
 	DisplayName    string      `json:"displayName,omitempty"`
 	RouteRules     []RouteRule `json:"routeRules"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // RouteTableStatus describes a route table status
@@ -143,24 +145,37 @@ func (s *RouteTable) SetResource(r *ocisdkcore.RouteTable) *RouteTable {
 	return s
 }
 
+// SetResourceID points the route table status at an existing OCI resource
+func (s *RouteTable) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.RouteTable{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the route table spec
+func (s *RouteTable) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the route table resource in oci
+func (s *RouteTable) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *RouteTable) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a route table dependent
-func (s *RouteTable) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a route table dependent
-func (s *RouteTable) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the route table
+func (s *RouteTable) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the route table dependent is registered
-func (s *RouteTable) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the route table
+func (s *RouteTable) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the route table oci resource
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -35,13 +34,15 @@ const (
 )
 
 // SecurityRuleSetValidation describes the security rule set validation schema
-var SecurityRuleSetValidation = apiextv1beta1.CustomResourceValidation{
+var SecurityRuleSetValidation = common.StructuralValidation(&SecurityRuleSet{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "vcnRef", "egressSecurityRules", "ingressSecurityRules"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -63,10 +64,9 @@ var SecurityRuleSetValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SecurityRuleSet describes a security rule set
@@ -85,7 +85,9 @@ type SecurityRuleSetSpec struct {
 
 	EgressSecurityRules  []ocisdkcore.EgressSecurityRule  `json:"egressSecurityRules"`
 	IngressSecurityRules []ocisdkcore.IngressSecurityRule `json:"ingressSecurityRules"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // SecurityRuleSetStatus describes a security rule set status
@@ -143,24 +145,37 @@ func (s *SecurityRuleSet) SetResource(r *ocisdkcore.SecurityList) *SecurityRuleS
 	return s
 }
 
+// SetResourceID points the security rule set status at an existing OCI resource
+func (s *SecurityRuleSet) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.SecurityList{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the security rule set spec
+func (s *SecurityRuleSet) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the security rule set resource in oci
+func (s *SecurityRuleSet) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *SecurityRuleSet) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a security rule set dependent
-func (s *SecurityRuleSet) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a security rule set dependent
-func (s *SecurityRuleSet) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the security rule set
+func (s *SecurityRuleSet) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the security rule set dependent is registered
-func (s *SecurityRuleSet) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the security rule set
+func (s *SecurityRuleSet) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the security rule set spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // SubnetValidation describes the subnet validation schema
-var SubnetValidation = apiextv1beta1.CustomResourceValidation{
+var SubnetValidation = common.StructuralValidation(&Subnet{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "routetableRef", "securityrulesetRefs", "vcnRef", "dnsLabel", "cidrBlock"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -75,10 +76,9 @@ var SubnetValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Subnet describes a subnet
@@ -100,7 +100,9 @@ type SubnetSpec struct {
 	DNSLabel            string   `json:"dnsLabel,omitempty"`
 	RouteTableRef       string   `json:"routetableRef,omitempty"`
 	SecurityRuleSetRefs []string `json:"securityrulesetRefs,omitempty"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // SubnetStatus describes a subnet status
@@ -166,24 +168,37 @@ func (s *Subnet) SetResource(r *ocisdkcore.Subnet) *Subnet {
 	return s
 }
 
+// SetResourceID points the subnet status at an existing OCI resource
+func (s *Subnet) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.Subnet{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the subnet spec
+func (s *Subnet) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the subnet resource in oci
+func (s *Subnet) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *Subnet) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a subnet dependent
-func (s *Subnet) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a subnet dependent
-func (s *Subnet) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the subnet
+func (s *Subnet) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the subnet dependent is registered
-func (s *Subnet) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the subnet
+func (s *Subnet) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the subnet oci resource
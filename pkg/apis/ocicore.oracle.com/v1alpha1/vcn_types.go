@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // VcnValidation describes the vcn validation schema
-var VcnValidation = apiextv1beta1.CustomResourceValidation{
+var VcnValidation = common.StructuralValidation(&Vcn{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "cidrBlock", "dnsLabel"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -64,10 +65,9 @@ var VcnValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 //Vcn describes a vcn
@@ -85,7 +85,9 @@ type VcnSpec struct {
 	DisplayName    string `json:"displayName,omitempty"`
 	DNSLabel       string `json:"dnsLabel"`
 	VcnDomainName  string `json:"vcnDomainName"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // VcnStatus describes a vcn status
@@ -151,24 +153,37 @@ func (s *Vcn) SetResource(r *ocisdkcore.Vcn) *Vcn {
 	return s
 }
 
+// SetResourceID points the vcn status at an existing OCI resource
+func (s *Vcn) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.Vcn{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the vcn spec
+func (s *Vcn) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the vcn resource in oci
+func (s *Vcn) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *Vcn) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a vcn dependent
-func (s *Vcn) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent remvoes a vcn dependent
-func (s *Vcn) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the vcn
+func (s *Vcn) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the vcn dependent is registered
-func (s *Vcn) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the vcn
+func (s *Vcn) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the vcn oci resource
@@ -24,7 +24,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -39,13 +38,15 @@ var minVolumeSizeInGBs = float64(50)
 var maxVolumeSizeInGBs = float64(16000)
 
 // VolumeValidation describes the volume validation schema
-var VolumeValidation = apiextv1beta1.CustomResourceValidation{
+var VolumeValidation = common.StructuralValidation(&Volume{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "instanceRef", "availabilityDomain", "sizeInGBs"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -75,10 +76,9 @@ var VolumeValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Volume describes a volume
@@ -98,7 +98,9 @@ type VolumeSpec struct {
 	AvailabilityDomain string `json:"availabilityDomain"`
 	SizeInGBs          int64  `json:"sizeInGBs"`
 	AttachmentType     string `json:"attachmentType,omitempty"`
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // VolumeStatus describes a volume status
@@ -216,6 +218,29 @@ func (s *Volume) SetResource(r *ocisdkcore.Volume) *Volume {
 	return s
 }
 
+// SetResourceID points the volume status at an existing OCI resource
+func (s *Volume) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.Volume{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the volume spec
+func (s *Volume) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetAvailabilityDomain returns the availability domain of the volume spec
+func (s *Volume) GetAvailabilityDomain() string {
+	return s.Spec.AvailabilityDomain
+}
+
+// GetResourceCompartmentID returns the compartment id of the volume resource in oci
+func (s *Volume) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // SetAttachment sets the volume attachment
 func (s *Volume) SetAttachment(atype string, r *ocisdkcore.VolumeAttachment) *Volume {
 	if r != nil {
@@ -231,19 +256,14 @@ func (s *Volume) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a volume dependent
-func (s *Volume) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a volume dependent
-func (s *Volume) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the volume
+func (s *Volume) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the volume dependent is registered
-func (s *Volume) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the volume
+func (s *Volume) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the volume spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -36,13 +35,15 @@ var minVolumeBackupSizeInGBs = float64(50)
 var maxVolumeBackupSizeInGBs = float64(16000)
 
 // VolumeBackupValidation describes the volume backup validation schema
-var VolumeBackupValidation = apiextv1beta1.CustomResourceValidation{
+var VolumeBackupValidation = common.StructuralValidation(&VolumeBackup{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"volumeRef", "type"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"displayName": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.HostnameValidationRegex,
@@ -59,10 +60,9 @@ var VolumeBackupValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // VolumeBackup describes a volume backup
@@ -80,7 +80,9 @@ type VolumeBackupSpec struct {
 	DisplayName      string `json:"displayName,omitempty"`
 	VolumeBackupType string `json:"type"`
 
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // VolumeBackupStatus describes a volume backup status
@@ -146,24 +148,37 @@ func (s *VolumeBackup) SetResource(r *ocisdkcore.VolumeBackup) *VolumeBackup {
 	return s
 }
 
+// SetResourceID points the volume backup status at an existing OCI resource
+func (s *VolumeBackup) SetResourceID(id string) {
+	s.SetResource(&ocisdkcore.VolumeBackup{Id: &id})
+}
+
+// GetCompartmentRef returns an empty reference since the volume backup spec has no compartment
+func (s *VolumeBackup) GetCompartmentRef() string {
+	return ""
+}
+
+// GetResourceCompartmentID returns the compartment id of the volume backup resource in oci
+func (s *VolumeBackup) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *VolumeBackup) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a volume backup dependent
-func (s *VolumeBackup) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a volume backup dependent
-func (s *VolumeBackup) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the volume backup
+func (s *VolumeBackup) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the volume backup dependent is registered
-func (s *VolumeBackup) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the volume backup
+func (s *VolumeBackup) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the volume backup spec
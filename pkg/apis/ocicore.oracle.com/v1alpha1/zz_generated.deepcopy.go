@@ -328,7 +328,9 @@ func (in *InternetGatewayResource) DeepCopyInto(out *InternetGatewayResource) {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternetGatewaySpec) DeepCopyInto(out *InternetGatewaySpec) {
 	*out = *in
+	in.ResourceTags.DeepCopyInto(&out.ResourceTags)
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
@@ -469,7 +471,9 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 		*out = make([]RouteRule, len(*in))
 		copy(*out, *in)
 	}
+	in.ResourceTags.DeepCopyInto(&out.ResourceTags)
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
@@ -690,7 +694,9 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.ResourceTags.DeepCopyInto(&out.ResourceTags)
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
@@ -803,7 +809,9 @@ func (in *VcnResource) DeepCopyInto(out *VcnResource) {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VcnSpec) DeepCopyInto(out *VcnSpec) {
 	*out = *in
+	in.ResourceTags.DeepCopyInto(&out.ResourceTags)
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
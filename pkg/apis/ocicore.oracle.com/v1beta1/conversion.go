@@ -0,0 +1,34 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	"github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+)
+
+// FieldRenames are the renames of the kinds of the group on top of the common renames
+var FieldRenames = map[string][]commonv1beta1.FieldRename{
+	v1alpha1.SubnetKind: {
+		{V1alpha1: "spec.routetableRef", V1beta1: "spec.routeTableRef"},
+		{V1alpha1: "spec.securityrulesetRefs", V1beta1: "spec.securityRuleSetRefs"},
+	},
+	v1alpha1.VolumeKind: {
+		{V1alpha1: "status.attachment.AttachmentType", V1beta1: "status.attachment.attachmentType"},
+		{V1alpha1: "status.attachment.VolumeAttachment", V1beta1: "status.attachment.resource"},
+	},
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Types of dhcp options
+const (
+	DhcpOptionTypeDomainNameServer = "DomainNameServer"
+	DhcpOptionTypeSearchDomain     = "SearchDomain"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DhcpOption describes a set of dhcp options
+type DhcpOption struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              DhcpOptionSpec   `json:"spec"`
+	Status            DhcpOptionStatus `json:"status,omitempty"`
+}
+
+// DhcpOptionSpec describes a dhcp option spec
+type DhcpOptionSpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+	VcnRef         string `json:"vcnRef"`
+
+	DisplayName string            `json:"displayName,omitempty"`
+	Options     []DhcpOptionEntry `json:"options"`
+
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// DhcpOptionEntry is one option of the set. A DomainNameServer option has a server
+// type and custom dns servers, a SearchDomain option has search domain names.
+type DhcpOptionEntry struct {
+	Type              string   `json:"type"`
+	ServerType        string   `json:"serverType,omitempty"`
+	CustomDnsServers  []string `json:"customDnsServers,omitempty"`
+	SearchDomainNames []string `json:"searchDomainNames,omitempty"`
+}
+
+// DhcpOptionStatus describes a dhcp option status
+type DhcpOptionStatus struct {
+	commonv1beta1.ResourceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DhcpOptionList is a list of DhcpOption items
+type DhcpOptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []DhcpOption `json:"items"`
+}
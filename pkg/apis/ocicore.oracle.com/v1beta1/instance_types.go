@@ -0,0 +1,71 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Instance describes a compute instance
+type Instance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              InstanceSpec   `json:"spec"`
+	Status            InstanceStatus `json:"status,omitempty"`
+}
+
+// InstanceSpec describes a compute instance spec
+type InstanceSpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+	SubnetRef      string `json:"subnetRef"`
+
+	AvailabilityDomain string `json:"availabilityDomain"`
+	DisplayName        string `json:"displayName,omitempty"`
+	HostnameLabel      string `json:"hostnameLabel,omitempty"`
+	Image              string `json:"image"`
+	IpxeScript         string `json:"ipxeScript,omitempty"`
+	Shape              string `json:"shape"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// ExtendedMetadata values are arbitrary JSON
+	ExtendedMetadata map[string]runtime.RawExtension `json:"extendedMetadata,omitempty"`
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// InstanceStatus describes a compute instance status
+type InstanceStatus struct {
+	commonv1beta1.ResourceStatus
+	PrimaryVnic *runtime.RawExtension `json:"primaryVnic,omitempty"`
+	BootVolume  *runtime.RawExtension `json:"bootVolume,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InstanceList is a list of Instance items
+type InstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Instance `json:"items"`
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InternetGateway describes an internet gateway
+type InternetGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              InternetGatewaySpec   `json:"spec"`
+	Status            InternetGatewayStatus `json:"status,omitempty"`
+}
+
+// InternetGatewaySpec describes an internet gateway spec
+type InternetGatewaySpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+	VcnRef         string `json:"vcnRef"`
+	DisplayName    string `json:"displayName,omitempty"`
+	IsEnabled      bool   `json:"isEnabled"`
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// InternetGatewayStatus describes an internet gateway status
+type InternetGatewayStatus struct {
+	commonv1beta1.ResourceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InternetGatewayList is a list of InternetGateway items
+type InternetGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []InternetGateway `json:"items"`
+}
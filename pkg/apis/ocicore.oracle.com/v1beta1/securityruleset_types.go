@@ -0,0 +1,102 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecurityRuleSet describes a security rule set
+type SecurityRuleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              SecurityRuleSetSpec   `json:"spec"`
+	Status            SecurityRuleSetStatus `json:"status,omitempty"`
+}
+
+// SecurityRuleSetSpec describes a security rule set spec
+type SecurityRuleSetSpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+	VcnRef         string `json:"vcnRef"`
+	DisplayName    string `json:"displayName,omitempty"`
+
+	EgressSecurityRules  []EgressSecurityRule  `json:"egressSecurityRules"`
+	IngressSecurityRules []IngressSecurityRule `json:"ingressSecurityRules"`
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// IngressSecurityRule allows traffic from a source. The protocol is "all" or an
+// IANA protocol number such as 6 for tcp.
+type IngressSecurityRule struct {
+	Protocol    string       `json:"protocol"`
+	Source      string       `json:"source"`
+	SourceType  string       `json:"sourceType,omitempty"`
+	IsStateless *bool        `json:"isStateless,omitempty"`
+	IcmpOptions *IcmpOptions `json:"icmpOptions,omitempty"`
+	TcpOptions  *PortOptions `json:"tcpOptions,omitempty"`
+	UdpOptions  *PortOptions `json:"udpOptions,omitempty"`
+}
+
+// EgressSecurityRule allows traffic to a destination
+type EgressSecurityRule struct {
+	Protocol        string       `json:"protocol"`
+	Destination     string       `json:"destination"`
+	DestinationType string       `json:"destinationType,omitempty"`
+	IsStateless     *bool        `json:"isStateless,omitempty"`
+	IcmpOptions     *IcmpOptions `json:"icmpOptions,omitempty"`
+	TcpOptions      *PortOptions `json:"tcpOptions,omitempty"`
+	UdpOptions      *PortOptions `json:"udpOptions,omitempty"`
+}
+
+// IcmpOptions restricts a rule to an icmp type and code
+type IcmpOptions struct {
+	Type int  `json:"type"`
+	Code *int `json:"code,omitempty"`
+}
+
+// PortOptions restricts a tcp or udp rule to port ranges
+type PortOptions struct {
+	DestinationPortRange *PortRange `json:"destinationPortRange,omitempty"`
+	SourcePortRange      *PortRange `json:"sourcePortRange,omitempty"`
+}
+
+// PortRange is an inclusive range of ports
+type PortRange struct {
+	Max int `json:"max"`
+	Min int `json:"min"`
+}
+
+// SecurityRuleSetStatus describes a security rule set status
+type SecurityRuleSetStatus struct {
+	commonv1beta1.ResourceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SecurityRuleSetList is a list of SecurityRuleSet items
+type SecurityRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []SecurityRuleSet `json:"items"`
+}
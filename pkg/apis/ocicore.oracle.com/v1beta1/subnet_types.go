@@ -0,0 +1,63 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Subnet describes a subnet
+type Subnet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              SubnetSpec   `json:"spec"`
+	Status            SubnetStatus `json:"status,omitempty"`
+}
+
+// SubnetSpec describes a subnet spec
+type SubnetSpec struct {
+	CompartmentRef      string   `json:"compartmentRef"`
+	VcnRef              string   `json:"vcnRef"`
+	AvailabilityDomain  string   `json:"availabilityDomain"`
+	CidrBlock           string   `json:"cidrBlock"`
+	DisplayName         string   `json:"displayName,omitempty"`
+	DNSLabel            string   `json:"dnsLabel,omitempty"`
+	RouteTableRef       string   `json:"routeTableRef,omitempty"`
+	SecurityRuleSetRefs []string `json:"securityRuleSetRefs,omitempty"`
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// SubnetStatus describes a subnet status
+type SubnetStatus struct {
+	commonv1beta1.ResourceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SubnetList is a list of Subnet items
+type SubnetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Subnet `json:"items"`
+}
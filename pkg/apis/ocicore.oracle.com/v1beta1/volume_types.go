@@ -0,0 +1,72 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Volume describes a block volume
+type Volume struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              VolumeSpec   `json:"spec"`
+	Status            VolumeStatus `json:"status,omitempty"`
+}
+
+// VolumeSpec describes a block volume spec
+type VolumeSpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+	InstanceRef    string `json:"instanceRef"`
+
+	DisplayName        string `json:"displayName,omitempty"`
+	AvailabilityDomain string `json:"availabilityDomain"`
+	SizeInGBs          int64  `json:"sizeInGBs"`
+	AttachmentType     string `json:"attachmentType,omitempty"`
+	commonv1beta1.ResourceTags
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// VolumeStatus describes a block volume status
+type VolumeStatus struct {
+	commonv1beta1.ResourceStatus
+	AttachmentState string            `json:"attachmentState,omitempty"`
+	Attachment      *VolumeAttachment `json:"attachment,omitempty"`
+}
+
+// VolumeAttachment is the attachment of the volume to its instance
+type VolumeAttachment struct {
+	AttachmentType string `json:"attachmentType,omitempty"`
+	// Resource is the last OCI volume attachment read by the controller
+	Resource *runtime.RawExtension `json:"resource,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeList is a list of Volume items
+type VolumeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Volume `json:"items"`
+}
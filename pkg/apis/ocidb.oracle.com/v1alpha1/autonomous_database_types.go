@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // AutonomousDatabaseValidation describes the AutonomousDatabase validation schema
-var AutonomousDatabaseValidation = apiextv1beta1.CustomResourceValidation{
+var AutonomousDatabaseValidation = common.StructuralValidation(&AutonomousDatabase{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "cpuCoreCount", "dataStorageSizeInTBs"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -54,10 +55,9 @@ var AutonomousDatabaseValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AutonomousDatabase describes a AutonomousDatabase
@@ -95,6 +95,7 @@ type AutonomousDatabaseSpec struct {
 	FreeformTags map[string]string `mandatory:"false" json:"freeformTags"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // AutonomousDatabaseStatus describes a AutonomousDatabase status
@@ -161,24 +162,37 @@ func (s *AutonomousDatabase) SetResource(r *ocidb.AutonomousDatabase) *Autonomou
 	return s
 }
 
+// SetResourceID points the autonomous database status at an existing OCI resource
+func (s *AutonomousDatabase) SetResourceID(id string) {
+	s.SetResource(&ocidb.AutonomousDatabase{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the autonomous database spec
+func (s *AutonomousDatabase) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the autonomous database resource in oci
+func (s *AutonomousDatabase) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.AutonomousDatabase != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *AutonomousDatabase) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a AutonomousDatabase dependent
-func (s *AutonomousDatabase) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a AutonomousDatabase dependent
-func (s *AutonomousDatabase) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the autonomous database
+func (s *AutonomousDatabase) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the AutonomousDatabase dependent is registered
-func (s *AutonomousDatabase) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the autonomous database
+func (s *AutonomousDatabase) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the AutonomousDatabase spec
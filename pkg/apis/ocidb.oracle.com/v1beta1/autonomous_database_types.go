@@ -0,0 +1,65 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AutonomousDatabase describes an autonomous transaction processing database
+type AutonomousDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              AutonomousDatabaseSpec   `json:"spec"`
+	Status            AutonomousDatabaseStatus `json:"status,omitempty"`
+}
+
+// AutonomousDatabaseSpec describes an autonomous database spec, the admin password
+// is read from the secret named like the object
+type AutonomousDatabaseSpec struct {
+	CompartmentRef string `json:"compartmentRef"`
+
+	CpuCoreCount         int    `json:"cpuCoreCount"`
+	DataStorageSizeInTBs int    `json:"dataStorageSizeInTBs"`
+	DisplayName          string `json:"displayName,omitempty"`
+	LicenseModel         string `json:"licenseModel,omitempty"`
+
+	DefinedTags  map[string]commonv1beta1.Tags `json:"definedTags,omitempty"`
+	FreeformTags map[string]string             `json:"freeformTags,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// AutonomousDatabaseStatus describes an autonomous database status
+type AutonomousDatabaseStatus struct {
+	commonv1beta1.ResourceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AutonomousDatabaseList is a list of AutonomousDatabase items
+type AutonomousDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []AutonomousDatabase `json:"items"`
+}
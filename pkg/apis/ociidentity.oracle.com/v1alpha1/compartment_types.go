@@ -19,8 +19,8 @@ package v1alpha1
 import (
 	ocisdkidentity "github.com/oracle/oci-go-sdk/identity"
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -31,8 +31,10 @@ const (
 	CompartmentControllerName = "compartments"
 )
 
+// CompartmentValidation describes the compartment validation schema
+var CompartmentValidation = common.StructuralValidation(&Compartment{}, apiextv1beta1.CustomResourceValidation{})
+
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Compartment describes a compartment
@@ -58,6 +60,7 @@ type CompartmentSpec struct {
 	DefinedTags map[string]map[string]interface{} `mandatory:"false" json:"definedTags"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // CompartmentStatus describes a compartment status
@@ -117,24 +120,37 @@ func (s *Compartment) SetResource(r *ocisdkidentity.Compartment) *Compartment {
 	return s
 }
 
+// SetResourceID points the compartment status at an existing OCI resource
+func (s *Compartment) SetResourceID(id string) {
+	s.SetResource(&ocisdkidentity.Compartment{Id: &id})
+}
+
+// GetCompartmentRef returns an empty reference since the compartment spec has no compartment
+func (s *Compartment) GetCompartmentRef() string {
+	return ""
+}
+
+// GetResourceCompartmentID returns the compartment id of the compartment resource in oci
+func (s *Compartment) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *Compartment) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a compartment dependent
-func (s *Compartment) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a compartment dependent
-func (s *Compartment) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the compartment
+func (s *Compartment) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the compartment dependent is registered
-func (s *Compartment) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the compartment
+func (s *Compartment) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the load balancer spec
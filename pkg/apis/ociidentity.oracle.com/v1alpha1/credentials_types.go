@@ -0,0 +1,94 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OciCredentials names
+const (
+	OciCredentialsKind           = "OciCredentials"
+	OciCredentialsResourcePlural = "ocicredentials"
+)
+
+// Keys of the secret referenced by oci credentials
+const (
+	CredentialsTenancyKey     = "tenancy"
+	CredentialsUserKey        = "user"
+	CredentialsFingerprintKey = "fingerprint"
+	CredentialsRegionKey      = "region"
+	CredentialsPrivateKeyKey  = "key"
+	CredentialsPassphraseKey  = "passphrase"
+)
+
+// OciCredentialsValidation describes the oci credentials validation schema
+var OciCredentialsValidation = common.StructuralValidation(&OciCredentials{}, apiextv1beta1.CustomResourceValidation{
+	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
+		Required: []string{"spec"},
+		Properties: map[string]apiextv1beta1.JSONSchemaProps{
+			"spec": {
+				Required: []string{"secretName"},
+				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"secretName": {
+						Type:    common.ValidationTypeString,
+						Pattern: common.AnyStringValidationRegex,
+					},
+				},
+			},
+		},
+	},
+})
+
+// OciCredentialsColumns are the printer columns of oci credentials
+var OciCredentialsColumns = []apiextv1beta1.CustomResourceColumnDefinition{
+	{Name: "Secret", Type: common.ValidationTypeString, JSONPath: ".spec.secretName"},
+	{Name: "Region", Type: common.ValidationTypeString, JSONPath: ".spec.region"},
+	common.AgeColumn,
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OciCredentials selects the tenancy and user the resources of a namespace are managed
+// with, namespaces refer to it by name with the credentials annotation. It is cluster
+// scoped so that only cluster admins choose the credentials of a namespace.
+type OciCredentials struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              OciCredentialsSpec `json:"spec"`
+}
+
+// OciCredentialsSpec describes an oci credentials spec
+type OciCredentialsSpec struct {
+	// SecretName is the secret in the oci-manager namespace holding the tenancy, user,
+	// fingerprint, region and private key of the OCI API key
+	SecretName string `json:"secretName"`
+
+	// Region overrides the region of the secret
+	Region string `json:"region,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OciCredentialsList is a list of OciCredentials items
+type OciCredentialsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []OciCredentials `json:"items"`
+}
@@ -22,7 +22,6 @@ import (
 	ocisdkidentity "github.com/oracle/oci-go-sdk/identity"
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -34,13 +33,15 @@ const (
 )
 
 // DynamicGroupValidation describes the dynamic group validation schema
-var DynamicGroupValidation = apiextv1beta1.CustomResourceValidation{
+var DynamicGroupValidation = common.StructuralValidation(&DynamicGroup{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "description", "matchingRule"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -57,10 +58,9 @@ var DynamicGroupValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DynamicGroup describes a dynamic group
@@ -83,6 +83,7 @@ type DynamicGroupSpec struct {
 	MatchingRule *string `mandatory:"true" json:"matchingRule"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // DynamicGroupStatus describes a dynamic group status
@@ -139,24 +140,37 @@ func (s *DynamicGroup) SetResource(r *ocisdkidentity.DynamicGroup) *DynamicGroup
 	return s
 }
 
+// SetResourceID points the dynamic group status at an existing OCI resource
+func (s *DynamicGroup) SetResourceID(id string) {
+	s.SetResource(&ocisdkidentity.DynamicGroup{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the dynamic group spec
+func (s *DynamicGroup) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the dynamic group resource in oci
+func (s *DynamicGroup) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *DynamicGroup) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a dynamic group dependent
-func (s *DynamicGroup) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a dynamic group dependent
-func (s *DynamicGroup) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the dynamic group
+func (s *DynamicGroup) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the dynamic group dependent is registered
-func (s *DynamicGroup) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the dynamic group
+func (s *DynamicGroup) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the dynamic group oci resource
@@ -22,7 +22,6 @@ import (
 	ocisdkidentity "github.com/oracle/oci-go-sdk/identity"
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -34,13 +33,15 @@ const (
 )
 
 // PolicyValidation describes the policy validation schema
-var PolicyValidation = apiextv1beta1.CustomResourceValidation{
+var PolicyValidation = common.StructuralValidation(&Policy{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "description", "statements"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -56,10 +57,9 @@ var PolicyValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Policy describes a policy
@@ -80,7 +80,9 @@ type PolicySpec struct {
 	// An array of one or more policy statements written in the policy language.
 	Statements []string `mandatory:"true" json:"statements"`
 
+	common.ResourceTags
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // PolicyStatus describes a policy status
@@ -137,24 +139,37 @@ func (s *Policy) SetResource(r *ocisdkidentity.Policy) *Policy {
 	return s
 }
 
+// SetResourceID points the policy status at an existing OCI resource
+func (s *Policy) SetResourceID(id string) {
+	s.SetResource(&ocisdkidentity.Policy{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the policy spec
+func (s *Policy) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the policy resource in oci
+func (s *Policy) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *Policy) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a policy dependent
-func (s *Policy) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a policy dependent
-func (s *Policy) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the policy
+func (s *Policy) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the policy dependent is registered
-func (s *Policy) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the policy
+func (s *Policy) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the policy oci resource
@@ -44,6 +44,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CompartmentList{},
 		&Policy{},
 		&PolicyList{},
+		&OciCredentials{},
+		&OciCredentialsList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -236,6 +236,7 @@ func (in *DynamicGroupSpec) DeepCopyInto(out *DynamicGroupSpec) {
 		}
 	}
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
@@ -275,6 +276,84 @@ func (in *DynamicGroupStatus) DeepCopy() *DynamicGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciCredentials) DeepCopyInto(out *OciCredentials) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciCredentials.
+func (in *OciCredentials) DeepCopy() *OciCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(OciCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciCredentials) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciCredentialsList) DeepCopyInto(out *OciCredentialsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OciCredentials, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciCredentialsList.
+func (in *OciCredentialsList) DeepCopy() *OciCredentialsList {
+	if in == nil {
+		return nil
+	}
+	out := new(OciCredentialsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OciCredentialsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	} else {
+		return nil
+	}
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OciCredentialsSpec) DeepCopyInto(out *OciCredentialsSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OciCredentialsSpec.
+func (in *OciCredentialsSpec) DeepCopy() *OciCredentialsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OciCredentialsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Policy) DeepCopyInto(out *Policy) {
 	*out = *in
@@ -362,7 +441,9 @@ func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.ResourceTags.DeepCopyInto(&out.ResourceTags)
 	in.Dependency.DeepCopyInto(&out.Dependency)
+	out.ResourcePolicy = in.ResourcePolicy
 	return
 }
 
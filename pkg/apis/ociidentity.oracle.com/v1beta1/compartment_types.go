@@ -0,0 +1,63 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Compartment describes a compartment
+type Compartment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              CompartmentSpec   `json:"spec"`
+	Status            CompartmentStatus `json:"status,omitempty"`
+}
+
+// CompartmentSpec describes a compartment spec
+type CompartmentSpec struct {
+	Description string `json:"description,omitempty"`
+
+	FreeformTags map[string]string             `json:"freeformTags,omitempty"`
+	DefinedTags  map[string]commonv1beta1.Tags `json:"definedTags,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// CompartmentStatus describes a compartment status with the shapes, images and
+// availability domains available in the compartment
+type CompartmentStatus struct {
+	commonv1beta1.ResourceStatus
+	Shapes              []string          `json:"shapes,omitempty"`
+	Images              map[string]string `json:"images,omitempty"`
+	AvailabilityDomains []string          `json:"availabilityDomains,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CompartmentList is a list of Compartment items
+type CompartmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Compartment `json:"items"`
+}
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -39,13 +38,14 @@ var minWeight = float64(1)
 var maxWeight = float64(100)
 
 // BackendValidation describes the backend validation schema
-var BackendValidation = apiextv1beta1.CustomResourceValidation{
+var BackendValidation = common.StructuralValidation(&Backend{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"backendSetRef", "instanceRef", "loadBalancerRef", "port", "weight"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
 					"backendSetRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -86,10 +86,9 @@ var BackendValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Backend describes a backend
@@ -114,6 +113,7 @@ type BackendSpec struct {
 	Weight    int    `json:"weight"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // BackendStatus describes a backend status
@@ -181,19 +181,14 @@ func (s *Backend) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a backend dependent
-func (s *Backend) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a backend dependent
-func (s *Backend) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the backend
+func (s *Backend) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the backend dependent is registered
-func (s *Backend) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the backend
+func (s *Backend) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the backend spec
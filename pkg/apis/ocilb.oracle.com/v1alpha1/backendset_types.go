@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -42,13 +41,14 @@ var minDepth = float64(1)
 var maxDepth = float64(10)
 
 // BackendSetValidation describes the backend set validation schema
-var BackendSetValidation = apiextv1beta1.CustomResourceValidation{
+var BackendSetValidation = common.StructuralValidation(&BackendSet{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"loadBalancerRef"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
 					"loadBalancerRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -127,10 +127,9 @@ var BackendSetValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // BackendSet describes a backend set
@@ -153,6 +152,7 @@ type BackendSetSpec struct {
 	SessionPersistenceConfig *SessionPersistenceConfiguration `json:"sessionPersistenceConfiguration,omitempty" url:"-"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // HealthChecker describes health checker of the backend set
@@ -247,19 +247,14 @@ func (s *BackendSet) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a backend set dependent
-func (s *BackendSet) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a backend set dependent
-func (s *BackendSet) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the backend set
+func (s *BackendSet) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the backend set dependent is registered
-func (s *BackendSet) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the backend set
+func (s *BackendSet) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the backend set spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,14 @@ const (
 )
 
 // CertificateValidation describes the certificate validation schema
-var CertificateValidation = apiextv1beta1.CustomResourceValidation{
+var CertificateValidation = common.StructuralValidation(&Certificate{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"loadBalancerRef", "publicCertificate", "privateKey"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
 					"loadBalancerRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -65,10 +65,9 @@ var CertificateValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Certificate describes a certificate
@@ -91,6 +90,7 @@ type CertificateSpec struct {
 	Passphrase    string `header:"-" url:"-" json:"passphrase,omitempty"` // Only for create
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // CertificateStatus describes a certificate status
@@ -158,19 +158,14 @@ func (s *Certificate) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a certificate dependent
-func (s *Certificate) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a certificate dependent
-func (s *Certificate) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the certificate
+func (s *Certificate) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the certificate dependent is registered
-func (s *Certificate) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the certificate
+func (s *Certificate) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the certificate spec
@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,14 @@ const (
 )
 
 // ListenerValidation describes the listener validation schema
-var ListenerValidation = apiextv1beta1.CustomResourceValidation{
+var ListenerValidation = common.StructuralValidation(&Listener{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"loadBalancerRef", "defaultBackendSetName", "port", "protocol"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
 					"certificateRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.NoOrAnyStringValidationRegex,
@@ -65,10 +65,9 @@ var ListenerValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Listener describes a listener
@@ -91,6 +90,7 @@ type ListenerSpec struct {
 	PathRouteSetName      string `header:"-" url:"-" json:"pathRouteSetName"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // ListenerStatus describes a listener status
@@ -158,19 +158,14 @@ func (s *Listener) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a listener dependent
-func (s *Listener) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a listener dependent
-func (s *Listener) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the listener
+func (s *Listener) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the listener dependent is registered
-func (s *Listener) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the listener
+func (s *Listener) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the listener spec
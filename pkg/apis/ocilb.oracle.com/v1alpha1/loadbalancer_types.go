@@ -21,7 +21,6 @@ import (
 	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -33,13 +32,15 @@ const (
 )
 
 // LoadBalancerValidation describes the load balancer validation schema
-var LoadBalancerValidation = apiextv1beta1.CustomResourceValidation{
+var LoadBalancerValidation = common.StructuralValidation(&LoadBalancer{}, apiextv1beta1.CustomResourceValidation{
 	OpenAPIV3Schema: &apiextv1beta1.JSONSchemaProps{
 		Properties: map[string]apiextv1beta1.JSONSchemaProps{
-			"metadata": common.MetaDataValidation,
 			"spec": {
 				Required: []string{"compartmentRef", "subnetRefs"},
 				Properties: map[string]apiextv1beta1.JSONSchemaProps{
+					"deletionPolicy": common.DeletionPolicyValidation,
+					"driftPolicy":    common.DriftPolicyValidation,
+					"importOcid":     common.ImportOcidValidation,
 					"compartmentRef": {
 						Type:    common.ValidationTypeString,
 						Pattern: common.AnyStringValidationRegex,
@@ -59,10 +60,9 @@ var LoadBalancerValidation = apiextv1beta1.CustomResourceValidation{
 			},
 		},
 	},
-}
+})
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // LoadBalancer describes a load balancer
@@ -92,6 +92,7 @@ type LoadBalancerSpec struct {
 	FreeformTags map[string]string `mandatory:"false" json:"freeformTags"`
 
 	common.Dependency
+	common.ResourcePolicy
 }
 
 // LoadBalancerStatus describes a load balancer status
@@ -163,24 +164,37 @@ func (s *LoadBalancer) SetResource(r *ocilb.LoadBalancer) *LoadBalancer {
 	return s
 }
 
+// SetResourceID points the load balancer status at an existing OCI resource
+func (s *LoadBalancer) SetResourceID(id string) {
+	s.SetResource(&ocilb.LoadBalancer{Id: &id})
+}
+
+// GetCompartmentRef returns the compartment reference of the load balancer spec
+func (s *LoadBalancer) GetCompartmentRef() string {
+	return s.Spec.CompartmentRef
+}
+
+// GetResourceCompartmentID returns the compartment id of the load balancer resource in oci
+func (s *LoadBalancer) GetResourceCompartmentID() string {
+	if s.Status.Resource != nil && s.Status.Resource.LoadBalancer != nil && s.Status.Resource.CompartmentId != nil {
+		return *s.Status.Resource.CompartmentId
+	}
+	return ""
+}
+
 // GetResourceState returns the current state of the iresource
 func (s *LoadBalancer) GetResourceState() common.ResourceState {
 	return s.Status.State
 }
 
-// AddDependent adds a load balancer dependent
-func (s *LoadBalancer) AddDependent(kind string, obj runtime.Object) error {
-	return s.Status.AddDependent(kind, obj)
-}
-
-// RemoveDependent removes a load balancer dependent
-func (s *LoadBalancer) RemoveDependent(kind string, obj runtime.Object) error {
-	return s.Status.RemoveDependent(kind, obj)
+// GetResourceStatus returns the common status of the load balancer
+func (s *LoadBalancer) GetResourceStatus() *common.ResourceStatus {
+	return &s.Status.ResourceStatus
 }
 
-// IsDependentRegistered returns true if the load balancer dependent is registered
-func (s *LoadBalancer) IsDependentRegistered(kind string, obj runtime.Object) (bool, error) {
-	return s.Status.IsDependentRegistered(kind, obj)
+// GetResourcePolicy returns the common policy of the load balancer
+func (s *LoadBalancer) GetResourcePolicy() *common.ResourcePolicy {
+	return &s.Spec.ResourcePolicy
 }
 
 // DeepCopy the load balancer spec
@@ -0,0 +1,67 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Backend describes a backend of a backend set
+type Backend struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              BackendSpec   `json:"spec"`
+	Status            BackendStatus `json:"status,omitempty"`
+}
+
+// BackendSpec describes a backend spec
+type BackendSpec struct {
+	BackendSetRef   string `json:"backendSetRef"`
+	InstanceRef     string `json:"instanceRef"`
+	LoadBalancerRef string `json:"loadBalancerRef"`
+
+	Backup    bool   `json:"backup"`
+	Drain     bool   `json:"drain"`
+	IPAddress string `json:"ipAddress"`
+	Offline   bool   `json:"offline"`
+	Port      int    `json:"port"`
+	Weight    int    `json:"weight"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// BackendStatus describes a backend status
+type BackendStatus struct {
+	commonv1beta1.ResourceStatus
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	WorkRequest    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackendList is a list of Backend items
+type BackendList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Backend `json:"items"`
+}
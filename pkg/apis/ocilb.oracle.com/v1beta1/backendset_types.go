@@ -0,0 +1,90 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackendSet describes a load balancer backend set
+type BackendSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              BackendSetSpec   `json:"spec"`
+	Status            BackendSetStatus `json:"status,omitempty"`
+}
+
+// BackendSetSpec describes a backend set spec
+type BackendSetSpec struct {
+	LoadBalancerRef string `json:"loadBalancerRef"`
+
+	HealthChecker *HealthChecker `json:"healthChecker"`
+	Policy        string         `json:"policy"`
+
+	SSLConfig                *SSLConfiguration                `json:"sslConfiguration,omitempty"`
+	SessionPersistenceConfig *SessionPersistenceConfiguration `json:"sessionPersistenceConfiguration,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// HealthChecker describes the health check of the backends
+type HealthChecker struct {
+	Protocol string `json:"protocol"`
+	URLPath  string `json:"urlPath"`
+
+	IntervalInMillis  int    `json:"intervalInMillis,omitempty"`
+	Port              int    `json:"port,omitempty"`
+	ResponseBodyRegex string `json:"responseBodyRegex,omitempty"`
+	Retries           int    `json:"retries,omitempty"`
+	ReturnCode        int    `json:"returnCode,omitempty"`
+	TimeoutInMillis   int    `json:"timeoutInMillis,omitempty"`
+}
+
+// SSLConfiguration describes the ssl connections to the backends
+type SSLConfiguration struct {
+	CertificateName       string `json:"certificateName"`
+	VerifyDepth           int    `json:"verifyDepth"`
+	VerifyPeerCertificate bool   `json:"verifyPeerCertificate"`
+}
+
+// SessionPersistenceConfiguration describes the cookie based session persistence
+type SessionPersistenceConfiguration struct {
+	CookieName      string `json:"cookieName"`
+	DisableFallback bool   `json:"disableFallback"`
+}
+
+// BackendSetStatus describes a backend set status
+type BackendSetStatus struct {
+	commonv1beta1.ResourceStatus
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	WorkRequest    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackendSetList is a list of BackendSet items
+type BackendSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []BackendSet `json:"items"`
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate describes a load balancer certificate bundle
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              CertificateSpec   `json:"spec"`
+	Status            CertificateStatus `json:"status,omitempty"`
+}
+
+// CertificateSpec describes a certificate spec, the private key and its passphrase
+// are only used on create
+type CertificateSpec struct {
+	LoadBalancerRef string `json:"loadBalancerRef"`
+
+	PublicCertificate string `json:"publicCertificate"`
+	PrivateKey        string `json:"privateKey,omitempty"`
+	CACertificate     string `json:"caCertificate,omitempty"`
+	Passphrase        string `json:"passphrase,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// CertificateStatus describes a certificate status
+type CertificateStatus struct {
+	commonv1beta1.ResourceStatus
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	WorkRequest    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList is a list of Certificate items
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Certificate `json:"items"`
+}
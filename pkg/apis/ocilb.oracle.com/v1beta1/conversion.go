@@ -0,0 +1,33 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	"github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+)
+
+// loadBalancerIdRename names the load balancer id of the status which has no json tag in v1alpha1
+var loadBalancerIdRename = commonv1beta1.FieldRename{V1alpha1: "status.LoadBalancerId", V1beta1: "status.loadBalancerId"}
+
+// FieldRenames are the renames of the kinds of the group on top of the common renames
+var FieldRenames = map[string][]commonv1beta1.FieldRename{
+	v1alpha1.BackendKind:     {loadBalancerIdRename},
+	v1alpha1.BackendSetKind:  {loadBalancerIdRename},
+	v1alpha1.CertificateKind: {loadBalancerIdRename},
+	v1alpha1.ListenerKind:    {loadBalancerIdRename},
+}
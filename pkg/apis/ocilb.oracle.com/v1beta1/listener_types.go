@@ -0,0 +1,65 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Listener describes a load balancer listener
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ListenerSpec   `json:"spec"`
+	Status            ListenerStatus `json:"status,omitempty"`
+}
+
+// ListenerSpec describes a listener spec
+type ListenerSpec struct {
+	CertificateRef  string `json:"certificateRef"`
+	LoadBalancerRef string `json:"loadBalancerRef"`
+
+	DefaultBackendSetName string `json:"defaultBackendSetName"`
+	Port                  int    `json:"port"`
+	Protocol              string `json:"protocol"`
+	IdleTimeout           int64  `json:"idleTimeout"`
+	PathRouteSetName      string `json:"pathRouteSetName"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// ListenerStatus describes a listener status
+type ListenerStatus struct {
+	commonv1beta1.ResourceStatus
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	WorkRequest    `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ListenerList is a list of Listener items
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Listener `json:"items"`
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	common "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LoadBalancer describes a load balancer
+type LoadBalancer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              LoadBalancerSpec   `json:"spec"`
+	Status            LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// LoadBalancerSpec describes a load balancer spec
+type LoadBalancerSpec struct {
+	CompartmentRef string   `json:"compartmentRef"`
+	SubnetRefs     []string `json:"subnetRefs"`
+
+	IsPrivate bool   `json:"isPrivate"`
+	Shape     string `json:"shapeName"`
+
+	DefinedTags  map[string]commonv1beta1.Tags `json:"definedTags,omitempty"`
+	FreeformTags map[string]string             `json:"freeformTags,omitempty"`
+
+	commonv1beta1.Dependency
+	common.ResourcePolicy
+}
+
+// WorkRequest is the last load balancer work request of an object
+type WorkRequest struct {
+	WorkRequestId     *string `json:"workRequestId,omitempty"`
+	WorkRequestStatus string  `json:"workRequestStatus,omitempty"`
+}
+
+// LoadBalancerStatus describes a load balancer status
+type LoadBalancerStatus struct {
+	commonv1beta1.ResourceStatus
+	WorkRequest `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LoadBalancerList is a list of LoadBalancer items
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []LoadBalancer `json:"items"`
+}
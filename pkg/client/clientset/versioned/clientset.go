@@ -18,10 +18,15 @@ package versioned
 import (
 	cloudv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/cloud.k8s.io/v1alpha1"
 	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1alpha1"
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1beta1"
 	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1alpha1"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1beta1"
 	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1alpha1"
+	ocidbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1beta1"
 	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1alpha1"
+	ociidentityv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1beta1"
 	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1alpha1"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -33,20 +38,25 @@ type Interface interface {
 	// Deprecated: please explicitly pick a version if possible.
 	Cloud() cloudv1alpha1.CloudV1alpha1Interface
 	OciceV1alpha1() ocicev1alpha1.OciceV1alpha1Interface
+	OciceV1beta1() ocicev1beta1.OciceV1beta1Interface
 	// Deprecated: please explicitly pick a version if possible.
-	Ocice() ocicev1alpha1.OciceV1alpha1Interface
+	Ocice() ocicev1beta1.OciceV1beta1Interface
 	OcicoreV1alpha1() ocicorev1alpha1.OcicoreV1alpha1Interface
+	OcicoreV1beta1() ocicorev1beta1.OcicoreV1beta1Interface
 	// Deprecated: please explicitly pick a version if possible.
-	Ocicore() ocicorev1alpha1.OcicoreV1alpha1Interface
+	Ocicore() ocicorev1beta1.OcicoreV1beta1Interface
 	OcidbV1alpha1() ocidbv1alpha1.OcidbV1alpha1Interface
+	OcidbV1beta1() ocidbv1beta1.OcidbV1beta1Interface
 	// Deprecated: please explicitly pick a version if possible.
-	Ocidb() ocidbv1alpha1.OcidbV1alpha1Interface
+	Ocidb() ocidbv1beta1.OcidbV1beta1Interface
 	OciidentityV1alpha1() ociidentityv1alpha1.OciidentityV1alpha1Interface
+	OciidentityV1beta1() ociidentityv1beta1.OciidentityV1beta1Interface
 	// Deprecated: please explicitly pick a version if possible.
-	Ociidentity() ociidentityv1alpha1.OciidentityV1alpha1Interface
+	Ociidentity() ociidentityv1beta1.OciidentityV1beta1Interface
 	OcilbV1alpha1() ocilbv1alpha1.OcilbV1alpha1Interface
+	OcilbV1beta1() ocilbv1beta1.OcilbV1beta1Interface
 	// Deprecated: please explicitly pick a version if possible.
-	Ocilb() ocilbv1alpha1.OcilbV1alpha1Interface
+	Ocilb() ocilbv1beta1.OcilbV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -55,10 +65,15 @@ type Clientset struct {
 	*discovery.DiscoveryClient
 	cloudV1alpha1       *cloudv1alpha1.CloudV1alpha1Client
 	ociceV1alpha1       *ocicev1alpha1.OciceV1alpha1Client
+	ociceV1beta1        *ocicev1beta1.OciceV1beta1Client
 	ocicoreV1alpha1     *ocicorev1alpha1.OcicoreV1alpha1Client
+	ocicoreV1beta1      *ocicorev1beta1.OcicoreV1beta1Client
 	ocidbV1alpha1       *ocidbv1alpha1.OcidbV1alpha1Client
+	ocidbV1beta1        *ocidbv1beta1.OcidbV1beta1Client
 	ociidentityV1alpha1 *ociidentityv1alpha1.OciidentityV1alpha1Client
+	ociidentityV1beta1  *ociidentityv1beta1.OciidentityV1beta1Client
 	ocilbV1alpha1       *ocilbv1alpha1.OcilbV1alpha1Client
+	ocilbV1beta1        *ocilbv1beta1.OcilbV1beta1Client
 }
 
 // CloudV1alpha1 retrieves the CloudV1alpha1Client
@@ -77,10 +92,15 @@ func (c *Clientset) OciceV1alpha1() ocicev1alpha1.OciceV1alpha1Interface {
 	return c.ociceV1alpha1
 }
 
+// OciceV1beta1 retrieves the OciceV1beta1Client
+func (c *Clientset) OciceV1beta1() ocicev1beta1.OciceV1beta1Interface {
+	return c.ociceV1beta1
+}
+
 // Deprecated: Ocice retrieves the default version of OciceClient.
 // Please explicitly pick a version.
-func (c *Clientset) Ocice() ocicev1alpha1.OciceV1alpha1Interface {
-	return c.ociceV1alpha1
+func (c *Clientset) Ocice() ocicev1beta1.OciceV1beta1Interface {
+	return c.ociceV1beta1
 }
 
 // OcicoreV1alpha1 retrieves the OcicoreV1alpha1Client
@@ -88,10 +108,15 @@ func (c *Clientset) OcicoreV1alpha1() ocicorev1alpha1.OcicoreV1alpha1Interface {
 	return c.ocicoreV1alpha1
 }
 
+// OcicoreV1beta1 retrieves the OcicoreV1beta1Client
+func (c *Clientset) OcicoreV1beta1() ocicorev1beta1.OcicoreV1beta1Interface {
+	return c.ocicoreV1beta1
+}
+
 // Deprecated: Ocicore retrieves the default version of OcicoreClient.
 // Please explicitly pick a version.
-func (c *Clientset) Ocicore() ocicorev1alpha1.OcicoreV1alpha1Interface {
-	return c.ocicoreV1alpha1
+func (c *Clientset) Ocicore() ocicorev1beta1.OcicoreV1beta1Interface {
+	return c.ocicoreV1beta1
 }
 
 // OcidbV1alpha1 retrieves the OcidbV1alpha1Client
@@ -99,10 +124,15 @@ func (c *Clientset) OcidbV1alpha1() ocidbv1alpha1.OcidbV1alpha1Interface {
 	return c.ocidbV1alpha1
 }
 
+// OcidbV1beta1 retrieves the OcidbV1beta1Client
+func (c *Clientset) OcidbV1beta1() ocidbv1beta1.OcidbV1beta1Interface {
+	return c.ocidbV1beta1
+}
+
 // Deprecated: Ocidb retrieves the default version of OcidbClient.
 // Please explicitly pick a version.
-func (c *Clientset) Ocidb() ocidbv1alpha1.OcidbV1alpha1Interface {
-	return c.ocidbV1alpha1
+func (c *Clientset) Ocidb() ocidbv1beta1.OcidbV1beta1Interface {
+	return c.ocidbV1beta1
 }
 
 // OciidentityV1alpha1 retrieves the OciidentityV1alpha1Client
@@ -110,10 +140,15 @@ func (c *Clientset) OciidentityV1alpha1() ociidentityv1alpha1.OciidentityV1alpha
 	return c.ociidentityV1alpha1
 }
 
+// OciidentityV1beta1 retrieves the OciidentityV1beta1Client
+func (c *Clientset) OciidentityV1beta1() ociidentityv1beta1.OciidentityV1beta1Interface {
+	return c.ociidentityV1beta1
+}
+
 // Deprecated: Ociidentity retrieves the default version of OciidentityClient.
 // Please explicitly pick a version.
-func (c *Clientset) Ociidentity() ociidentityv1alpha1.OciidentityV1alpha1Interface {
-	return c.ociidentityV1alpha1
+func (c *Clientset) Ociidentity() ociidentityv1beta1.OciidentityV1beta1Interface {
+	return c.ociidentityV1beta1
 }
 
 // OcilbV1alpha1 retrieves the OcilbV1alpha1Client
@@ -121,10 +156,15 @@ func (c *Clientset) OcilbV1alpha1() ocilbv1alpha1.OcilbV1alpha1Interface {
 	return c.ocilbV1alpha1
 }
 
+// OcilbV1beta1 retrieves the OcilbV1beta1Client
+func (c *Clientset) OcilbV1beta1() ocilbv1beta1.OcilbV1beta1Interface {
+	return c.ocilbV1beta1
+}
+
 // Deprecated: Ocilb retrieves the default version of OcilbClient.
 // Please explicitly pick a version.
-func (c *Clientset) Ocilb() ocilbv1alpha1.OcilbV1alpha1Interface {
-	return c.ocilbV1alpha1
+func (c *Clientset) Ocilb() ocilbv1beta1.OcilbV1beta1Interface {
+	return c.ocilbV1beta1
 }
 
 // Discovery retrieves the DiscoveryClient
@@ -151,22 +191,42 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.ociceV1beta1, err = ocicev1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.ocicoreV1alpha1, err = ocicorev1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.ocicoreV1beta1, err = ocicorev1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.ocidbV1alpha1, err = ocidbv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.ocidbV1beta1, err = ocidbv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.ociidentityV1alpha1, err = ociidentityv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.ociidentityV1beta1, err = ociidentityv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.ocilbV1alpha1, err = ocilbv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.ocilbV1beta1, err = ocilbv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -181,10 +241,15 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.cloudV1alpha1 = cloudv1alpha1.NewForConfigOrDie(c)
 	cs.ociceV1alpha1 = ocicev1alpha1.NewForConfigOrDie(c)
+	cs.ociceV1beta1 = ocicev1beta1.NewForConfigOrDie(c)
 	cs.ocicoreV1alpha1 = ocicorev1alpha1.NewForConfigOrDie(c)
+	cs.ocicoreV1beta1 = ocicorev1beta1.NewForConfigOrDie(c)
 	cs.ocidbV1alpha1 = ocidbv1alpha1.NewForConfigOrDie(c)
+	cs.ocidbV1beta1 = ocidbv1beta1.NewForConfigOrDie(c)
 	cs.ociidentityV1alpha1 = ociidentityv1alpha1.NewForConfigOrDie(c)
+	cs.ociidentityV1beta1 = ociidentityv1beta1.NewForConfigOrDie(c)
 	cs.ocilbV1alpha1 = ocilbv1alpha1.NewForConfigOrDie(c)
+	cs.ocilbV1beta1 = ocilbv1beta1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -195,10 +260,15 @@ func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.cloudV1alpha1 = cloudv1alpha1.New(c)
 	cs.ociceV1alpha1 = ocicev1alpha1.New(c)
+	cs.ociceV1beta1 = ocicev1beta1.New(c)
 	cs.ocicoreV1alpha1 = ocicorev1alpha1.New(c)
+	cs.ocicoreV1beta1 = ocicorev1beta1.New(c)
 	cs.ocidbV1alpha1 = ocidbv1alpha1.New(c)
+	cs.ocidbV1beta1 = ocidbv1beta1.New(c)
 	cs.ociidentityV1alpha1 = ociidentityv1alpha1.New(c)
+	cs.ociidentityV1beta1 = ociidentityv1beta1.New(c)
 	cs.ocilbV1alpha1 = ocilbv1alpha1.New(c)
+	cs.ocilbV1beta1 = ocilbv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
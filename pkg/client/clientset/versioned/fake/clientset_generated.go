@@ -21,14 +21,24 @@ import (
 	fakecloudv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/cloud.k8s.io/v1alpha1/fake"
 	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1alpha1"
 	fakeocicev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1alpha1/fake"
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1beta1"
+	fakeocicev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocice.oracle.com/v1beta1/fake"
 	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1alpha1"
 	fakeocicorev1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1alpha1/fake"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1beta1"
+	fakeocicorev1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocicore.oracle.com/v1beta1/fake"
 	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1alpha1"
 	fakeocidbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1alpha1/fake"
+	ocidbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1beta1"
+	fakeocidbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocidb.oracle.com/v1beta1/fake"
 	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1alpha1"
 	fakeociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1alpha1/fake"
+	ociidentityv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1beta1"
+	fakeociidentityv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ociidentity.oracle.com/v1beta1/fake"
 	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1alpha1"
 	fakeocilbv1alpha1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1alpha1/fake"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1beta1"
+	fakeocilbv1beta1 "github.com/oracle/oci-manager/pkg/client/clientset/versioned/typed/ocilb.oracle.com/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -93,9 +103,14 @@ func (c *Clientset) OciceV1alpha1() ocicev1alpha1.OciceV1alpha1Interface {
 	return &fakeocicev1alpha1.FakeOciceV1alpha1{Fake: &c.Fake}
 }
 
-// Ocice retrieves the OciceV1alpha1Client
-func (c *Clientset) Ocice() ocicev1alpha1.OciceV1alpha1Interface {
-	return &fakeocicev1alpha1.FakeOciceV1alpha1{Fake: &c.Fake}
+// OciceV1beta1 retrieves the OciceV1beta1Client
+func (c *Clientset) OciceV1beta1() ocicev1beta1.OciceV1beta1Interface {
+	return &fakeocicev1beta1.FakeOciceV1beta1{Fake: &c.Fake}
+}
+
+// Ocice retrieves the OciceV1beta1Client
+func (c *Clientset) Ocice() ocicev1beta1.OciceV1beta1Interface {
+	return &fakeocicev1beta1.FakeOciceV1beta1{Fake: &c.Fake}
 }
 
 // OcicoreV1alpha1 retrieves the OcicoreV1alpha1Client
@@ -103,9 +118,14 @@ func (c *Clientset) OcicoreV1alpha1() ocicorev1alpha1.OcicoreV1alpha1Interface {
 	return &fakeocicorev1alpha1.FakeOcicoreV1alpha1{Fake: &c.Fake}
 }
 
-// Ocicore retrieves the OcicoreV1alpha1Client
-func (c *Clientset) Ocicore() ocicorev1alpha1.OcicoreV1alpha1Interface {
-	return &fakeocicorev1alpha1.FakeOcicoreV1alpha1{Fake: &c.Fake}
+// OcicoreV1beta1 retrieves the OcicoreV1beta1Client
+func (c *Clientset) OcicoreV1beta1() ocicorev1beta1.OcicoreV1beta1Interface {
+	return &fakeocicorev1beta1.FakeOcicoreV1beta1{Fake: &c.Fake}
+}
+
+// Ocicore retrieves the OcicoreV1beta1Client
+func (c *Clientset) Ocicore() ocicorev1beta1.OcicoreV1beta1Interface {
+	return &fakeocicorev1beta1.FakeOcicoreV1beta1{Fake: &c.Fake}
 }
 
 // OcidbV1alpha1 retrieves the OcidbV1alpha1Client
@@ -113,9 +133,14 @@ func (c *Clientset) OcidbV1alpha1() ocidbv1alpha1.OcidbV1alpha1Interface {
 	return &fakeocidbv1alpha1.FakeOcidbV1alpha1{Fake: &c.Fake}
 }
 
-// Ocidb retrieves the OcidbV1alpha1Client
-func (c *Clientset) Ocidb() ocidbv1alpha1.OcidbV1alpha1Interface {
-	return &fakeocidbv1alpha1.FakeOcidbV1alpha1{Fake: &c.Fake}
+// OcidbV1beta1 retrieves the OcidbV1beta1Client
+func (c *Clientset) OcidbV1beta1() ocidbv1beta1.OcidbV1beta1Interface {
+	return &fakeocidbv1beta1.FakeOcidbV1beta1{Fake: &c.Fake}
+}
+
+// Ocidb retrieves the OcidbV1beta1Client
+func (c *Clientset) Ocidb() ocidbv1beta1.OcidbV1beta1Interface {
+	return &fakeocidbv1beta1.FakeOcidbV1beta1{Fake: &c.Fake}
 }
 
 // OciidentityV1alpha1 retrieves the OciidentityV1alpha1Client
@@ -123,9 +148,14 @@ func (c *Clientset) OciidentityV1alpha1() ociidentityv1alpha1.OciidentityV1alpha
 	return &fakeociidentityv1alpha1.FakeOciidentityV1alpha1{Fake: &c.Fake}
 }
 
-// Ociidentity retrieves the OciidentityV1alpha1Client
-func (c *Clientset) Ociidentity() ociidentityv1alpha1.OciidentityV1alpha1Interface {
-	return &fakeociidentityv1alpha1.FakeOciidentityV1alpha1{Fake: &c.Fake}
+// OciidentityV1beta1 retrieves the OciidentityV1beta1Client
+func (c *Clientset) OciidentityV1beta1() ociidentityv1beta1.OciidentityV1beta1Interface {
+	return &fakeociidentityv1beta1.FakeOciidentityV1beta1{Fake: &c.Fake}
+}
+
+// Ociidentity retrieves the OciidentityV1beta1Client
+func (c *Clientset) Ociidentity() ociidentityv1beta1.OciidentityV1beta1Interface {
+	return &fakeociidentityv1beta1.FakeOciidentityV1beta1{Fake: &c.Fake}
 }
 
 // OcilbV1alpha1 retrieves the OcilbV1alpha1Client
@@ -133,7 +163,12 @@ func (c *Clientset) OcilbV1alpha1() ocilbv1alpha1.OcilbV1alpha1Interface {
 	return &fakeocilbv1alpha1.FakeOcilbV1alpha1{Fake: &c.Fake}
 }
 
-// Ocilb retrieves the OcilbV1alpha1Client
-func (c *Clientset) Ocilb() ocilbv1alpha1.OcilbV1alpha1Interface {
-	return &fakeocilbv1alpha1.FakeOcilbV1alpha1{Fake: &c.Fake}
+// OcilbV1beta1 retrieves the OcilbV1beta1Client
+func (c *Clientset) OcilbV1beta1() ocilbv1beta1.OcilbV1beta1Interface {
+	return &fakeocilbv1beta1.FakeOcilbV1beta1{Fake: &c.Fake}
+}
+
+// Ocilb retrieves the OcilbV1beta1Client
+func (c *Clientset) Ocilb() ocilbv1beta1.OcilbV1beta1Interface {
+	return &fakeocilbv1beta1.FakeOcilbV1beta1{Fake: &c.Fake}
 }
@@ -18,10 +18,15 @@ package fake
 import (
 	cloudv1alpha1 "github.com/oracle/oci-manager/pkg/apis/cloud.k8s.io/v1alpha1"
 	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1alpha1"
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1beta1"
 	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
 	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1alpha1"
+	ocidbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1beta1"
 	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	ociidentityv1beta1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1beta1"
 	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -54,8 +59,13 @@ func init() {
 func AddToScheme(scheme *runtime.Scheme) {
 	cloudv1alpha1.AddToScheme(scheme)
 	ocicev1alpha1.AddToScheme(scheme)
+	ocicev1beta1.AddToScheme(scheme)
 	ocicorev1alpha1.AddToScheme(scheme)
+	ocicorev1beta1.AddToScheme(scheme)
 	ocidbv1alpha1.AddToScheme(scheme)
+	ocidbv1beta1.AddToScheme(scheme)
 	ociidentityv1alpha1.AddToScheme(scheme)
+	ociidentityv1beta1.AddToScheme(scheme)
 	ocilbv1alpha1.AddToScheme(scheme)
+	ocilbv1beta1.AddToScheme(scheme)
 }
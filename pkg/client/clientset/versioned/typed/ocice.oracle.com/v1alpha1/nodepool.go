@@ -34,6 +34,7 @@ type NodePoolsGetter interface {
 type NodePoolInterface interface {
 	Create(*v1alpha1.NodePool) (*v1alpha1.NodePool, error)
 	Update(*v1alpha1.NodePool) (*v1alpha1.NodePool, error)
+	UpdateStatus(*v1alpha1.NodePool) (*v1alpha1.NodePool, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.NodePool, error)
@@ -117,6 +118,22 @@ func (c *nodePools) Update(nodePool *v1alpha1.NodePool) (result *v1alpha1.NodePo
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *nodePools) UpdateStatus(nodePool *v1alpha1.NodePool) (result *v1alpha1.NodePool, err error) {
+	result = &v1alpha1.NodePool{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("nodepools").
+		Name(nodePool.Name).
+		SubResource("status").
+		Body(nodePool).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the nodePool and deletes it. Returns an error if one occurs.
 func (c *nodePools) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type DhcpOptionsGetter interface {
 type DhcpOptionInterface interface {
 	Create(*v1alpha1.DhcpOption) (*v1alpha1.DhcpOption, error)
 	Update(*v1alpha1.DhcpOption) (*v1alpha1.DhcpOption, error)
+	UpdateStatus(*v1alpha1.DhcpOption) (*v1alpha1.DhcpOption, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.DhcpOption, error)
@@ -117,6 +118,22 @@ func (c *dhcpOptions) Update(dhcpOption *v1alpha1.DhcpOption) (result *v1alpha1.
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *dhcpOptions) UpdateStatus(dhcpOption *v1alpha1.DhcpOption) (result *v1alpha1.DhcpOption, err error) {
+	result = &v1alpha1.DhcpOption{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dhcpoptions").
+		Name(dhcpOption.Name).
+		SubResource("status").
+		Body(dhcpOption).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the dhcpOption and deletes it. Returns an error if one occurs.
 func (c *dhcpOptions) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -97,6 +97,18 @@ func (c *FakeDhcpOptions) Update(dhcpOption *v1alpha1.DhcpOption) (result *v1alp
 	return obj.(*v1alpha1.DhcpOption), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDhcpOptions) UpdateStatus(dhcpOption *v1alpha1.DhcpOption) (*v1alpha1.DhcpOption, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(dhcpoptionsResource, "status", c.ns, dhcpOption), &v1alpha1.DhcpOption{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DhcpOption), err
+}
+
 // Delete takes name of the dhcpOption and deletes it. Returns an error if one occurs.
 func (c *FakeDhcpOptions) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
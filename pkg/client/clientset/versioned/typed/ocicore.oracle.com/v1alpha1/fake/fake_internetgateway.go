@@ -97,6 +97,18 @@ func (c *FakeInternetGatewaies) Update(internetGateway *v1alpha1.InternetGateway
 	return obj.(*v1alpha1.InternetGateway), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeInternetGatewaies) UpdateStatus(internetGateway *v1alpha1.InternetGateway) (*v1alpha1.InternetGateway, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(internetgatewaiesResource, "status", c.ns, internetGateway), &v1alpha1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.InternetGateway), err
+}
+
 // Delete takes name of the internetGateway and deletes it. Returns an error if one occurs.
 func (c *FakeInternetGatewaies) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
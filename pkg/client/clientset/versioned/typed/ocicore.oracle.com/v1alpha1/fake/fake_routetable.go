@@ -97,6 +97,18 @@ func (c *FakeRouteTables) Update(routeTable *v1alpha1.RouteTable) (result *v1alp
 	return obj.(*v1alpha1.RouteTable), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRouteTables) UpdateStatus(routeTable *v1alpha1.RouteTable) (*v1alpha1.RouteTable, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(routetablesResource, "status", c.ns, routeTable), &v1alpha1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RouteTable), err
+}
+
 // Delete takes name of the routeTable and deletes it. Returns an error if one occurs.
 func (c *FakeRouteTables) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -97,6 +97,18 @@ func (c *FakeSecurityRuleSets) Update(securityRuleSet *v1alpha1.SecurityRuleSet)
 	return obj.(*v1alpha1.SecurityRuleSet), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSecurityRuleSets) UpdateStatus(securityRuleSet *v1alpha1.SecurityRuleSet) (*v1alpha1.SecurityRuleSet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(securityrulesetsResource, "status", c.ns, securityRuleSet), &v1alpha1.SecurityRuleSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SecurityRuleSet), err
+}
+
 // Delete takes name of the securityRuleSet and deletes it. Returns an error if one occurs.
 func (c *FakeSecurityRuleSets) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
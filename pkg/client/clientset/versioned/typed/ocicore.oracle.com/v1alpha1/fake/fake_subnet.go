@@ -97,6 +97,18 @@ func (c *FakeSubnets) Update(subnet *v1alpha1.Subnet) (result *v1alpha1.Subnet,
 	return obj.(*v1alpha1.Subnet), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSubnets) UpdateStatus(subnet *v1alpha1.Subnet) (*v1alpha1.Subnet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(subnetsResource, "status", c.ns, subnet), &v1alpha1.Subnet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Subnet), err
+}
+
 // Delete takes name of the subnet and deletes it. Returns an error if one occurs.
 func (c *FakeSubnets) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -97,6 +97,18 @@ func (c *FakeVcns) Update(vcn *v1alpha1.Vcn) (result *v1alpha1.Vcn, err error) {
 	return obj.(*v1alpha1.Vcn), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVcns) UpdateStatus(vcn *v1alpha1.Vcn) (*v1alpha1.Vcn, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(vcnsResource, "status", c.ns, vcn), &v1alpha1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Vcn), err
+}
+
 // Delete takes name of the vcn and deletes it. Returns an error if one occurs.
 func (c *FakeVcns) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -97,6 +97,18 @@ func (c *FakeVolumes) Update(volume *v1alpha1.Volume) (result *v1alpha1.Volume,
 	return obj.(*v1alpha1.Volume), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVolumes) UpdateStatus(volume *v1alpha1.Volume) (*v1alpha1.Volume, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(volumesResource, "status", c.ns, volume), &v1alpha1.Volume{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Volume), err
+}
+
 // Delete takes name of the volume and deletes it. Returns an error if one occurs.
 func (c *FakeVolumes) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
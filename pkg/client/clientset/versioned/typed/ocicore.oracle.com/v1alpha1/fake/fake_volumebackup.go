@@ -97,6 +97,18 @@ func (c *FakeVolumeBackups) Update(volumeBackup *v1alpha1.VolumeBackup) (result
 	return obj.(*v1alpha1.VolumeBackup), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVolumeBackups) UpdateStatus(volumeBackup *v1alpha1.VolumeBackup) (*v1alpha1.VolumeBackup, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(volumebackupsResource, "status", c.ns, volumeBackup), &v1alpha1.VolumeBackup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VolumeBackup), err
+}
+
 // Delete takes name of the volumeBackup and deletes it. Returns an error if one occurs.
 func (c *FakeVolumeBackups) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -34,6 +34,7 @@ type InstancesGetter interface {
 type InstanceInterface interface {
 	Create(*v1alpha1.Instance) (*v1alpha1.Instance, error)
 	Update(*v1alpha1.Instance) (*v1alpha1.Instance, error)
+	UpdateStatus(*v1alpha1.Instance) (*v1alpha1.Instance, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Instance, error)
@@ -117,6 +118,22 @@ func (c *instances) Update(instance *v1alpha1.Instance) (result *v1alpha1.Instan
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *instances) UpdateStatus(instance *v1alpha1.Instance) (result *v1alpha1.Instance, err error) {
+	result = &v1alpha1.Instance{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("instances").
+		Name(instance.Name).
+		SubResource("status").
+		Body(instance).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the instance and deletes it. Returns an error if one occurs.
 func (c *instances) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
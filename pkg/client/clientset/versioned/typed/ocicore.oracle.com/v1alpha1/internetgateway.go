@@ -34,6 +34,7 @@ type InternetGatewaiesGetter interface {
 type InternetGatewayInterface interface {
 	Create(*v1alpha1.InternetGateway) (*v1alpha1.InternetGateway, error)
 	Update(*v1alpha1.InternetGateway) (*v1alpha1.InternetGateway, error)
+	UpdateStatus(*v1alpha1.InternetGateway) (*v1alpha1.InternetGateway, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.InternetGateway, error)
@@ -117,6 +118,22 @@ func (c *internetGatewaies) Update(internetGateway *v1alpha1.InternetGateway) (r
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *internetGatewaies) UpdateStatus(internetGateway *v1alpha1.InternetGateway) (result *v1alpha1.InternetGateway, err error) {
+	result = &v1alpha1.InternetGateway{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("internetgatewaies").
+		Name(internetGateway.Name).
+		SubResource("status").
+		Body(internetGateway).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the internetGateway and deletes it. Returns an error if one occurs.
 func (c *internetGatewaies) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type RouteTablesGetter interface {
 type RouteTableInterface interface {
 	Create(*v1alpha1.RouteTable) (*v1alpha1.RouteTable, error)
 	Update(*v1alpha1.RouteTable) (*v1alpha1.RouteTable, error)
+	UpdateStatus(*v1alpha1.RouteTable) (*v1alpha1.RouteTable, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.RouteTable, error)
@@ -117,6 +118,22 @@ func (c *routeTables) Update(routeTable *v1alpha1.RouteTable) (result *v1alpha1.
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *routeTables) UpdateStatus(routeTable *v1alpha1.RouteTable) (result *v1alpha1.RouteTable, err error) {
+	result = &v1alpha1.RouteTable{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("routetables").
+		Name(routeTable.Name).
+		SubResource("status").
+		Body(routeTable).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the routeTable and deletes it. Returns an error if one occurs.
 func (c *routeTables) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type SecurityRuleSetsGetter interface {
 type SecurityRuleSetInterface interface {
 	Create(*v1alpha1.SecurityRuleSet) (*v1alpha1.SecurityRuleSet, error)
 	Update(*v1alpha1.SecurityRuleSet) (*v1alpha1.SecurityRuleSet, error)
+	UpdateStatus(*v1alpha1.SecurityRuleSet) (*v1alpha1.SecurityRuleSet, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.SecurityRuleSet, error)
@@ -117,6 +118,22 @@ func (c *securityRuleSets) Update(securityRuleSet *v1alpha1.SecurityRuleSet) (re
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *securityRuleSets) UpdateStatus(securityRuleSet *v1alpha1.SecurityRuleSet) (result *v1alpha1.SecurityRuleSet, err error) {
+	result = &v1alpha1.SecurityRuleSet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("securityrulesets").
+		Name(securityRuleSet.Name).
+		SubResource("status").
+		Body(securityRuleSet).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the securityRuleSet and deletes it. Returns an error if one occurs.
 func (c *securityRuleSets) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
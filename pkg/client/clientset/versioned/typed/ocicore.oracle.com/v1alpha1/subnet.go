@@ -34,6 +34,7 @@ type SubnetsGetter interface {
 type SubnetInterface interface {
 	Create(*v1alpha1.Subnet) (*v1alpha1.Subnet, error)
 	Update(*v1alpha1.Subnet) (*v1alpha1.Subnet, error)
+	UpdateStatus(*v1alpha1.Subnet) (*v1alpha1.Subnet, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Subnet, error)
@@ -117,6 +118,22 @@ func (c *subnets) Update(subnet *v1alpha1.Subnet) (result *v1alpha1.Subnet, err
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *subnets) UpdateStatus(subnet *v1alpha1.Subnet) (result *v1alpha1.Subnet, err error) {
+	result = &v1alpha1.Subnet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("subnets").
+		Name(subnet.Name).
+		SubResource("status").
+		Body(subnet).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the subnet and deletes it. Returns an error if one occurs.
 func (c *subnets) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type VcnsGetter interface {
 type VcnInterface interface {
 	Create(*v1alpha1.Vcn) (*v1alpha1.Vcn, error)
 	Update(*v1alpha1.Vcn) (*v1alpha1.Vcn, error)
+	UpdateStatus(*v1alpha1.Vcn) (*v1alpha1.Vcn, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Vcn, error)
@@ -117,6 +118,22 @@ func (c *vcns) Update(vcn *v1alpha1.Vcn) (result *v1alpha1.Vcn, err error) {
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *vcns) UpdateStatus(vcn *v1alpha1.Vcn) (result *v1alpha1.Vcn, err error) {
+	result = &v1alpha1.Vcn{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("vcns").
+		Name(vcn.Name).
+		SubResource("status").
+		Body(vcn).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the vcn and deletes it. Returns an error if one occurs.
 func (c *vcns) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type VolumesGetter interface {
 type VolumeInterface interface {
 	Create(*v1alpha1.Volume) (*v1alpha1.Volume, error)
 	Update(*v1alpha1.Volume) (*v1alpha1.Volume, error)
+	UpdateStatus(*v1alpha1.Volume) (*v1alpha1.Volume, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Volume, error)
@@ -117,6 +118,22 @@ func (c *volumes) Update(volume *v1alpha1.Volume) (result *v1alpha1.Volume, err
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *volumes) UpdateStatus(volume *v1alpha1.Volume) (result *v1alpha1.Volume, err error) {
+	result = &v1alpha1.Volume{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("volumes").
+		Name(volume.Name).
+		SubResource("status").
+		Body(volume).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the volume and deletes it. Returns an error if one occurs.
 func (c *volumes) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
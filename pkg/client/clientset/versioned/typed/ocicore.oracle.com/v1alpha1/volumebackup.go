@@ -34,6 +34,7 @@ type VolumeBackupsGetter interface {
 type VolumeBackupInterface interface {
 	Create(*v1alpha1.VolumeBackup) (*v1alpha1.VolumeBackup, error)
 	Update(*v1alpha1.VolumeBackup) (*v1alpha1.VolumeBackup, error)
+	UpdateStatus(*v1alpha1.VolumeBackup) (*v1alpha1.VolumeBackup, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.VolumeBackup, error)
@@ -117,6 +118,22 @@ func (c *volumeBackups) Update(volumeBackup *v1alpha1.VolumeBackup) (result *v1a
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *volumeBackups) UpdateStatus(volumeBackup *v1alpha1.VolumeBackup) (result *v1alpha1.VolumeBackup, err error) {
+	result = &v1alpha1.VolumeBackup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("volumebackups").
+		Name(volumeBackup.Name).
+		SubResource("status").
+		Body(volumeBackup).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the volumeBackup and deletes it. Returns an error if one occurs.
 func (c *volumeBackups) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -0,0 +1,137 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeInternetGatewaies implements InternetGatewayInterface
+type FakeInternetGatewaies struct {
+	Fake *FakeOcicoreV1beta1
+	ns   string
+}
+
+var internetgatewaiesResource = schema.GroupVersionResource{Group: "ocicore.oracle.com", Version: "v1beta1", Resource: "internetgatewaies"}
+
+var internetgatewaiesKind = schema.GroupVersionKind{Group: "ocicore.oracle.com", Version: "v1beta1", Kind: "InternetGateway"}
+
+// Get takes name of the internetGateway, and returns the corresponding internetGateway object, and an error if there is any.
+func (c *FakeInternetGatewaies) Get(name string, options v1.GetOptions) (result *v1beta1.InternetGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(internetgatewaiesResource, c.ns, name), &v1beta1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.InternetGateway), err
+}
+
+// List takes label and field selectors, and returns the list of InternetGatewaies that match those selectors.
+func (c *FakeInternetGatewaies) List(opts v1.ListOptions) (result *v1beta1.InternetGatewayList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(internetgatewaiesResource, internetgatewaiesKind, c.ns, opts), &v1beta1.InternetGatewayList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.InternetGatewayList{ListMeta: obj.(*v1beta1.InternetGatewayList).ListMeta}
+	for _, item := range obj.(*v1beta1.InternetGatewayList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested internetGatewaies.
+func (c *FakeInternetGatewaies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(internetgatewaiesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a internetGateway and creates it.  Returns the server's representation of the internetGateway, and an error, if there is any.
+func (c *FakeInternetGatewaies) Create(internetGateway *v1beta1.InternetGateway) (result *v1beta1.InternetGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(internetgatewaiesResource, c.ns, internetGateway), &v1beta1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.InternetGateway), err
+}
+
+// Update takes the representation of a internetGateway and updates it. Returns the server's representation of the internetGateway, and an error, if there is any.
+func (c *FakeInternetGatewaies) Update(internetGateway *v1beta1.InternetGateway) (result *v1beta1.InternetGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(internetgatewaiesResource, c.ns, internetGateway), &v1beta1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.InternetGateway), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeInternetGatewaies) UpdateStatus(internetGateway *v1beta1.InternetGateway) (*v1beta1.InternetGateway, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(internetgatewaiesResource, "status", c.ns, internetGateway), &v1beta1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.InternetGateway), err
+}
+
+// Delete takes name of the internetGateway and deletes it. Returns an error if one occurs.
+func (c *FakeInternetGatewaies) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(internetgatewaiesResource, c.ns, name), &v1beta1.InternetGateway{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeInternetGatewaies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(internetgatewaiesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.InternetGatewayList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched internetGateway.
+func (c *FakeInternetGatewaies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.InternetGateway, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(internetgatewaiesResource, c.ns, name, data, subresources...), &v1beta1.InternetGateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.InternetGateway), err
+}
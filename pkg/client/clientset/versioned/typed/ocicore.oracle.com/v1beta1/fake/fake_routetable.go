@@ -0,0 +1,137 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRouteTables implements RouteTableInterface
+type FakeRouteTables struct {
+	Fake *FakeOcicoreV1beta1
+	ns   string
+}
+
+var routetablesResource = schema.GroupVersionResource{Group: "ocicore.oracle.com", Version: "v1beta1", Resource: "routetables"}
+
+var routetablesKind = schema.GroupVersionKind{Group: "ocicore.oracle.com", Version: "v1beta1", Kind: "RouteTable"}
+
+// Get takes name of the routeTable, and returns the corresponding routeTable object, and an error if there is any.
+func (c *FakeRouteTables) Get(name string, options v1.GetOptions) (result *v1beta1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(routetablesResource, c.ns, name), &v1beta1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RouteTable), err
+}
+
+// List takes label and field selectors, and returns the list of RouteTables that match those selectors.
+func (c *FakeRouteTables) List(opts v1.ListOptions) (result *v1beta1.RouteTableList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(routetablesResource, routetablesKind, c.ns, opts), &v1beta1.RouteTableList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.RouteTableList{ListMeta: obj.(*v1beta1.RouteTableList).ListMeta}
+	for _, item := range obj.(*v1beta1.RouteTableList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested routeTables.
+func (c *FakeRouteTables) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(routetablesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a routeTable and creates it.  Returns the server's representation of the routeTable, and an error, if there is any.
+func (c *FakeRouteTables) Create(routeTable *v1beta1.RouteTable) (result *v1beta1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(routetablesResource, c.ns, routeTable), &v1beta1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RouteTable), err
+}
+
+// Update takes the representation of a routeTable and updates it. Returns the server's representation of the routeTable, and an error, if there is any.
+func (c *FakeRouteTables) Update(routeTable *v1beta1.RouteTable) (result *v1beta1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(routetablesResource, c.ns, routeTable), &v1beta1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RouteTable), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRouteTables) UpdateStatus(routeTable *v1beta1.RouteTable) (*v1beta1.RouteTable, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(routetablesResource, "status", c.ns, routeTable), &v1beta1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RouteTable), err
+}
+
+// Delete takes name of the routeTable and deletes it. Returns an error if one occurs.
+func (c *FakeRouteTables) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(routetablesResource, c.ns, name), &v1beta1.RouteTable{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRouteTables) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(routetablesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.RouteTableList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched routeTable.
+func (c *FakeRouteTables) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.RouteTable, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(routetablesResource, c.ns, name, data, subresources...), &v1beta1.RouteTable{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RouteTable), err
+}
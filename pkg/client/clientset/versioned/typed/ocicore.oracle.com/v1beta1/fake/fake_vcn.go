@@ -0,0 +1,137 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fake
+
+import (
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeVcns implements VcnInterface
+type FakeVcns struct {
+	Fake *FakeOcicoreV1beta1
+	ns   string
+}
+
+var vcnsResource = schema.GroupVersionResource{Group: "ocicore.oracle.com", Version: "v1beta1", Resource: "vcns"}
+
+var vcnsKind = schema.GroupVersionKind{Group: "ocicore.oracle.com", Version: "v1beta1", Kind: "Vcn"}
+
+// Get takes name of the vcn, and returns the corresponding vcn object, and an error if there is any.
+func (c *FakeVcns) Get(name string, options v1.GetOptions) (result *v1beta1.Vcn, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(vcnsResource, c.ns, name), &v1beta1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Vcn), err
+}
+
+// List takes label and field selectors, and returns the list of Vcns that match those selectors.
+func (c *FakeVcns) List(opts v1.ListOptions) (result *v1beta1.VcnList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(vcnsResource, vcnsKind, c.ns, opts), &v1beta1.VcnList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.VcnList{ListMeta: obj.(*v1beta1.VcnList).ListMeta}
+	for _, item := range obj.(*v1beta1.VcnList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested vcns.
+func (c *FakeVcns) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(vcnsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a vcn and creates it.  Returns the server's representation of the vcn, and an error, if there is any.
+func (c *FakeVcns) Create(vcn *v1beta1.Vcn) (result *v1beta1.Vcn, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(vcnsResource, c.ns, vcn), &v1beta1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Vcn), err
+}
+
+// Update takes the representation of a vcn and updates it. Returns the server's representation of the vcn, and an error, if there is any.
+func (c *FakeVcns) Update(vcn *v1beta1.Vcn) (result *v1beta1.Vcn, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(vcnsResource, c.ns, vcn), &v1beta1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Vcn), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVcns) UpdateStatus(vcn *v1beta1.Vcn) (*v1beta1.Vcn, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(vcnsResource, "status", c.ns, vcn), &v1beta1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Vcn), err
+}
+
+// Delete takes name of the vcn and deletes it. Returns an error if one occurs.
+func (c *FakeVcns) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(vcnsResource, c.ns, name), &v1beta1.Vcn{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVcns) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(vcnsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.VcnList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched vcn.
+func (c *FakeVcns) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1beta1.Vcn, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(vcnsResource, c.ns, name, data, subresources...), &v1beta1.Vcn{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Vcn), err
+}
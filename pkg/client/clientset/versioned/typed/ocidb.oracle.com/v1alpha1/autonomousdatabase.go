@@ -34,6 +34,7 @@ type AutonomousDatabasesGetter interface {
 type AutonomousDatabaseInterface interface {
 	Create(*v1alpha1.AutonomousDatabase) (*v1alpha1.AutonomousDatabase, error)
 	Update(*v1alpha1.AutonomousDatabase) (*v1alpha1.AutonomousDatabase, error)
+	UpdateStatus(*v1alpha1.AutonomousDatabase) (*v1alpha1.AutonomousDatabase, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.AutonomousDatabase, error)
@@ -117,6 +118,22 @@ func (c *autonomousDatabases) Update(autonomousDatabase *v1alpha1.AutonomousData
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *autonomousDatabases) UpdateStatus(autonomousDatabase *v1alpha1.AutonomousDatabase) (result *v1alpha1.AutonomousDatabase, err error) {
+	result = &v1alpha1.AutonomousDatabase{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("autonomousdatabases").
+		Name(autonomousDatabase.Name).
+		SubResource("status").
+		Body(autonomousDatabase).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the autonomousDatabase and deletes it. Returns an error if one occurs.
 func (c *autonomousDatabases) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
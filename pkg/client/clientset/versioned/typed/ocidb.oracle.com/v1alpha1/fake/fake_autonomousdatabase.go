@@ -97,6 +97,18 @@ func (c *FakeAutonomousDatabases) Update(autonomousDatabase *v1alpha1.Autonomous
 	return obj.(*v1alpha1.AutonomousDatabase), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAutonomousDatabases) UpdateStatus(autonomousDatabase *v1alpha1.AutonomousDatabase) (*v1alpha1.AutonomousDatabase, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(autonomousdatabasesResource, "status", c.ns, autonomousDatabase), &v1alpha1.AutonomousDatabase{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AutonomousDatabase), err
+}
+
 // Delete takes name of the autonomousDatabase and deletes it. Returns an error if one occurs.
 func (c *FakeAutonomousDatabases) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
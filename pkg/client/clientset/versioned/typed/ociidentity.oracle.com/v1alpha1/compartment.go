@@ -34,6 +34,7 @@ type CompartmentsGetter interface {
 type CompartmentInterface interface {
 	Create(*v1alpha1.Compartment) (*v1alpha1.Compartment, error)
 	Update(*v1alpha1.Compartment) (*v1alpha1.Compartment, error)
+	UpdateStatus(*v1alpha1.Compartment) (*v1alpha1.Compartment, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Compartment, error)
@@ -117,6 +118,22 @@ func (c *compartments) Update(compartment *v1alpha1.Compartment) (result *v1alph
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *compartments) UpdateStatus(compartment *v1alpha1.Compartment) (result *v1alpha1.Compartment, err error) {
+	result = &v1alpha1.Compartment{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("compartments").
+		Name(compartment.Name).
+		SubResource("status").
+		Body(compartment).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the compartment and deletes it. Returns an error if one occurs.
 func (c *compartments) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
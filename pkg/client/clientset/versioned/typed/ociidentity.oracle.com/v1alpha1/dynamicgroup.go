@@ -34,6 +34,7 @@ type DynamicGroupsGetter interface {
 type DynamicGroupInterface interface {
 	Create(*v1alpha1.DynamicGroup) (*v1alpha1.DynamicGroup, error)
 	Update(*v1alpha1.DynamicGroup) (*v1alpha1.DynamicGroup, error)
+	UpdateStatus(*v1alpha1.DynamicGroup) (*v1alpha1.DynamicGroup, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.DynamicGroup, error)
@@ -117,6 +118,22 @@ func (c *dynamicGroups) Update(dynamicGroup *v1alpha1.DynamicGroup) (result *v1a
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *dynamicGroups) UpdateStatus(dynamicGroup *v1alpha1.DynamicGroup) (result *v1alpha1.DynamicGroup, err error) {
+	result = &v1alpha1.DynamicGroup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dynamicgroups").
+		Name(dynamicGroup.Name).
+		SubResource("status").
+		Body(dynamicGroup).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the dynamicGroup and deletes it. Returns an error if one occurs.
 func (c *dynamicGroups) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -97,6 +97,18 @@ func (c *FakeCompartments) Update(compartment *v1alpha1.Compartment) (result *v1
 	return obj.(*v1alpha1.Compartment), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeCompartments) UpdateStatus(compartment *v1alpha1.Compartment) (*v1alpha1.Compartment, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(compartmentsResource, "status", c.ns, compartment), &v1alpha1.Compartment{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Compartment), err
+}
+
 // Delete takes name of the compartment and deletes it. Returns an error if one occurs.
 func (c *FakeCompartments) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
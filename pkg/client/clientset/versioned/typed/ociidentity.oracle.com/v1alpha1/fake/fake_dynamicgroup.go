@@ -97,6 +97,18 @@ func (c *FakeDynamicGroups) Update(dynamicGroup *v1alpha1.DynamicGroup) (result
 	return obj.(*v1alpha1.DynamicGroup), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDynamicGroups) UpdateStatus(dynamicGroup *v1alpha1.DynamicGroup) (*v1alpha1.DynamicGroup, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(dynamicgroupsResource, "status", c.ns, dynamicGroup), &v1alpha1.DynamicGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DynamicGroup), err
+}
+
 // Delete takes name of the dynamicGroup and deletes it. Returns an error if one occurs.
 func (c *FakeDynamicGroups) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
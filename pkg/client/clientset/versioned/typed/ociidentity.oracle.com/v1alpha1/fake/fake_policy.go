@@ -97,6 +97,18 @@ func (c *FakePolicies) Update(policy *v1alpha1.Policy) (result *v1alpha1.Policy,
 	return obj.(*v1alpha1.Policy), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePolicies) UpdateStatus(policy *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(policiesResource, "status", c.ns, policy), &v1alpha1.Policy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Policy), err
+}
+
 // Delete takes name of the policy and deletes it. Returns an error if one occurs.
 func (c *FakePolicies) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -34,6 +34,7 @@ type PoliciesGetter interface {
 type PolicyInterface interface {
 	Create(*v1alpha1.Policy) (*v1alpha1.Policy, error)
 	Update(*v1alpha1.Policy) (*v1alpha1.Policy, error)
+	UpdateStatus(*v1alpha1.Policy) (*v1alpha1.Policy, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Policy, error)
@@ -117,6 +118,22 @@ func (c *policies) Update(policy *v1alpha1.Policy) (result *v1alpha1.Policy, err
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *policies) UpdateStatus(policy *v1alpha1.Policy) (result *v1alpha1.Policy, err error) {
+	result = &v1alpha1.Policy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("policies").
+		Name(policy.Name).
+		SubResource("status").
+		Body(policy).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the policy and deletes it. Returns an error if one occurs.
 func (c *policies) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
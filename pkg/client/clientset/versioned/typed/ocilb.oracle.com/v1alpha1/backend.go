@@ -34,6 +34,7 @@ type BackendsGetter interface {
 type BackendInterface interface {
 	Create(*v1alpha1.Backend) (*v1alpha1.Backend, error)
 	Update(*v1alpha1.Backend) (*v1alpha1.Backend, error)
+	UpdateStatus(*v1alpha1.Backend) (*v1alpha1.Backend, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Backend, error)
@@ -117,6 +118,22 @@ func (c *backends) Update(backend *v1alpha1.Backend) (result *v1alpha1.Backend,
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *backends) UpdateStatus(backend *v1alpha1.Backend) (result *v1alpha1.Backend, err error) {
+	result = &v1alpha1.Backend{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backends").
+		Name(backend.Name).
+		SubResource("status").
+		Body(backend).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the backend and deletes it. Returns an error if one occurs.
 func (c *backends) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type BackendSetsGetter interface {
 type BackendSetInterface interface {
 	Create(*v1alpha1.BackendSet) (*v1alpha1.BackendSet, error)
 	Update(*v1alpha1.BackendSet) (*v1alpha1.BackendSet, error)
+	UpdateStatus(*v1alpha1.BackendSet) (*v1alpha1.BackendSet, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.BackendSet, error)
@@ -117,6 +118,22 @@ func (c *backendSets) Update(backendSet *v1alpha1.BackendSet) (result *v1alpha1.
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *backendSets) UpdateStatus(backendSet *v1alpha1.BackendSet) (result *v1alpha1.BackendSet, err error) {
+	result = &v1alpha1.BackendSet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("backendsets").
+		Name(backendSet.Name).
+		SubResource("status").
+		Body(backendSet).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the backendSet and deletes it. Returns an error if one occurs.
 func (c *backendSets) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type CertificatesGetter interface {
 type CertificateInterface interface {
 	Create(*v1alpha1.Certificate) (*v1alpha1.Certificate, error)
 	Update(*v1alpha1.Certificate) (*v1alpha1.Certificate, error)
+	UpdateStatus(*v1alpha1.Certificate) (*v1alpha1.Certificate, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Certificate, error)
@@ -117,6 +118,22 @@ func (c *certificates) Update(certificate *v1alpha1.Certificate) (result *v1alph
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *certificates) UpdateStatus(certificate *v1alpha1.Certificate) (result *v1alpha1.Certificate, err error) {
+	result = &v1alpha1.Certificate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("certificates").
+		Name(certificate.Name).
+		SubResource("status").
+		Body(certificate).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the certificate and deletes it. Returns an error if one occurs.
 func (c *certificates) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
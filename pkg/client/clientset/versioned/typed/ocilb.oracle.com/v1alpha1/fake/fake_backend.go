@@ -97,6 +97,18 @@ func (c *FakeBackends) Update(backend *v1alpha1.Backend) (result *v1alpha1.Backe
 	return obj.(*v1alpha1.Backend), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBackends) UpdateStatus(backend *v1alpha1.Backend) (*v1alpha1.Backend, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(backendsResource, "status", c.ns, backend), &v1alpha1.Backend{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Backend), err
+}
+
 // Delete takes name of the backend and deletes it. Returns an error if one occurs.
 func (c *FakeBackends) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -97,6 +97,18 @@ func (c *FakeBackendSets) Update(backendSet *v1alpha1.BackendSet) (result *v1alp
 	return obj.(*v1alpha1.BackendSet), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBackendSets) UpdateStatus(backendSet *v1alpha1.BackendSet) (*v1alpha1.BackendSet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(backendsetsResource, "status", c.ns, backendSet), &v1alpha1.BackendSet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.BackendSet), err
+}
+
 // Delete takes name of the backendSet and deletes it. Returns an error if one occurs.
 func (c *FakeBackendSets) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -97,6 +97,18 @@ func (c *FakeListeners) Update(listener *v1alpha1.Listener) (result *v1alpha1.Li
 	return obj.(*v1alpha1.Listener), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeListeners) UpdateStatus(listener *v1alpha1.Listener) (*v1alpha1.Listener, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(listenersResource, "status", c.ns, listener), &v1alpha1.Listener{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Listener), err
+}
+
 // Delete takes name of the listener and deletes it. Returns an error if one occurs.
 func (c *FakeListeners) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
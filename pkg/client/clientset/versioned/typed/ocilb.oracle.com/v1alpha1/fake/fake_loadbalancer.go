@@ -97,6 +97,18 @@ func (c *FakeLoadBalancers) Update(loadBalancer *v1alpha1.LoadBalancer) (result
 	return obj.(*v1alpha1.LoadBalancer), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeLoadBalancers) UpdateStatus(loadBalancer *v1alpha1.LoadBalancer) (*v1alpha1.LoadBalancer, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(loadbalancersResource, "status", c.ns, loadBalancer), &v1alpha1.LoadBalancer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.LoadBalancer), err
+}
+
 // Delete takes name of the loadBalancer and deletes it. Returns an error if one occurs.
 func (c *FakeLoadBalancers) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
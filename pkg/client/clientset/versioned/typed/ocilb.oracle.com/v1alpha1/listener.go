@@ -34,6 +34,7 @@ type ListenersGetter interface {
 type ListenerInterface interface {
 	Create(*v1alpha1.Listener) (*v1alpha1.Listener, error)
 	Update(*v1alpha1.Listener) (*v1alpha1.Listener, error)
+	UpdateStatus(*v1alpha1.Listener) (*v1alpha1.Listener, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.Listener, error)
@@ -117,6 +118,22 @@ func (c *listeners) Update(listener *v1alpha1.Listener) (result *v1alpha1.Listen
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *listeners) UpdateStatus(listener *v1alpha1.Listener) (result *v1alpha1.Listener, err error) {
+	result = &v1alpha1.Listener{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("listeners").
+		Name(listener.Name).
+		SubResource("status").
+		Body(listener).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the listener and deletes it. Returns an error if one occurs.
 func (c *listeners) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -34,6 +34,7 @@ type LoadBalancersGetter interface {
 type LoadBalancerInterface interface {
 	Create(*v1alpha1.LoadBalancer) (*v1alpha1.LoadBalancer, error)
 	Update(*v1alpha1.LoadBalancer) (*v1alpha1.LoadBalancer, error)
+	UpdateStatus(*v1alpha1.LoadBalancer) (*v1alpha1.LoadBalancer, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.LoadBalancer, error)
@@ -117,6 +118,22 @@ func (c *loadBalancers) Update(loadBalancer *v1alpha1.LoadBalancer) (result *v1a
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *loadBalancers) UpdateStatus(loadBalancer *v1alpha1.LoadBalancer) (result *v1alpha1.LoadBalancer, err error) {
+	result = &v1alpha1.LoadBalancer{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("loadbalancers").
+		Name(loadBalancer.Name).
+		SubResource("status").
+		Body(loadBalancer).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the loadBalancer and deletes it. Returns an error if one occurs.
 func (c *loadBalancers) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -19,10 +19,15 @@ import (
 	"fmt"
 	v1alpha1 "github.com/oracle/oci-manager/pkg/apis/cloud.k8s.io/v1alpha1"
 	ocice_oracle_com_v1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1alpha1"
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1beta1"
 	ocicore_oracle_com_v1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocicore_oracle_com_v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
 	ocidb_oracle_com_v1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1alpha1"
+	ocidb_oracle_com_v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1beta1"
 	ociidentity_oracle_com_v1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	ociidentity_oracle_com_v1beta1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1beta1"
 	ocilb_oracle_com_v1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	ocilb_oracle_com_v1beta1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -75,6 +80,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case ocice_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("nodepools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocice().V1alpha1().NodePools().Informer()}, nil
 
+		// Group=ocice.oracle.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("clusters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocice().V1beta1().Clusters().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("nodepools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocice().V1beta1().NodePools().Informer()}, nil
+
 		// Group=ocicore.oracle.com, Version=v1alpha1
 	case ocicore_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("dhcpoptions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1alpha1().DhcpOptions().Informer()}, nil
@@ -95,10 +106,34 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case ocicore_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("volumebackups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1alpha1().VolumeBackups().Informer()}, nil
 
+		// Group=ocicore.oracle.com, Version=v1beta1
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("dhcpoptions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().DhcpOptions().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("instances"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().Instances().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("internetgatewaies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().InternetGatewaies().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("routetables"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().RouteTables().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("securityrulesets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().SecurityRuleSets().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("subnets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().Subnets().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("vcns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().Vcns().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("volumes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().Volumes().Informer()}, nil
+	case ocicore_oracle_com_v1beta1.SchemeGroupVersion.WithResource("volumebackups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocicore().V1beta1().VolumeBackups().Informer()}, nil
+
 		// Group=ocidb.oracle.com, Version=v1alpha1
 	case ocidb_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("autonomousdatabases"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocidb().V1alpha1().AutonomousDatabases().Informer()}, nil
 
+		// Group=ocidb.oracle.com, Version=v1beta1
+	case ocidb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("autonomousdatabases"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocidb().V1beta1().AutonomousDatabases().Informer()}, nil
+
 		// Group=ociidentity.oracle.com, Version=v1alpha1
 	case ociidentity_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("compartments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ociidentity().V1alpha1().Compartments().Informer()}, nil
@@ -107,6 +142,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case ociidentity_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("policies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ociidentity().V1alpha1().Policies().Informer()}, nil
 
+		// Group=ociidentity.oracle.com, Version=v1beta1
+	case ociidentity_oracle_com_v1beta1.SchemeGroupVersion.WithResource("compartments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ociidentity().V1beta1().Compartments().Informer()}, nil
+	case ociidentity_oracle_com_v1beta1.SchemeGroupVersion.WithResource("dynamicgroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ociidentity().V1beta1().DynamicGroups().Informer()}, nil
+	case ociidentity_oracle_com_v1beta1.SchemeGroupVersion.WithResource("policies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ociidentity().V1beta1().Policies().Informer()}, nil
+
 		// Group=ocilb.oracle.com, Version=v1alpha1
 	case ocilb_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("backends"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1alpha1().Backends().Informer()}, nil
@@ -119,6 +162,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case ocilb_oracle_com_v1alpha1.SchemeGroupVersion.WithResource("loadbalancers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1alpha1().LoadBalancers().Informer()}, nil
 
+		// Group=ocilb.oracle.com, Version=v1beta1
+	case ocilb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("backends"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1beta1().Backends().Informer()}, nil
+	case ocilb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("backendsets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1beta1().BackendSets().Informer()}, nil
+	case ocilb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("certificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1beta1().Certificates().Informer()}, nil
+	case ocilb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("listeners"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1beta1().Listeners().Informer()}, nil
+	case ocilb_oracle_com_v1beta1.SchemeGroupVersion.WithResource("loadbalancers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ocilb().V1beta1().LoadBalancers().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
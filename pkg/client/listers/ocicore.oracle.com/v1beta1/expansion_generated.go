@@ -0,0 +1,88 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+// DhcpOptionListerExpansion allows custom methods to be added to
+// DhcpOptionLister.
+type DhcpOptionListerExpansion interface{}
+
+// DhcpOptionNamespaceListerExpansion allows custom methods to be added to
+// DhcpOptionNamespaceLister.
+type DhcpOptionNamespaceListerExpansion interface{}
+
+// InstanceListerExpansion allows custom methods to be added to
+// InstanceLister.
+type InstanceListerExpansion interface{}
+
+// InstanceNamespaceListerExpansion allows custom methods to be added to
+// InstanceNamespaceLister.
+type InstanceNamespaceListerExpansion interface{}
+
+// InternetGatewayListerExpansion allows custom methods to be added to
+// InternetGatewayLister.
+type InternetGatewayListerExpansion interface{}
+
+// InternetGatewayNamespaceListerExpansion allows custom methods to be added to
+// InternetGatewayNamespaceLister.
+type InternetGatewayNamespaceListerExpansion interface{}
+
+// RouteTableListerExpansion allows custom methods to be added to
+// RouteTableLister.
+type RouteTableListerExpansion interface{}
+
+// RouteTableNamespaceListerExpansion allows custom methods to be added to
+// RouteTableNamespaceLister.
+type RouteTableNamespaceListerExpansion interface{}
+
+// SecurityRuleSetListerExpansion allows custom methods to be added to
+// SecurityRuleSetLister.
+type SecurityRuleSetListerExpansion interface{}
+
+// SecurityRuleSetNamespaceListerExpansion allows custom methods to be added to
+// SecurityRuleSetNamespaceLister.
+type SecurityRuleSetNamespaceListerExpansion interface{}
+
+// SubnetListerExpansion allows custom methods to be added to
+// SubnetLister.
+type SubnetListerExpansion interface{}
+
+// SubnetNamespaceListerExpansion allows custom methods to be added to
+// SubnetNamespaceLister.
+type SubnetNamespaceListerExpansion interface{}
+
+// VcnListerExpansion allows custom methods to be added to
+// VcnLister.
+type VcnListerExpansion interface{}
+
+// VcnNamespaceListerExpansion allows custom methods to be added to
+// VcnNamespaceLister.
+type VcnNamespaceListerExpansion interface{}
+
+// VolumeListerExpansion allows custom methods to be added to
+// VolumeLister.
+type VolumeListerExpansion interface{}
+
+// VolumeNamespaceListerExpansion allows custom methods to be added to
+// VolumeNamespaceLister.
+type VolumeNamespaceListerExpansion interface{}
+
+// VolumeBackupListerExpansion allows custom methods to be added to
+// VolumeBackupLister.
+type VolumeBackupListerExpansion interface{}
+
+// VolumeBackupNamespaceListerExpansion allows custom methods to be added to
+// VolumeBackupNamespaceLister.
+type VolumeBackupNamespaceListerExpansion interface{}
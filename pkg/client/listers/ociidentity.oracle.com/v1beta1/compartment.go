@@ -0,0 +1,91 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+import (
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CompartmentLister helps list Compartments.
+type CompartmentLister interface {
+	// List lists all Compartments in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.Compartment, err error)
+	// Compartments returns an object that can list and get Compartments.
+	Compartments(namespace string) CompartmentNamespaceLister
+	CompartmentListerExpansion
+}
+
+// compartmentLister implements the CompartmentLister interface.
+type compartmentLister struct {
+	indexer cache.Indexer
+}
+
+// NewCompartmentLister returns a new CompartmentLister.
+func NewCompartmentLister(indexer cache.Indexer) CompartmentLister {
+	return &compartmentLister{indexer: indexer}
+}
+
+// List lists all Compartments in the indexer.
+func (s *compartmentLister) List(selector labels.Selector) (ret []*v1beta1.Compartment, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Compartment))
+	})
+	return ret, err
+}
+
+// Compartments returns an object that can list and get Compartments.
+func (s *compartmentLister) Compartments(namespace string) CompartmentNamespaceLister {
+	return compartmentNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// CompartmentNamespaceLister helps list and get Compartments.
+type CompartmentNamespaceLister interface {
+	// List lists all Compartments in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1beta1.Compartment, err error)
+	// Get retrieves the Compartment from the indexer for a given namespace and name.
+	Get(name string) (*v1beta1.Compartment, error)
+	CompartmentNamespaceListerExpansion
+}
+
+// compartmentNamespaceLister implements the CompartmentNamespaceLister
+// interface.
+type compartmentNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Compartments in the indexer for a given namespace.
+func (s compartmentNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.Compartment, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Compartment))
+	})
+	return ret, err
+}
+
+// Get retrieves the Compartment from the indexer for a given namespace and name.
+func (s compartmentNamespaceLister) Get(name string) (*v1beta1.Compartment, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("compartment"), name)
+	}
+	return obj.(*v1beta1.Compartment), nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+import (
+	v1beta1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DynamicGroupLister helps list DynamicGroups.
+type DynamicGroupLister interface {
+	// List lists all DynamicGroups in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.DynamicGroup, err error)
+	// DynamicGroups returns an object that can list and get DynamicGroups.
+	DynamicGroups(namespace string) DynamicGroupNamespaceLister
+	DynamicGroupListerExpansion
+}
+
+// dynamicGroupLister implements the DynamicGroupLister interface.
+type dynamicGroupLister struct {
+	indexer cache.Indexer
+}
+
+// NewDynamicGroupLister returns a new DynamicGroupLister.
+func NewDynamicGroupLister(indexer cache.Indexer) DynamicGroupLister {
+	return &dynamicGroupLister{indexer: indexer}
+}
+
+// List lists all DynamicGroups in the indexer.
+func (s *dynamicGroupLister) List(selector labels.Selector) (ret []*v1beta1.DynamicGroup, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DynamicGroup))
+	})
+	return ret, err
+}
+
+// DynamicGroups returns an object that can list and get DynamicGroups.
+func (s *dynamicGroupLister) DynamicGroups(namespace string) DynamicGroupNamespaceLister {
+	return dynamicGroupNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DynamicGroupNamespaceLister helps list and get DynamicGroups.
+type DynamicGroupNamespaceLister interface {
+	// List lists all DynamicGroups in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1beta1.DynamicGroup, err error)
+	// Get retrieves the DynamicGroup from the indexer for a given namespace and name.
+	Get(name string) (*v1beta1.DynamicGroup, error)
+	DynamicGroupNamespaceListerExpansion
+}
+
+// dynamicGroupNamespaceLister implements the DynamicGroupNamespaceLister
+// interface.
+type dynamicGroupNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DynamicGroups in the indexer for a given namespace.
+func (s dynamicGroupNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.DynamicGroup, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DynamicGroup))
+	})
+	return ret, err
+}
+
+// Get retrieves the DynamicGroup from the indexer for a given namespace and name.
+func (s dynamicGroupNamespaceLister) Get(name string) (*v1beta1.DynamicGroup, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("dynamicgroup"), name)
+	}
+	return obj.(*v1beta1.DynamicGroup), nil
+}
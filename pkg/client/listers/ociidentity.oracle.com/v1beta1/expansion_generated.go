@@ -0,0 +1,40 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1beta1
+
+// CompartmentListerExpansion allows custom methods to be added to
+// CompartmentLister.
+type CompartmentListerExpansion interface{}
+
+// CompartmentNamespaceListerExpansion allows custom methods to be added to
+// CompartmentNamespaceLister.
+type CompartmentNamespaceListerExpansion interface{}
+
+// DynamicGroupListerExpansion allows custom methods to be added to
+// DynamicGroupLister.
+type DynamicGroupListerExpansion interface{}
+
+// DynamicGroupNamespaceListerExpansion allows custom methods to be added to
+// DynamicGroupNamespaceLister.
+type DynamicGroupNamespaceListerExpansion interface{}
+
+// PolicyListerExpansion allows custom methods to be added to
+// PolicyLister.
+type PolicyListerExpansion interface{}
+
+// PolicyNamespaceListerExpansion allows custom methods to be added to
+// PolicyNamespaceLister.
+type PolicyNamespaceListerExpansion interface{}
@@ -75,6 +75,8 @@ func init() {
 		cloudv1alpha1.ClusterKind,
 		cloudv1alpha1.GroupName,
 		&cloudv1alpha1.ClusterValidation,
+		common.PrinterColumns(),
+		nil,
 		NewClusterAdapter,
 	)
 }
@@ -90,3 +90,24 @@ func FilteredConditions(conditions []cloudv1alpha1.OperatorCondition, conditionT
 func RemoveCondition(status *cloudv1alpha1.OperatorStatus, conditionType cloudv1alpha1.OperatorConditionType) {
 	status.Conditions = FilteredConditions(status.Conditions, conditionType)
 }
+
+// OperatorStatusOf returns the operator status embedded in a cloud object or nil for other objects
+func OperatorStatusOf(obj interface{}) *cloudv1alpha1.OperatorStatus {
+	switch o := obj.(type) {
+	case *cloudv1alpha1.Cluster:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.Compute:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.Cpod:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.LoadBalancer:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.Network:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.Security:
+		return &o.Status.OperatorStatus
+	case *cloudv1alpha1.Storage:
+		return &o.Status.OperatorStatus
+	}
+	return nil
+}
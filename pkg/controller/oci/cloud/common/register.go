@@ -21,19 +21,23 @@ import (
 	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 )
 
-// CloudType configures kubernetes for a cloud workload type
+// CloudType configures kubernetes for a cloud workload type. The cloud controller writes the
+// status together with the object, so cloud types have no status subresource.
 type CloudType struct {
 	GroupName      string
 	Kind           string
 	ResourcePlural string
 	Validation     *apiextv1beta1.CustomResourceValidation
+	PrinterColumns []apiextv1beta1.CustomResourceColumnDefinition
+	Subresources   *apiextv1beta1.CustomResourceSubresources
 	AdapterFactory AdapterFactory
 }
 
 var typeRegistry = make(map[string]CloudType)
 
 //Register cloud type adpater factory
-func RegisterCloudType(plural, kind, groupName string, validation *apiextv1beta1.CustomResourceValidation, factory AdapterFactory) {
+func RegisterCloudType(plural, kind, groupName string, validation *apiextv1beta1.CustomResourceValidation,
+	columns []apiextv1beta1.CustomResourceColumnDefinition, subresources *apiextv1beta1.CustomResourceSubresources, factory AdapterFactory) {
 	_, ok := typeRegistry[kind]
 	if ok {
 		// TODO Is panicking ok given that this is part of a type-registration mechanism
@@ -44,6 +48,8 @@ func RegisterCloudType(plural, kind, groupName string, validation *apiextv1beta1
 		ResourcePlural: plural,
 		GroupName:      groupName,
 		Validation:     validation,
+		PrinterColumns: columns,
+		Subresources:   subresources,
 		AdapterFactory: factory,
 	}
 }
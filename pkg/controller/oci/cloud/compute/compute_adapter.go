@@ -32,6 +32,7 @@ import (
 	lbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
 	"github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 	cloudcommon "github.com/oracle/oci-manager/pkg/controller/oci/cloud/common"
+	apiextv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -55,6 +56,17 @@ func init() {
 		cloudv1alpha1.ComputeKind,
 		cloudv1alpha1.GroupName,
 		&cloudv1alpha1.ComputeValidation,
+		common.PrinterColumns(
+			apiextv1beta1.CustomResourceColumnDefinition{Name: "Replicas", Type: "integer", JSONPath: ".spec.replicas"},
+			apiextv1beta1.CustomResourceColumnDefinition{Name: "Ready", Type: "integer", JSONPath: ".status.readyReplicas"},
+		),
+		// kubectl scale sets the replicas of the spec
+		&apiextv1beta1.CustomResourceSubresources{
+			Scale: &apiextv1beta1.CustomResourceSubresourceScale{
+				SpecReplicasPath:   ".spec.replicas",
+				StatusReplicasPath: ".status.replicas",
+			},
+		},
 		NewComputeAdapter,
 	)
 }
@@ -337,22 +349,21 @@ func (a *ComputeAdapter) Reconcile(obj runtime.Object) (runtime.Object, error) {
 		if err != nil {
 			glog.Errorf("could not list instances: %v", err)
 		} else {
+			backends, err := a.clientset.OcilbV1alpha1().Backends(compute.Namespace).List(metav1.ListOptions{})
+			if err != nil {
+				glog.Errorf("could not list backends: %v", err)
+				backends = &lbv1alpha1.BackendList{}
+			}
 			for _, instance := range instances.Items {
 				if _, ok := instanceNameMap[instance.Name]; ok {
 					// do nothing - should be there
 				} else {
-					if instance.Status.Dependents != nil {
-						for kind, vals := range instance.Status.Dependents {
-							if kind == lbv1alpha1.BackendKind {
-								for _, val := range vals {
-									depParts := strings.Split(val, "/")
-									backend := depParts[1]
-									glog.Infof("deleting backend: %s for orphan instance: %s", backend, instance.Name)
-									err = a.clientset.OcilbV1alpha1().Backends(compute.Namespace).Delete(backend, nil)
-									if err != nil {
-										glog.Errorf("could not delete orphan backend: %s - err: %v", backend, err)
-									}
-								}
+					for _, backend := range backends.Items {
+						if backend.Spec.InstanceRef == instance.Name {
+							glog.Infof("deleting backend: %s for orphan instance: %s", backend.Name, instance.Name)
+							err = a.clientset.OcilbV1alpha1().Backends(compute.Namespace).Delete(backend.Name, nil)
+							if err != nil {
+								glog.Errorf("could not delete orphan backend: %s - err: %v", backend.Name, err)
 							}
 						}
 					}
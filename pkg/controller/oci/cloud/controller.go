@@ -19,14 +19,19 @@ package cloud
 import (
 	"fmt"
 	"k8s.io/client-go/kubernetes"
+	"reflect"
 	"time"
 
 	cloudv1alpha1 "github.com/oracle/oci-manager/pkg/apis/cloud.k8s.io/v1alpha1"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	vclientset "github.com/oracle/oci-manager/pkg/client/clientset/versioned"
+	clientsetScheme "github.com/oracle/oci-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
 	cloudcommon "github.com/oracle/oci-manager/pkg/controller/oci/cloud/common"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -34,12 +39,22 @@ import (
 	"github.com/golang/glog"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+
+	corev1 "k8s.io/api/core/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
 	MAX_REQUEUES                   = 5
 	CloudProivderAnnotation string = "cloud-provider"
 	OCIProviderValue        string = "oci"
+
+	eventTypePaused       = "Paused"
+	reasonPaused          = "Paused"
+	reasonNamespacePaused = "NamespacePaused"
 )
 
 type Controller struct {
@@ -47,6 +62,11 @@ type Controller struct {
 	queueMap map[string]workqueue.RateLimitingInterface
 	informer cache.SharedInformer
 	adapter  cloudcommon.CloudTypeAdapter
+	recorder record.EventRecorder
+
+	namespaces corelisters.NamespaceLister
+	// listers of the subscribed resources, used to refresh the status of paused objects
+	children []cache.GenericLister
 	//cloudIfactory  informers.SharedInformerFactory
 	//resourceIfactory  informers.SharedInformerFactory
 }
@@ -55,6 +75,7 @@ func New(adapterFactory cloudcommon.AdapterFactory,
 	clientSet vclientset.Interface,
 	kubeclient kubernetes.Interface,
 	cloudIFactory, resourceIFactory informers.SharedInformerFactory,
+	namespaces coreinformers.NamespaceInformer,
 	queueMap map[string]workqueue.RateLimitingInterface) *Controller {
 
 	adapter := adapterFactory(clientSet, kubeclient)
@@ -65,6 +86,10 @@ func New(adapterFactory cloudcommon.AdapterFactory,
 		queueMap: queueMap,
 	}
 
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeclient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(clientsetScheme.Scheme, corev1.EventSource{Component: "cloud-" + adapter.Kind()})
+
 	cloudInfomer, err := cloudIFactory.ForResource(adapter.GroupVersionWithResource())
 
 	if err != nil {
@@ -102,6 +127,24 @@ func New(adapterFactory cloudcommon.AdapterFactory,
 			glog.Fatalf("Error building subscriber informer for resource: %s - %v", subResource, err)
 		}
 		informer.Informer().AddEventHandler(adapter.CallbackForResource(subResource))
+		c.children = append(c.children, informer.Lister())
+	}
+
+	if namespaces != nil {
+		c.namespaces = namespaces.Lister()
+		namespaces.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, cur interface{}) {
+				oldns, curns := old.(*corev1.Namespace), cur.(*corev1.Namespace)
+				if ocicommon.IsPaused(oldns) == ocicommon.IsPaused(curns) {
+					return
+				}
+				for _, key := range c.informer.GetStore().ListKeys() {
+					if ns, _, err := cache.SplitMetaNamespaceKey(key); err == nil && ns == curns.Name {
+						c.queue.Add(key)
+					}
+				}
+			},
+		})
 	}
 
 	return c
@@ -129,8 +172,8 @@ func (c *Controller) queueUsingAnnotation(obj interface{}, key string) {
 	}
 }
 
-// Run turns on the controller
-func (c *Controller) Run(stopCh <-chan struct{}) {
+// Run turns on the controller with the given number of workers
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
 	go c.informer.Run(stopCh)
@@ -140,8 +183,13 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		return
 	}
 
-	// TODO add multiple workers for performance
-	go wait.Until(c.worker, time.Second, stopCh)
+	if workers < 1 {
+		workers = 1
+	}
+	glog.V(2).Infof("Starting %d workers for %s", workers, c.adapter.Kind())
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
 
 	go func() {
 		<-stopCh
@@ -232,6 +280,18 @@ func (c *Controller) reconcile(key string) (runtime.Object, error) {
 
 	objectmeta := c.adapter.ObjectMeta(object)
 
+	// a paused cloud object is neither reconciled nor deleted, its status is still
+	// refreshed from the resources it owns and the Paused condition is kept
+	if paused, reason := c.isPaused(objectmeta); paused {
+		c.refreshStatus(object, objectmeta)
+		c.pause(kind, key, object, reason)
+		if reflect.DeepEqual(cloudcommon.OperatorStatusOf(source), cloudcommon.OperatorStatusOf(object)) {
+			return nil, nil
+		}
+		return object, nil
+	}
+	resumed := c.resume(kind, key, object)
+
 	if objectmeta.DeletionTimestamp != nil {
 
 		object, err = c.adapter.Delete(object)
@@ -257,9 +317,97 @@ func (c *Controller) reconcile(key string) (runtime.Object, error) {
 		glog.Errorf("ERROR reconcile cloud object kind %s and key %s: %#v\n", kind, key, err)
 	}
 
-	if c.adapter.Equivalent(source, reconciled) {
+	if !resumed && c.adapter.Equivalent(source, reconciled) {
 		glog.V(4).Infof("Match cloud object %s  %s\n", kind, key)
 		return nil, nil
 	}
 	return reconciled, nil
 }
+
+// pause sets the Paused condition on cloud objects that have a status
+func (c *Controller) pause(kind, key string, object runtime.Object, reason string) {
+	status := cloudcommon.OperatorStatusOf(object)
+	if status == nil {
+		return
+	}
+	condition := cloudcommon.GetCondition(status, cloudv1alpha1.OperatorConditionPaused)
+	if condition != nil && condition.Reason == reason {
+		return
+	}
+	if condition == nil {
+		glog.V(1).Infof("Reconcile of cloud object %s  %s is paused (%s)\n", kind, key, reason)
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypePaused, fmt.Sprintf("Paused reconcile of cloud object %s  %s", kind, key))
+	}
+	cloudcommon.SetCondition(status, cloudv1alpha1.OperatorConditionPaused, reason)
+}
+
+// refreshStatus sets the State of the cloud object from the resources it controls
+// without creating, updating or deleting any of them
+func (c *Controller) refreshStatus(object runtime.Object, objectmeta metav1.Object) {
+	status := cloudcommon.OperatorStatusOf(object)
+	if status == nil {
+		return
+	}
+	owned, pending := 0, 0
+	for _, lister := range c.children {
+		children, err := lister.ByNamespace(objectmeta.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			glog.Errorf("ERROR listing resources of cloud object %s/%s: %v\n", objectmeta.GetNamespace(), objectmeta.GetName(), err)
+			return
+		}
+		for _, child := range children {
+			childmeta, err := meta.Accessor(child)
+			if err != nil || !metav1.IsControlledBy(childmeta, objectmeta) {
+				continue
+			}
+			owned++
+			if !isReady(child) {
+				pending++
+			}
+		}
+	}
+	if owned == 0 {
+		return
+	}
+	if pending == 0 {
+		status.State = cloudv1alpha1.OperatorStateCreated
+	} else {
+		status.State = cloudv1alpha1.OperatorStatePending
+	}
+}
+
+// isReady reports if a resource is processed or a cloud object is created
+func isReady(obj runtime.Object) bool {
+	if resource, ok := obj.(ocicommon.ObjectInterface); ok {
+		return resource.GetResourceState() == ocicommon.ResourceStateProcessed && resource.IsResource()
+	}
+	if status := cloudcommon.OperatorStatusOf(obj); status != nil {
+		return status.State == cloudv1alpha1.OperatorStateCreated
+	}
+	return true
+}
+
+// resume removes the Paused condition, it reports if there was one
+func (c *Controller) resume(kind, key string, object runtime.Object) bool {
+	status := cloudcommon.OperatorStatusOf(object)
+	if status == nil || cloudcommon.GetCondition(status, cloudv1alpha1.OperatorConditionPaused) == nil {
+		return false
+	}
+	cloudcommon.RemoveCondition(status, cloudv1alpha1.OperatorConditionPaused)
+	c.recorder.Event(object, corev1.EventTypeNormal, eventTypePaused, fmt.Sprintf("Resumed reconcile of cloud object %s  %s", kind, key))
+	return true
+}
+
+// isPaused reports if the cloud object is paused by its own or its namespace annotation
+func (c *Controller) isPaused(objectmeta metav1.Object) (bool, string) {
+	if ocicommon.IsPaused(objectmeta) {
+		return true, reasonPaused
+	}
+	if c.namespaces == nil || objectmeta.GetNamespace() == "" {
+		return false, ""
+	}
+	if ns, err := c.namespaces.Get(objectmeta.GetNamespace()); err == nil && ocicommon.IsPaused(ns) {
+		return true, reasonNamespacePaused
+	}
+	return false, ""
+}
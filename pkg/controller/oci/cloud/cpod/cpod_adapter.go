@@ -55,6 +55,8 @@ func init() {
 		cloudv1alpha1.CpodResourcePlural,
 		cloudv1alpha1.CpodKind,
 		cloudv1alpha1.GroupName,
+		&cloudv1alpha1.CpodValidation,
+		ocicommon.PrinterColumns(),
 		nil,
 		NewCpodAdapter,
 	)
@@ -60,6 +60,8 @@ func init() {
 		cloudv1alpha1.LoadBalancerKind,
 		cloudv1alpha1.GroupName,
 		&cloudv1alpha1.LoadBalancerValidation,
+		common.PrinterColumns(common.IPColumn(".status.ipAddress")),
+		nil,
 		NewLoadBalancerAdapter,
 	)
 }
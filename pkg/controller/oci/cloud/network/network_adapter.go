@@ -44,6 +44,8 @@ func init() {
 		cloudv1alpha1.NetworkKind,
 		cloudv1alpha1.GroupName,
 		&cloudv1alpha1.NetworkValidation,
+		common.PrinterColumns(),
+		nil,
 		NewNetworkAdapter,
 	)
 }
@@ -42,6 +42,8 @@ func init() {
 		cloudv1alpha1.SecurityKind,
 		cloudv1alpha1.GroupName,
 		&cloudv1alpha1.SecurityValidation,
+		common.PrinterColumns(),
+		nil,
 		NewSecurityAdapter,
 	)
 }
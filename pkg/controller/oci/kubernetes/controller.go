@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"k8s.io/client-go/kubernetes"
 	"reflect"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,9 +30,14 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	"github.com/golang/glog"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	clientset "github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	kubecommon "github.com/oracle/oci-manager/pkg/controller/oci/kubernetes/common"
 )
@@ -39,6 +45,8 @@ import (
 // OciGroupName constant is used for finalizers string
 const (
 	OciGroupName = "oci.oracle.com"
+
+	eventTypePaused = "Paused"
 )
 
 // Controller of resource create/update/delete events
@@ -48,6 +56,12 @@ type Controller struct {
 	informer cache.SharedInformer
 	adapter  kubecommon.KubernetesTypeAdapter
 	factory  informers.SharedInformerFactory
+	recorder record.EventRecorder
+
+	// Namespaces have no conditions to record a Paused condition in, the paused
+	// keys are tracked here to emit an event when the state changes
+	pausedLock sync.Mutex
+	paused     map[string]bool
 }
 
 // Start a new controller for a type adapter
@@ -60,15 +74,17 @@ func Start(
 	informerFactory informers.SharedInformerFactory,
 	stopChan <-chan struct{},
 	queueMap map[string]workqueue.RateLimitingInterface,
+	workers int,
 ) *Controller {
 	adapter := adapterFactory(clientset, kubeclient, adapterSpecificArgs)
-	controller := New(adapter, watchType, informerFactory, queueMap)
-	controller.Run(stopChan)
+	controller := New(adapter, kubeclient, watchType, informerFactory, queueMap)
+	controller.Run(workers, stopChan)
 	return controller
 }
 
 // New initializes a controller object
 func New(adapter kubecommon.KubernetesTypeAdapter,
+	kubeclient kubernetes.Interface,
 	objectType runtime.Object,
 	informerFactory informers.SharedInformerFactory,
 	queueMap map[string]workqueue.RateLimitingInterface) *Controller {
@@ -80,8 +96,13 @@ func New(adapter kubecommon.KubernetesTypeAdapter,
 		factory:  informerFactory,
 		queue:    queueMap[kind],
 		queueMap: queueMap,
+		paused:   make(map[string]bool),
 	}
 
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeclient.CoreV1().Events("")})
+	c.recorder = eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: adapter.Kind()})
+
 	// using explicit group version kind via adapter due to these are empty:
 	// 	glog.Infof("Group: %s Version: %s Resource: %s",  objectType.GetObjectKind().GroupVersionKind().Group,
 	//		objectType.GetObjectKind().GroupVersionKind().Version,  objectType.GetObjectKind().GroupVersionKind().Kind)
@@ -116,8 +137,8 @@ func New(adapter kubecommon.KubernetesTypeAdapter,
 	return c
 }
 
-// Run turns on the controller
-func (c *Controller) Run(stopCh <-chan struct{}) {
+// Run turns on the controller with the given number of workers
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
 	go c.informer.Run(stopCh)
@@ -127,8 +148,13 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		return
 	}
 
-	// TODO add multiple workers for performance
-	go wait.Until(c.worker, time.Second, stopCh)
+	if workers < 1 {
+		workers = 1
+	}
+	glog.V(2).Infof("Starting %d workers for %s", workers, c.adapter.Kind())
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
 
 	go func() {
 		<-stopCh
@@ -220,6 +246,9 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	}
 
 	if !exists {
+		c.pausedLock.Lock()
+		delete(c.paused, key)
+		c.pausedLock.Unlock()
 		return nil, nil, false
 	}
 
@@ -232,12 +261,22 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 		return object, nil, false
 	}
 
+	// Paused
+	// The object itself carries the annotation, i.e. a paused Namespace
+	// neither creates nor updates its compartment. The Get below still runs
+	// so the object keeps reflecting the current resource
+	paused := c.setPaused(key, ocicommon.IsPaused(objectmeta), object)
+
 	// Create
 	// The Id indicates if the resource is already created or it's pending.
 	// After setting the finalizer we return to start the reconcile processing
 	// from the beginning since the object was updated. It should be skipped on
 	// the next loop and proceed with the Get below to validate the create
 	if c.adapter.Id(object) == "" {
+		if paused {
+			glog.V(2).Infof("Create of %s  %s is paused\n", kind, key)
+			return nil, nil, false
+		}
 		glog.V(1).Infof("Creating resource %s  %s \n", kind, key)
 		glog.V(5).Infof("Creating resource %s  %s --- %#v\n", kind, key, object)
 		object, err = c.adapter.Create(object)
@@ -266,6 +305,10 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	// that needs to be corrected since we are the source of truth for the resource
 
 	if object != nil && !c.adapter.IsCompliant(object) {
+		if paused {
+			glog.V(2).Infof("Update of %s  %s is paused\n", kind, key)
+			return object, nil, false
+		}
 		glog.V(1).Infof("Updating resource %s  %s %#v\n", kind, key, object)
 		//this updates underlying oci resource not the crd
 		// crd will get updated in report func
@@ -282,3 +325,21 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	return nil, nil, false
 
 }
+
+// setPaused tracks the paused state of key and emits an event when it changes
+func (c *Controller) setPaused(key string, paused bool, object runtime.Object) bool {
+	c.pausedLock.Lock()
+	defer c.pausedLock.Unlock()
+
+	if c.paused[key] == paused {
+		return paused
+	}
+	if paused {
+		c.paused[key] = true
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypePaused, fmt.Sprintf("Paused reconcile of %s  %s", c.adapter.Kind(), key))
+	} else {
+		delete(c.paused, key)
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypePaused, fmt.Sprintf("Resumed reconcile of %s  %s", c.adapter.Kind(), key))
+	}
+	return paused
+}
@@ -49,6 +49,7 @@ func init() {
 		ocicev1alpha1.ClusterResourcePlural,
 		ocicev1alpha1.ClusterControllerName,
 		&ocicev1alpha1.ClusterValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewClusterAdapter)
 }
 
@@ -72,17 +73,24 @@ func NewClusterAdapter(clientset versioned.Interface, kubeclient kubernetes.Inte
 		glog.Errorf("Error creating oci ContainerEngine client: %v", err)
 		os.Exit(1)
 	}
-	ca.ceClient = &ceClient
+	ca.ceClient = resourcescommon.InstrumentContainerEngineClient(&ceClient)
 
 	vcnClient, err := ocisdkcore.NewVirtualNetworkClientWithConfigurationProvider(ociconfig)
 	if err != nil {
 		glog.Errorf("Error creating oci VCN client: %v", err)
 		os.Exit(1)
 	}
-	ca.vcnClient = &vcnClient
+	ca.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	return &ca
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *ClusterAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *ClusterAdapter) Kind() string {
 	return ocicev1alpha1.ClusterKind
@@ -121,24 +129,26 @@ func (a *ClusterAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceComplient checks if resource config is complient with CRD spec
-func (a *ClusterAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *ClusterAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	cluster := obj.(*ocicev1alpha1.Cluster)
 
 	if cluster.Status.WorkRequestId != nil {
 		glog.Infof("cluster has workrequest - isResourceCompliant: false")
-		return false
+		return false, nil
 	}
 
 	if cluster.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
-	if cluster.Status.KubeConfig == nil || *cluster.Status.KubeConfig == "" ||
-		*cluster.Spec.KubernetesVersion != *cluster.Status.Resource.KubernetesVersion {
-		return false
+	if cluster.Status.KubeConfig == nil || *cluster.Status.KubeConfig == "" {
+		return false, nil
 	}
 
-	return true
+	var drift resourcescommon.Drift
+	drift.Check("kubernetesVersion", cluster.Spec.KubernetesVersion, cluster.Status.Resource.KubernetesVersion)
+
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two cluster objects are the same
@@ -168,11 +178,6 @@ func (a *ClusterAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Depe
 	return obj.(*ocicev1alpha1.Cluster).Spec.DependsOn
 }
 
-// Dependents returns a map of cluster dependents (objects that depend on the cluster)
-func (a *ClusterAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicev1alpha1.Cluster).Status.Dependents
-}
-
 // CreateObject creates the cluster object
 func (a *ClusterAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicev1alpha1.Cluster)
@@ -185,6 +190,12 @@ func (a *ClusterAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error
 	return a.clientset.OciceV1alpha1().Clusters(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the cluster object
+func (a *ClusterAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicev1alpha1.Cluster)
+	return a.clientset.OciceV1alpha1().Clusters(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the cluster object
 func (a *ClusterAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var be = obj.(*ocicev1alpha1.Cluster)
@@ -233,30 +244,24 @@ func (a *ClusterAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 	if cluster.Status.WorkRequestId != nil {
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: cluster.Status.WorkRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &cluster.Status.ResourceStatus, cluster.Status.WorkRequestId)
+		if workResp.Status != "" {
+			cluster.Status.WorkRequestStatus = &workResp.Status
+		}
 		if e != nil {
-			glog.Errorf("CreateCluster GetWorkRequest error: %v", e)
+			glog.Errorf("CreateCluster work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				cluster.Status.WorkRequestId = nil
+				cluster.Status.WorkRequestStatus = nil
+				return cluster, nil
+			}
 			return cluster, cluster.Status.HandleError(e)
 		}
 
 		glog.V(4).Infof("CreateCluster workResp state: %s", workResp.Status)
 
-		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded &&
-			workResp.Status != ocisdkce.WorkRequestStatusFailed {
-
-			if workResp.Status != *cluster.Status.WorkRequestStatus {
-				cluster.Status.WorkRequestStatus = &workResp.Status
-				return cluster, nil
-			} else {
-				return nil, nil
-			}
-		}
-
-		if workResp.Status == ocisdkce.WorkRequestStatusFailed {
-			cluster.Status.WorkRequestStatus = &workResp.Status
-			err := fmt.Errorf("WorkRequest %s is in failed state", *cluster.Status.WorkRequestId)
-			return cluster, cluster.Status.HandleError(err)
+		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
+			return cluster, nil
 		}
 		cluster.Status.WorkRequestId = nil
 		cluster.Status.WorkRequestStatus = nil
@@ -317,6 +322,7 @@ func (a *ClusterAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 			workRequestId := *createResponse.OpcWorkRequestId
 			glog.V(4).Infof("CreateCluster workRequestId: %s", workRequestId)
 			cluster.Status.WorkRequestId = createResponse.OpcWorkRequestId
+			resourcescommon.StartWorkRequest(&cluster.Status.ResourceStatus, "containerengine", "CreateCluster", createResponse.OpcWorkRequestId)
 			stateAccepted := ocisdkce.WorkRequestStatusAccepted
 			cluster.Status.WorkRequestStatus = &stateAccepted
 			return cluster, nil
@@ -373,28 +379,22 @@ func (a *ClusterAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	if cluster.Status.WorkRequestId != nil {
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: cluster.Status.WorkRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			return cluster, cluster.Status.HandleError(e)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &cluster.Status.ResourceStatus, cluster.Status.WorkRequestId)
+		if workResp.Status != "" {
+			cluster.Status.WorkRequestStatus = &workResp.Status
 		}
-		cluster.Status.WorkRequestStatus = &workResp.Status
-
-		if workResp.Status != ocisdkce.WorkRequestStatusFailed &&
-			workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
-
-			if workResp.Status != *cluster.Status.WorkRequestStatus {
-				cluster.Status.WorkRequestStatus = &workResp.Status
+		if e != nil {
+			glog.Errorf("UpdateCluster work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				cluster.Status.WorkRequestId = nil
+				cluster.Status.WorkRequestStatus = nil
 				return cluster, nil
-			} else {
-				return nil, nil
 			}
+			return cluster, cluster.Status.HandleError(e)
 		}
 
-		if workResp.Status == ocisdkce.WorkRequestStatusFailed {
-			cluster.Status.WorkRequestStatus = &workResp.Status
-			err := fmt.Errorf("WorkRequest %s is in failed state", *cluster.Status.WorkRequestId)
-			return cluster, cluster.Status.HandleError(err)
+		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
+			return cluster, nil
 		}
 
 		cluster.Status.WorkRequestId = nil
@@ -424,15 +424,19 @@ func (a *ClusterAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 			workRequestId := *updateResponse.OpcWorkRequestId
 			glog.Infof("UpdateCluster workRequestId: %s", workRequestId)
 
-			workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: &workRequestId}
-			workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
-			if e != nil {
-				return cluster, cluster.Status.HandleError(e)
-			}
-
-			cluster.Status.WorkRequestStatus = &workResp.Status
 			cluster.Status.WorkRequestId = updateResponse.OpcWorkRequestId
-			return cluster, nil
+			resourcescommon.StartWorkRequest(&cluster.Status.ResourceStatus, "containerengine", "UpdateCluster", updateResponse.OpcWorkRequestId)
+			workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &cluster.Status.ResourceStatus, updateResponse.OpcWorkRequestId)
+			if workResp.Status != "" {
+				cluster.Status.WorkRequestStatus = &workResp.Status
+			}
+			if resourcescommon.IsWorkRequestFailed(e) {
+				glog.Errorf("UpdateCluster work request error: %v", e)
+				cluster.Status.WorkRequestId = nil
+				cluster.Status.WorkRequestStatus = nil
+				return cluster, nil
+			}
+			return cluster, cluster.Status.HandleError(e)
 		}
 
 	}
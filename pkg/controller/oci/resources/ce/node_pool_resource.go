@@ -48,6 +48,7 @@ func init() {
 		ocicev1alpha1.NodePoolResourcePlural,
 		ocicev1alpha1.NodePoolControllerName,
 		&ocicev1alpha1.NodePoolValidation,
+		ocicommon.PrinterColumns(ocicommon.OcidColumn),
 		NewNodePoolAdapter)
 }
 
@@ -71,17 +72,24 @@ func NewNodePoolAdapter(clientset versioned.Interface, kubeclient kubernetes.Int
 		glog.Errorf("Error creating oci ContainerEngine client: %v", err)
 		os.Exit(1)
 	}
-	ca.ceClient = &ceClient
+	ca.ceClient = resourcescommon.InstrumentContainerEngineClient(&ceClient)
 
 	vcnClient, err := ocisdkcore.NewVirtualNetworkClientWithConfigurationProvider(ociconfig)
 	if err != nil {
 		glog.Errorf("Error creating oci VCN client: %v", err)
 		os.Exit(1)
 	}
-	ca.vcnClient = &vcnClient
+	ca.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	return &ca
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *NodePoolAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *NodePoolAdapter) Kind() string {
 	return ocicev1alpha1.NodePoolKind
@@ -120,24 +128,23 @@ func (a *NodePoolAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *NodePoolAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *NodePoolAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	nodePool := obj.(*ocicev1alpha1.NodePool)
 
 	if nodePool.Status.WorkRequestStatus != nil {
 		glog.Infof("nodePool has workrequest - isResourceCompliant: false")
-		return false
+		return false, nil
 	}
 
 	if nodePool.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
-	if *nodePool.Spec.KubernetesVersion != *nodePool.Status.Resource.KubernetesVersion ||
-		*nodePool.Spec.QuantityPerSubnet != *nodePool.Status.Resource.QuantityPerSubnet {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("kubernetesVersion", nodePool.Spec.KubernetesVersion, nodePool.Status.Resource.KubernetesVersion)
+	drift.Check("quantityPerSubnet", nodePool.Spec.QuantityPerSubnet, nodePool.Status.Resource.QuantityPerSubnet)
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -169,11 +176,6 @@ func (a *NodePoolAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Dep
 	return obj.(*ocicev1alpha1.NodePool).Spec.DependsOn
 }
 
-// Dependents returns a map of nodePool dependents (objects that depend on the nodePool)
-func (a *NodePoolAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicev1alpha1.NodePool).Status.Dependents
-}
-
 // CreateObject creates the nodePool object
 func (a *NodePoolAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicev1alpha1.NodePool)
@@ -186,6 +188,12 @@ func (a *NodePoolAdapter) UpdateObject(obj runtime.Object) (runtime.Object, erro
 	return a.clientset.OciceV1alpha1().NodePools(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the nodePool object
+func (a *NodePoolAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicev1alpha1.NodePool)
+	return a.clientset.OciceV1alpha1().NodePools(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the nodePool object
 func (a *NodePoolAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var be = obj.(*ocicev1alpha1.NodePool)
@@ -235,30 +243,23 @@ func (a *NodePoolAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 	if nodePool.Status.WorkRequestId != nil {
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: nodePool.Status.WorkRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &nodePool.Status.ResourceStatus, nodePool.Status.WorkRequestId)
+		if workResp.Status != "" {
+			nodePool.Status.WorkRequestStatus = &workResp.Status
+		}
 		if e != nil {
-			glog.Errorf("CreateNodePool GetWorkRequest error: %v", e)
+			glog.Errorf("CreateNodePool work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				nodePool.Status.WorkRequestId = nil
+				nodePool.Status.WorkRequestStatus = nil
+				return nodePool, nil
+			}
 			return nodePool, nodePool.Status.HandleError(e)
 		}
 		glog.Infof("CreateNodePool workResp state: %s", workResp.Status)
 
-		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded &&
-			workResp.Status != ocisdkce.WorkRequestStatusFailed {
-
-			if workResp.Status != *nodePool.Status.WorkRequestStatus {
-				nodePool.Status.WorkRequestStatus = &workResp.Status
-				return nodePool, nil
-
-			} else {
-				return nil, nil
-			}
-
-		}
-		if workResp.Status == ocisdkce.WorkRequestStatusFailed {
-			nodePool.Status.WorkRequestStatus = &workResp.Status
-			err := fmt.Errorf("WorkRequest %s is in failed state", *nodePool.Status.WorkRequestId)
-			return nodePool, nodePool.Status.HandleError(err)
+		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
+			return nodePool, nil
 		}
 
 		nodePool.Status.WorkRequestId = nil
@@ -328,17 +329,23 @@ func (a *NodePoolAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 		workRequestId := *createResponse.OpcWorkRequestId
 		glog.V(4).Infof("CreateNodePool workRequestId: %s", workRequestId)
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: &workRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
+		nodePool.Status.WorkRequestId = createResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&nodePool.Status.ResourceStatus, "containerengine", "CreateNodePool", createResponse.OpcWorkRequestId)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &nodePool.Status.ResourceStatus, createResponse.OpcWorkRequestId)
+		if workResp.Status != "" {
+			nodePool.Status.WorkRequestStatus = &workResp.Status
+		}
 		if e != nil {
-			glog.Errorf("CreateNodePool GetWorkRequest error: %v", e)
+			glog.Errorf("CreateNodePool work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				nodePool.Status.WorkRequestId = nil
+				nodePool.Status.WorkRequestStatus = nil
+				return nodePool, nil
+			}
 			return nodePool, nodePool.Status.HandleError(e)
 		}
 		glog.V(4).Infof("CreateNodePool workResp state: %s", workResp.Status)
 
-		nodePool.Status.WorkRequestId = createResponse.OpcWorkRequestId
-		nodePool.Status.WorkRequestStatus = &workResp.Status
-
 		return nodePool, nil
 	}
 
@@ -395,28 +402,22 @@ func (a *NodePoolAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	if nodePool.Status.WorkRequestId != nil {
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: nodePool.Status.WorkRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			return nodePool, nodePool.Status.HandleError(e)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &nodePool.Status.ResourceStatus, nodePool.Status.WorkRequestId)
+		if workResp.Status != "" {
+			nodePool.Status.WorkRequestStatus = &workResp.Status
 		}
-		nodePool.Status.WorkRequestStatus = &workResp.Status
-
-		if workResp.Status != ocisdkce.WorkRequestStatusFailed &&
-			workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
-
-			if workResp.Status != *nodePool.Status.WorkRequestStatus {
-				nodePool.Status.WorkRequestStatus = &workResp.Status
+		if e != nil {
+			glog.Errorf("UpdateNodePool work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				nodePool.Status.WorkRequestId = nil
+				nodePool.Status.WorkRequestStatus = nil
 				return nodePool, nil
-			} else {
-				return nil, nil
 			}
+			return nodePool, nodePool.Status.HandleError(e)
 		}
 
-		if workResp.Status == ocisdkce.WorkRequestStatusFailed {
-			nodePool.Status.WorkRequestStatus = &workResp.Status
-			err := fmt.Errorf("WorkRequest %s is in failed state", *nodePool.Status.WorkRequestId)
-			return nodePool, nodePool.Status.HandleError(err)
+		if workResp.Status != ocisdkce.WorkRequestStatusSucceeded {
+			return nodePool, nil
 		}
 
 		nodePool.Status.WorkRequestId = nil
@@ -449,15 +450,22 @@ func (a *NodePoolAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 		workRequestId := *updateResponse.OpcWorkRequestId
 		glog.Infof("UpdateNodePool workRequestId: %s", workRequestId)
 
-		workRequest := ocisdkce.GetWorkRequestRequest{WorkRequestId: &workRequestId}
-		workResp, e := a.ceClient.GetWorkRequest(a.ctx, workRequest)
+		nodePool.Status.WorkRequestId = updateResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&nodePool.Status.ResourceStatus, "containerengine", "UpdateNodePool", updateResponse.OpcWorkRequestId)
+		workResp, e := resourcescommon.PollContainerEngineWorkRequest(a.ctx, a.ceClient, &nodePool.Status.ResourceStatus, updateResponse.OpcWorkRequestId)
+		if workResp.Status != "" {
+			nodePool.Status.WorkRequestStatus = &workResp.Status
+		}
 		if e != nil {
-			glog.Errorf("UpdateNodePool GetWorkRequest error: %v", e)
+			glog.Errorf("UpdateNodePool work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				nodePool.Status.WorkRequestId = nil
+				nodePool.Status.WorkRequestStatus = nil
+				return nodePool, nil
+			}
 			return nodePool, nodePool.Status.HandleError(e)
 		}
 		glog.Infof("UpdateNodePool workResp state: %s", workResp.Status)
-		nodePool.Status.WorkRequestId = updateResponse.OpcWorkRequestId
-		nodePool.Status.WorkRequestStatus = &workResp.Status
 		return nodePool, nil
 	}
 
@@ -17,6 +17,9 @@ limitations under the License.
 package common
 
 import (
+	"context"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -38,13 +41,12 @@ type ResourceTypeAdapter interface {
 	IsExpectedType(obj interface{}) bool
 	Copy(obj runtime.Object) runtime.Object
 	Equivalent(obj1, obj2 runtime.Object) bool
-	IsResourceCompliant(obj runtime.Object) bool
+	IsResourceCompliant(obj runtime.Object) (bool, Drift)
 	IsResourceStatusChanged(obj1, obj2 runtime.Object) bool
 	Id(obj runtime.Object) string
 	//Key(obj runtime.Object) objectclient.Key
 	ObjectMeta(obj runtime.Object) *metav1.ObjectMeta
 	DependsOn(obj runtime.Object) map[string]ocicommon.DependsOn
-	Dependents(obj runtime.Object) map[string][]string
 	DependsOnRefs(obj runtime.Object) ([]runtime.Object, error)
 
 	// Operations target the resource service apis
@@ -56,6 +58,7 @@ type ResourceTypeAdapter interface {
 	//Operations target CRDs
 	CreateObject(obj runtime.Object) (runtime.Object, error)
 	UpdateObject(obj runtime.Object) (runtime.Object, error)
+	UpdateObjectStatus(obj runtime.Object) (runtime.Object, error)
 	DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error
 	UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error)
 }
@@ -69,3 +72,32 @@ type AdapterFactory func(
 	kubeclient kubernetes.Interface,
 	ociconfig ocisdkcommon.ConfigurationProvider,
 	adapterSpecificArgs map[string]interface{}) ResourceTypeAdapter
+
+// ContextAdapter is implemented by adapters whose OCI calls can be made with another context,
+// the controller uses it to pass the object of the calls to the audit log
+type ContextAdapter interface {
+	WithContext(ctx context.Context) ResourceTypeAdapter
+}
+
+// OwnedResourceAdapter is implemented by the adapters of kinds whose OCI resources are swept
+// for orphans, i.e. resources carrying the ownership tags without an object managing them
+type OwnedResourceAdapter interface {
+	// ListOwned returns the resources of the kind in a compartment tagged as managed by
+	// oci-manager, resources being or already deleted are left out
+	ListOwned(compartmentId string) ([]OwnedResource, error)
+	// DeleteOwned deletes the resource with the id
+	DeleteOwned(id string) error
+	// ReleaseOwned removes the ownership tags from the resource with the id
+	ReleaseOwned(id string) error
+}
+
+// OwnedResource is an OCI resource tagged as managed by oci-manager
+type OwnedResource struct {
+	Id          string
+	DisplayName string
+	TimeCreated time.Time
+	Tags        map[string]string
+	// Parents are the ids of the resources it lives in or refers to, e.g. the vcn and route
+	// table of a subnet. These cannot be deleted as long as the resource exists.
+	Parents []string
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+)
+
+// Outcomes of audited calls
+const (
+	AuditOutcomeSuccess   = "success"
+	AuditOutcomeError     = "error"
+	AuditOutcomePlanned   = "planned"
+	AuditOutcomeThrottled = "throttled"
+)
+
+// AuditObject identifies the object an OCI call is made for
+type AuditObject struct {
+	Kind      string
+	Namespace string
+	Name      string
+	UID       string
+	OCID      string
+}
+
+// AuditRecord is the audit log entry of a mutating OCI call
+type AuditRecord struct {
+	Timestamp    time.Time   `json:"timestamp"`
+	Kind         string      `json:"kind,omitempty"`
+	Namespace    string      `json:"namespace,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	UID          string      `json:"uid,omitempty"`
+	OCID         string      `json:"ocid,omitempty"`
+	Service      string      `json:"service"`
+	Operation    string      `json:"operation"`
+	Request      interface{} `json:"request,omitempty"`
+	OpcRequestID string      `json:"opcRequestId,omitempty"`
+	Outcome      string      `json:"outcome"`
+	Error        string      `json:"error,omitempty"`
+}
+
+// AuditSink writes audit records, e.g. to a file or a webhook
+type AuditSink interface {
+	Write(record *AuditRecord) error
+}
+
+type auditObjectKey struct{}
+
+var (
+	auditLock  sync.RWMutex
+	auditSinks []AuditSink
+)
+
+// SetAuditSinks sets the sinks every mutating OCI call is written to, none turns the audit log off
+func SetAuditSinks(sinks ...AuditSink) {
+	auditLock.Lock()
+	defer auditLock.Unlock()
+	auditSinks = sinks
+}
+
+// WithAuditObject returns a context whose OCI calls are audited as made for object
+func WithAuditObject(ctx context.Context, object AuditObject) context.Context {
+	return context.WithValue(ctx, auditObjectKey{}, object)
+}
+
+// auditInterceptor writes a record of every call that is not a Get or List to the audit sinks.
+// It is the outermost of the interceptors, so a call is recorded once with the outcome of its last attempt.
+func auditInterceptor(ctx context.Context, call OciCall, next OciCallHandler) (interface{}, error) {
+	auditLock.RLock()
+	sinks := auditSinks
+	auditLock.RUnlock()
+	if len(sinks) == 0 || isReadOnly(call.Operation) {
+		return next(ctx)
+	}
+
+	response, err := next(ctx)
+
+	object, _ := ctx.Value(auditObjectKey{}).(AuditObject)
+	record := &AuditRecord{
+		Timestamp:    time.Now().UTC(),
+		Kind:         object.Kind,
+		Namespace:    object.Namespace,
+		Name:         object.Name,
+		UID:          object.UID,
+		OCID:         object.OCID,
+		Service:      call.Service,
+		Operation:    call.Operation,
+		Request:      redact(call.Request),
+		OpcRequestID: stringField(response, "OpcRequestId"),
+		Outcome:      auditOutcome(err),
+	}
+	if id := resourceID(response); id != "" {
+		record.OCID = id
+	}
+	if err != nil {
+		record.Error = err.Error()
+		if serviceErr, ok := ocisdkcommon.IsServiceError(err); ok && serviceErr.GetOpcRequestID() != "" {
+			record.OpcRequestID = serviceErr.GetOpcRequestID()
+		}
+	}
+
+	for _, sink := range sinks {
+		if e := sink.Write(record); e != nil {
+			glog.Errorf("Error writing audit record of %s %s: %v", call.Service, call.Operation, e)
+		}
+	}
+	return response, err
+}
+
+func auditOutcome(err error) string {
+	if _, ok := IsPlannedCall(err); ok {
+		return AuditOutcomePlanned
+	}
+	if _, ok := IsThrottled(err); ok {
+		return AuditOutcomeThrottled
+	}
+	if err != nil {
+		return AuditOutcomeError
+	}
+	return AuditOutcomeSuccess
+}
+
+// resourceID returns the OCID of the resource in an sdk response, e.g. the Vcn of a CreateVcnResponse
+func resourceID(response interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(response))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Struct && v.Type().Field(i).PkgPath == "" {
+			if id := stringField(field.Interface(), "Id"); id != "" {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// stringField returns the value of a *string field of a struct, or empty if it has none
+func stringField(value interface{}, name string) string {
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	field := v.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.String {
+		return ""
+	}
+	return field.Elem().String()
+}
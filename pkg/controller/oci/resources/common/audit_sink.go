@@ -0,0 +1,166 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// writerAuditSink writes audit records as JSON lines
+type writerAuditSink struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterAuditSink returns a sink writing JSON lines to w, e.g. os.Stdout
+func NewWriterAuditSink(w io.Writer) AuditSink {
+	return &writerAuditSink{w: w}
+}
+
+func (s *writerAuditSink) Write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// fileAuditSink writes audit records as JSON lines to a file, which is rotated once it
+// exceeds maxSize. The rotated files are named <path>.1 to <path>.<maxBackups>, the oldest
+// is removed.
+type fileAuditSink struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileAuditSink returns a sink appending JSON lines to the file at path. The file is rotated
+// when it exceeds maxSize bytes, 0 never rotates it.
+func NewFileAuditSink(path string, maxSize int64, maxBackups int) (AuditSink, error) {
+	s := &fileAuditSink{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *fileAuditSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	s.file, s.size = file, info.Size()
+	return nil
+}
+
+// rotate renames the file and its backups and opens a new file. The file is only closed once
+// the renames succeeded, a failed rotation keeps writing to it. After a failed reopen the file
+// is nil and opened again by the next Write.
+func (s *fileAuditSink) rotate() error {
+	for i := s.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if s.maxBackups > 0 {
+		if err := os.Rename(s.path, s.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return err
+	}
+	closeErr := s.file.Close()
+	s.file = nil
+	if err := s.open(); err != nil {
+		return err
+	}
+	return closeErr
+}
+
+func (s *fileAuditSink) Write(record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var rotateErr error
+	if s.file != nil && s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			rotateErr = fmt.Errorf("rotating audit log %s: %v", s.path, err)
+		}
+	}
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return fmt.Errorf("opening audit log %s: %v", s.path, err)
+		}
+	}
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return rotateErr
+}
+
+// webhookAuditSink posts every audit record as JSON to a URL
+type webhookAuditSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookAuditSink returns a sink posting the records to url. A record is posted while the
+// OCI call returns, timeout bounds how long the call is held up by the webhook.
+func NewWebhookAuditSink(url string, timeout time.Duration) AuditSink {
+	return &webhookAuditSink{url: url, client: &http.Client{Timeout: timeout}}
+}
+
+func (s *webhookAuditSink) Write(record *AuditRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("audit webhook %s returned %s", s.url, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+)
+
+func TestAuditLog(t *testing.T) {
+	var buf bytes.Buffer
+	resourcescommon.SetAuditSinks(resourcescommon.NewWriterAuditSink(&buf))
+	defer resourcescommon.SetAuditSinks()
+
+	ctx := resourcescommon.WithAuditObject(context.Background(), resourcescommon.AuditObject{
+		Kind:      "Vcn",
+		Namespace: "default",
+		Name:      "myvcn",
+		UID:       "uid-1",
+	})
+
+	fakeClient := fakeoci.NewVcnClient()
+	created, err := fakeClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+		CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.0.0.0/16")},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	vcnClient := resourcescommon.InstrumentVcnClient(fakeClient)
+	_, err = vcnClient.UpdateVcn(ctx, ocicore.UpdateVcnRequest{
+		VcnId:            created.Id,
+		UpdateVcnDetails: ocicore.UpdateVcnDetails{DisplayName: ocisdkcommon.String("renamed")},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if _, err := vcnClient.GetVcn(ctx, ocicore.GetVcnRequest{VcnId: created.Id}); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	computeClient := resourcescommon.InstrumentComputeClient(fakeoci.NewComputeClient())
+	_, err = computeClient.LaunchInstance(ctx, ocicore.LaunchInstanceRequest{
+		LaunchInstanceDetails: ocicore.LaunchInstanceDetails{
+			Metadata: map[string]string{"ssh_authorized_keys": "ssh-rsa AAAA", "user_data": "c2VjcmV0"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 audit records, got %d: %s", len(lines), buf.String())
+	}
+
+	var record resourcescommon.AuditRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if record.Service != "vcn" || record.Operation != "UpdateVcn" || record.Outcome != resourcescommon.AuditOutcomeSuccess {
+		t.Errorf("Unexpected call in record %#v", record)
+	}
+	if record.Kind != "Vcn" || record.Namespace != "default" || record.Name != "myvcn" || record.UID != "uid-1" {
+		t.Errorf("Unexpected object in record %#v", record)
+	}
+	if record.OCID != *created.Id {
+		t.Errorf("Expected ocid %s, got %s", *created.Id, record.OCID)
+	}
+	if !strings.Contains(lines[0], "renamed") {
+		t.Errorf("Expected the request in record %s", lines[0])
+	}
+
+	if strings.Contains(lines[1], "c2VjcmV0") || !strings.Contains(lines[1], "ssh-rsa AAAA") {
+		t.Errorf("Expected only user_data to be redacted in %s", lines[1])
+	}
+}
+
+func TestFileAuditSinkRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	sink, err := resourcescommon.NewFileAuditSink(path, 200, 2)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		record := &resourcescommon.AuditRecord{
+			Timestamp: time.Now(),
+			Service:   "vcn",
+			Operation: "CreateVcn",
+			Outcome:   resourcescommon.AuditOutcomeSuccess,
+		}
+		if err := sink.Write(record); err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+	}
+
+	for _, name := range []string{"audit.log", "audit.log.1", "audit.log.2"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Expected %s, got %v", name, err)
+		}
+		if info.Size() > 200 {
+			t.Errorf("Expected %s to be rotated at 200 bytes, got %d", name, info.Size())
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "audit.log.3")); !os.IsNotExist(err) {
+		t.Errorf("Expected only 2 backups, got %v", err)
+	}
+}
+
+func TestFileAuditSinkRotationFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a non-empty directory in the way of the first backup fails the rotation
+	path := filepath.Join(dir, "audit.log")
+	if err := os.MkdirAll(filepath.Join(path+".1", "blocked"), 0700); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	sink, err := resourcescommon.NewFileAuditSink(path, 100, 1)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	record := &resourcescommon.AuditRecord{
+		Timestamp: time.Now(),
+		Service:   "vcn",
+		Operation: "CreateVcn",
+		Outcome:   resourcescommon.AuditOutcomeSuccess,
+	}
+	if err := sink.Write(record); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := sink.Write(record); err == nil {
+		t.Errorf("Expected the rotation to fail")
+	}
+
+	if err := os.RemoveAll(path + ".1"); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if err := sink.Write(record); err != nil {
+		t.Fatalf("Expected the rotation to succeed once the backup is writable, got %v", err)
+	}
+	rotated, err := ioutil.ReadFile(path + ".1")
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if lines := strings.Count(string(rotated), "\n"); lines != 2 {
+		t.Errorf("Expected both records written during the failed rotation to be kept, got %d", lines)
+	}
+	current, err := ioutil.ReadFile(path)
+	if err != nil || strings.Count(string(current), "\n") != 1 {
+		t.Errorf("Expected the new audit log to hold the last record, got %q %v", current, err)
+	}
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package common
 
 import (
+	"context"
 	"errors"
+
+	ocicore "github.com/oracle/oci-go-sdk/core"
 	"github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
 	"github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -208,3 +211,25 @@ func VolumeId(clientset versioned.Interface, ns, name string) (id string, err er
 	}
 	return *vol.Status.Resource.Id, nil
 }
+
+// VcnIds returns the ids of the vcns in the compartment, the resources of a vcn are
+// listed by vcn
+func VcnIds(ctx context.Context, client VcnClientInterface, compartmentId string) ([]string, error) {
+	var ids []string
+	request := ocicore.ListVcnsRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := client.ListVcns(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if item.Id != nil && item.LifecycleState != ocicore.VcnLifecycleStateTerminated {
+				ids = append(ids, *item.Id)
+			}
+		}
+		if r.OpcNextPage == nil {
+			return ids, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
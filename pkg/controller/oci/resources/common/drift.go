@@ -0,0 +1,89 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+)
+
+// Drift collects the fields of an OCI resource that differ from the spec
+type Drift []ocicommon.FieldDrift
+
+// Check records field as drifted when the desired and actual values differ.
+// Pointers are compared by the value they point to.
+func (d *Drift) Check(field string, desired, actual interface{}) {
+	desired, actual = indirect(desired), indirect(actual)
+	if !reflect.DeepEqual(desired, actual) {
+		*d = append(*d, ocicommon.FieldDrift{
+			Field:   field,
+			Desired: formatValue(desired),
+			Actual:  formatValue(actual),
+		})
+	}
+}
+
+// Add records field as drifted with the given desired and actual values
+func (d *Drift) Add(field string, desired, actual interface{}) {
+	*d = append(*d, ocicommon.FieldDrift{
+		Field:   field,
+		Desired: formatValue(indirect(desired)),
+		Actual:  formatValue(indirect(actual)),
+	})
+}
+
+// Compliant reports if no field has drifted
+func (d Drift) Compliant() bool {
+	return len(d) == 0
+}
+
+// String returns a field level diff of the drift
+func (d Drift) String() string {
+	diffs := make([]string, 0, len(d))
+	for _, f := range d {
+		diffs = append(diffs, fmt.Sprintf("%s: desired %s, actual %s", f.Field, f.Desired, f.Actual))
+	}
+	return strings.Join(diffs, "; ")
+}
+
+func indirect(value interface{}) interface{} {
+	v := reflect.ValueOf(value)
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<nil>"
+	}
+	if b, err := json.Marshal(value); err == nil {
+		return string(b)
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"testing"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+)
+
+func TestDriftCheck(t *testing.T) {
+	var drift resourcescommon.Drift
+
+	drift.Check("same", "a", ocisdkcommon.String("a"))
+	drift.Check("sameInt", ocisdkcommon.Int64(50), ocisdkcommon.Int64(50))
+	if !drift.Compliant() {
+		t.Fatalf("Expected equal values by pointer not to drift, got %v", drift)
+	}
+
+	drift.Check("displayName", "spec", ocisdkcommon.String("console"))
+	drift.Check("dnsLabel", "vcn", (*string)(nil))
+	drift.Add("rules", []string{"a"}, []string{"b"})
+
+	if drift.Compliant() || len(drift) != 3 {
+		t.Fatalf("Expected 3 drifted fields, got %v", drift)
+	}
+	expected := `displayName: desired "spec", actual "console"; dnsLabel: desired "vcn", actual <nil>; rules: desired ["a"], actual ["b"]`
+	if drift.String() != expected {
+		t.Errorf("Expected %s, got %s", expected, drift.String())
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+)
+
+var dryRun int32
+
+// SetDryRun turns dry-run mode on or off. In dry-run mode only read-only
+// OCI calls are sent, every other call fails with a PlannedCallError.
+func SetDryRun(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&dryRun, v)
+}
+
+// DryRun reports if dry-run mode is on
+func DryRun() bool {
+	return atomic.LoadInt32(&dryRun) == 1
+}
+
+// PlannedCallError is returned by a mutating OCI call that was held back in dry-run mode
+type PlannedCallError struct {
+	Call OciCall
+}
+
+func (e *PlannedCallError) Error() string {
+	return fmt.Sprintf("dry-run: %s %s was not sent", e.Call.Service, e.Call.Operation)
+}
+
+// Planned marks the error as a held back call rather than a failure
+func (e *PlannedCallError) Planned() bool {
+	return true
+}
+
+// Plan returns the held back call in the form recorded in the resource status, secrets
+// in the request are redacted since the status is readable by anyone reading the object
+func (e *PlannedCallError) Plan() *ocicommon.ResourcePlan {
+	return &ocicommon.ResourcePlan{
+		Service:   e.Call.Service,
+		Operation: e.Call.Operation,
+		Request:   formatValue(redact(e.Call.Request)),
+	}
+}
+
+// IsPlannedCall returns the PlannedCallError if err is one
+func IsPlannedCall(err error) (*PlannedCallError, bool) {
+	planned, ok := err.(*PlannedCallError)
+	return planned, ok
+}
+
+// dryRunInterceptor holds back every call that is not a Get or List while dry-run mode is on
+func dryRunInterceptor(ctx context.Context, call OciCall, next OciCallHandler) (interface{}, error) {
+	if !DryRun() || isReadOnly(call.Operation) {
+		return next(ctx)
+	}
+	return nil, &PlannedCallError{Call: call}
+}
+
+func isReadOnly(operation string) bool {
+	return strings.HasPrefix(operation, "Get") || strings.HasPrefix(operation, "List")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	ocidb "github.com/oracle/oci-go-sdk/database"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+)
+
+func TestDryRun(t *testing.T) {
+	fakeClient := fakeoci.NewVcnClient()
+	client := resourcescommon.InstrumentVcnClient(fakeClient)
+
+	existing, err := fakeClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+		CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.0.0.0/16")},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	resourcescommon.SetDryRun(true)
+	defer resourcescommon.SetDryRun(false)
+
+	_, err = client.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+		CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.1.0.0/16")},
+	})
+	planned, ok := resourcescommon.IsPlannedCall(err)
+	if !ok {
+		t.Fatalf("Expected a planned call error, got %v", err)
+	}
+	plan := planned.Plan()
+	if plan.Service != "vcn" || plan.Operation != "CreateVcn" || !strings.Contains(plan.Request, "10.1.0.0/16") {
+		t.Errorf("Unexpected plan %#v", plan)
+	}
+
+	_, err = client.DeleteVcn(context.Background(), ocicore.DeleteVcnRequest{VcnId: existing.Id})
+	if _, ok := resourcescommon.IsPlannedCall(err); !ok {
+		t.Errorf("Expected delete to be held back, got %v", err)
+	}
+
+	got, err := client.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: existing.Id})
+	if err != nil {
+		t.Fatalf("Expected read-only call to go through, got %v", err)
+	}
+	if got.Id == nil || *got.Id != *existing.Id {
+		t.Errorf("Expected vcn %s to still exist, got %v", *existing.Id, got.Id)
+	}
+}
+
+func TestDryRunRedactsSecrets(t *testing.T) {
+	client := resourcescommon.InstrumentDatabaseClient(fakeoci.NewDatabaseClient())
+
+	resourcescommon.SetDryRun(true)
+	defer resourcescommon.SetDryRun(false)
+
+	_, err := client.CreateAutonomousDatabase(context.Background(), ocidb.CreateAutonomousDatabaseRequest{
+		CreateAutonomousDatabaseDetails: ocidb.CreateAutonomousDatabaseDetails{
+			DbName:        ocisdkcommon.String("mydb"),
+			AdminPassword: ocisdkcommon.String("Welcome#12345"),
+		},
+	})
+	planned, ok := resourcescommon.IsPlannedCall(err)
+	if !ok {
+		t.Fatalf("Expected a planned call error, got %v", err)
+	}
+	plan := planned.Plan()
+	if strings.Contains(plan.Request, "Welcome#12345") || !strings.Contains(plan.Request, "mydb") {
+		t.Errorf("Expected only the admin password to be redacted in %s", plan.Request)
+	}
+
+	status := &ocicommon.ResourceStatus{}
+	if status.HandleError(err) == nil || status.State == ocicommon.ResourceStateError {
+		t.Errorf("Expected a planned call to keep the state, got %q", status.State)
+	}
+}
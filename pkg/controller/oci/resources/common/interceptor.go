@@ -0,0 +1,57 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+)
+
+//go:generate sh -c "cd ../../../../.. && go run hack/gen-ociclient/main.go"
+
+// OciCall describes a single call made through one of the OCI client interfaces
+type OciCall struct {
+	Service   string
+	Operation string
+	Request   interface{}
+}
+
+// OciCallHandler performs the actual OCI call and returns the sdk response
+type OciCallHandler func(ctx context.Context) (interface{}, error)
+
+// OciCallInterceptor is invoked around every OCI call made by the instrumented clients.
+// Implementations must call next to continue the chain.
+type OciCallInterceptor func(ctx context.Context, call OciCall, next OciCallHandler) (interface{}, error)
+
+// interceptors is the chain every OCI call goes through, outermost first. The audit records a
+// call once with the outcome of its last attempt, the dry-run holds back mutating calls before
+// they are counted by the metrics, and the throttle waits and retries innermost.
+var interceptors = []OciCallInterceptor{
+	auditInterceptor,
+	dryRunInterceptor,
+	metricsInterceptor,
+	throttleInterceptor,
+}
+
+func intercept(ctx context.Context, call OciCall, handler OciCallHandler) (interface{}, error) {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context) (interface{}, error) {
+			return interceptor(ctx, call, next)
+		}
+	}
+	return handler(ctx)
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestInterceptorOrder(t *testing.T) {
+	expected := []OciCallInterceptor{auditInterceptor, dryRunInterceptor, metricsInterceptor, throttleInterceptor}
+
+	name := func(interceptor OciCallInterceptor) string {
+		return runtime.FuncForPC(reflect.ValueOf(interceptor).Pointer()).Name()
+	}
+	if len(interceptors) != len(expected) {
+		t.Fatalf("Expected %d interceptors, got %d", len(expected), len(interceptors))
+	}
+	for i := range expected {
+		if name(interceptors[i]) != name(expected[i]) {
+			t.Errorf("Expected interceptor %d to be %s, got %s", i, name(expected[i]), name(interceptors[i]))
+		}
+	}
+}
@@ -43,8 +43,8 @@ type BlockStorageClientInterface interface {
 	// GetVolumeBackupPolicyAssignment(ctx context.Context, request ocicore.GetVolumeBackupPolicyAssignmentRequest) (response ocicore.GetVolumeBackupPolicyAssignmentResponse, err error)
 	// ListBootVolumes(ctx context.Context, request ocicore.ListBootVolumesRequest) (response ocicore.ListBootVolumesResponse, err error)
 	// ListVolumeBackupPolicies(ctx context.Context, request ocicore.ListVolumeBackupPoliciesRequest) (response ocicore.ListVolumeBackupPoliciesResponse, err error)
-	// ListVolumeBackups(ctx context.Context, request ocicore.ListVolumeBackupsRequest) (response ocicore.ListVolumeBackupsResponse, err error)
-	// ListVolumes(ctx context.Context, request ocicore.ListVolumesRequest) (response ocicore.ListVolumesResponse, err error)
+	ListVolumeBackups(ctx context.Context, request ocicore.ListVolumeBackupsRequest) (response ocicore.ListVolumeBackupsResponse, err error)
+	ListVolumes(ctx context.Context, request ocicore.ListVolumesRequest) (response ocicore.ListVolumesResponse, err error)
 	// UpdateBootVolume(ctx context.Context, request ocicore.UpdateBootVolumeRequest) (response ocicore.UpdateBootVolumeResponse, err error)
 	UpdateVolume(ctx context.Context, request ocicore.UpdateVolumeRequest) (response ocicore.UpdateVolumeResponse, err error)
 	UpdateVolumeBackup(ctx context.Context, request ocicore.UpdateVolumeBackupRequest) (response ocicore.UpdateVolumeBackupResponse, err error)
@@ -82,7 +82,7 @@ type ComputeClientInterface interface {
 	// ListConsoleHistories(ctx context.Context, reqiest ocicore.ListConsoleHistoriesRequest) (response ocicore.ListConsoleHistoriesResponse, err error)
 	ListImages(ctx context.Context, reqiest ocicore.ListImagesRequest) (response ocicore.ListImagesResponse, err error)
 	// ListInstanceConsoleConnections(ctx context.Context, reqiest ocicore.ListInstanceConsoleConnectionsRequest) (response ocicore.ListInstanceConsoleConnectionsResponse, err error)
-	// ListInstances(ctx context.Context, reqiest ocicore.ListInstancesRequest) (response ocicore.ListInstancesResponse, err error)
+	ListInstances(ctx context.Context, reqiest ocicore.ListInstancesRequest) (response ocicore.ListInstancesResponse, err error)
 	ListShapes(ctx context.Context, reqiest ocicore.ListShapesRequest) (response ocicore.ListShapesResponse, err error)
 	TerminateInstance(ctx context.Context, reqiest ocicore.TerminateInstanceRequest) (response ocicore.TerminateInstanceResponse, err error)
 	// UpdateConsoleHistory(ctx context.Context, reqiest ocicore.UpdateConsoleHistoryRequest) (response ocicore.UpdateConsoleHistoryResponse, err error)
@@ -105,7 +105,7 @@ type ContainerEngineClientInterface interface {
 	GetWorkRequest(ctx context.Context, request ocice.GetWorkRequestRequest) (response ocice.GetWorkRequestResponse, err error)
 	ListClusters(ctx context.Context, request ocice.ListClustersRequest) (response ocice.ListClustersResponse, err error)
 	ListNodePools(ctx context.Context, request ocice.ListNodePoolsRequest) (response ocice.ListNodePoolsResponse, err error)
-	// ListWorkRequestErrors(ctx context.Context, request ocice.ListWorkRequestErrorsRequest) (response ocice.ListWorkRequestErrorsResponse, err error)
+	ListWorkRequestErrors(ctx context.Context, request ocice.ListWorkRequestErrorsRequest) (response ocice.ListWorkRequestErrorsResponse, err error)
 	// ListWorkRequestLogs(ctx context.Context, request ocice.ListWorkRequestLogsRequest) (response ocice.ListWorkRequestLogsResponse, err error)
 	// ListWorkRequests(ctx context.Context, request ocice.ListWorkRequestsRequest) (response ocice.ListWorkRequestsResponse, err error)
 	UpdateCluster(ctx context.Context, request ocice.UpdateClusterRequest) (response ocice.UpdateClusterResponse, err error)
@@ -227,7 +227,7 @@ type IdentityClientInterface interface {
 	// ListGroups(ctx context.Context, request ociid.ListGroupsRequest) (response ociid.ListGroupsResponse, err error)
 	// ListIdentityProviders(ctx context.Context, request ociid.ListIdentityProvidersRequest) (response ociid.ListIdentityProvidersResponse, err error)
 	// ListIdpGroupMappings(ctx context.Context, request ociid.ListIdpGroupMappingsRequest) (response ociid.ListIdpGroupMappingsResponse, err error)
-	// ListPolicies(ctx context.Context, request ociid.ListPoliciesRequest) (response ociid.ListPoliciesResponse, err error)
+	ListPolicies(ctx context.Context, request ociid.ListPoliciesRequest) (response ociid.ListPoliciesResponse, err error)
 	// ListRegionSubscriptions(ctx context.Context, request ociid.ListRegionSubscriptionsRequest) (response ociid.ListRegionSubscriptionsResponse, err error)
 	// ListRegions(ctx context.Context) (response ociid.ListRegionsResponse, err error)
 	// ListSmtpCredentials(ctx context.Context, request ociid.ListSmtpCredentialsRequest) (response ociid.ListSmtpCredentialsResponse, err error)
@@ -237,7 +237,7 @@ type IdentityClientInterface interface {
 	// ListUserGroupMemberships(ctx context.Context, request ociid.ListUserGroupMembershipsRequest) (response ociid.ListUserGroupMembershipsResponse, err error)
 	// ListUsers(ctx context.Context, request ociid.ListUsersRequest) (response ociid.ListUsersResponse, err error)
 	// RemoveUserFromGroup(ctx context.Context, request ociid.RemoveUserFromGroupRequest) (response ociid.RemoveUserFromGroupResponse, err error)
-	// UpdateCompartment(ctx context.Context, request ociid.UpdateCompartmentRequest) (response ociid.UpdateCompartmentResponse, err error)
+	UpdateCompartment(ctx context.Context, request ociid.UpdateCompartmentRequest) (response ociid.UpdateCompartmentResponse, err error)
 	// UpdateCustomerSecretKey(ctx context.Context, request ociid.UpdateCustomerSecretKeyRequest) (response ociid.UpdateCustomerSecretKeyResponse, err error)
 	// UpdateDynamicGroup(ctx context.Context, request ociid.UpdateDynamicGroupRequest) (response ociid.UpdateDynamicGroupResponse, err error)
 	// UpdateGroup(ctx context.Context, request ociid.UpdateGroupRequest) (response ociid.UpdateGroupResponse, err error)
@@ -281,7 +281,7 @@ type LoadBalancerClientInterface interface {
 	// ListBackends(ctx context.Context, request ocilb.ListBackendsRequest) (response ocilb.ListBackendsResponse, err error)
 	// ListCertificates(ctx context.Context, request ocilb.ListCertificatesRequest) (response ocilb.ListCertificatesResponse, err error)
 	// ListLoadBalancerHealths(ctx context.Context, request ocilb.ListLoadBalancerHealthsRequest) (response ocilb.ListLoadBalancerHealthsResponse, err error)
-	// ListLoadBalancers(ctx context.Context, request ocilb.ListLoadBalancersRequest) (response ocilb.ListLoadBalancersResponse, err error)
+	ListLoadBalancers(ctx context.Context, request ocilb.ListLoadBalancersRequest) (response ocilb.ListLoadBalancersResponse, err error)
 	// ListPathRouteSets(ctx context.Context, request ocilb.ListPathRouteSetsRequest) (response ocilb.ListPathRouteSetsResponse, err error)
 	// ListPolicies(ctx context.Context, request ocilb.ListPoliciesRequest) (response ocilb.ListPoliciesResponse, err error)
 	// ListProtocols(ctx context.Context, request ocilb.ListProtocolsRequest) (response ocilb.ListProtocolsResponse, err error)
@@ -366,21 +366,21 @@ type VcnClientInterface interface {
 	// ListCrossConnectLocations(ctx context.Context, request ocicore.ListCrossConnectLocationsRequest) (response ocicore.ListCrossConnectLocationsResponse, err error)
 	// ListCrossConnects(ctx context.Context, request ocicore.ListCrossConnectsRequest) (response ocicore.ListCrossConnectsResponse, err error)
 	// ListCrossconnectPortSpeedShapes(ctx context.Context, request ocicore.ListCrossconnectPortSpeedShapesRequest) (response ocicore.ListCrossconnectPortSpeedShapesResponse, err error)
-	// ListDhcpOptions(ctx context.Context, request ocicore.ListDhcpOptionsRequest) (response ocicore.ListDhcpOptionsResponse, err error)
+	ListDhcpOptions(ctx context.Context, request ocicore.ListDhcpOptionsRequest) (response ocicore.ListDhcpOptionsResponse, err error)
 	// ListDrgAttachments(ctx context.Context, request ocicore.ListDrgAttachmentsRequest) (response ocicore.ListDrgAttachmentsResponse, err error)
 	// ListDrgs(ctx context.Context, request ocicore.ListDrgsRequest) (response ocicore.ListDrgsResponse, err error)
 	// ListFastConnectProviderServices(ctx context.Context, request ocicore.ListFastConnectProviderServicesRequest) (response ocicore.ListFastConnectProviderServicesResponse, err error)
 	// ListFastConnectProviderVirtualCircuitBandwidthShapes(ctx context.Context, request ocicore.ListFastConnectProviderVirtualCircuitBandwidthShapesRequest) (response ocicore.ListFastConnectProviderVirtualCircuitBandwidthShapesResponse, err error)
 	// ListIPSecConnections(ctx context.Context, request ocicore.ListIPSecConnectionsRequest) (response ocicore.ListIPSecConnectionsResponse, err error)
-	// ListInternetGateways(ctx context.Context, request ocicore.ListInternetGatewaysRequest) (response ocicore.ListInternetGatewaysResponse, err error)
+	ListInternetGateways(ctx context.Context, request ocicore.ListInternetGatewaysRequest) (response ocicore.ListInternetGatewaysResponse, err error)
 	// ListLocalPeeringGateways(ctx context.Context, request ocicore.ListLocalPeeringGatewaysRequest) (response ocicore.ListLocalPeeringGatewaysResponse, err error)
 	// ListPrivateIps(ctx context.Context, request ocicore.ListPrivateIpsRequest) (response ocicore.ListPrivateIpsResponse, err error)
 	// ListPublicIps(ctx context.Context, request ocicore.ListPublicIpsRequest) (response ocicore.ListPublicIpsResponse, err error)
 	// ListRemotePeeringConnections(ctx context.Context, request ocicore.ListRemotePeeringConnectionsRequest) (response ocicore.ListRemotePeeringConnectionsResponse, err error)
-	// ListRouteTables(ctx context.Context, request ocicore.ListRouteTablesRequest) (response ocicore.ListRouteTablesResponse, err error)
-	// ListSecurityLists(ctx context.Context, request ocicore.ListSecurityListsRequest) (response ocicore.ListSecurityListsResponse, err error)
-	// ListSubnets(ctx context.Context, request ocicore.ListSubnetsRequest) (response ocicore.ListSubnetsResponse, err error)
-	// ListVcns(ctx context.Context, request ocicore.ListVcnsRequest) (response ocicore.ListVcnsResponse, err error)
+	ListRouteTables(ctx context.Context, request ocicore.ListRouteTablesRequest) (response ocicore.ListRouteTablesResponse, err error)
+	ListSecurityLists(ctx context.Context, request ocicore.ListSecurityListsRequest) (response ocicore.ListSecurityListsResponse, err error)
+	ListSubnets(ctx context.Context, request ocicore.ListSubnetsRequest) (response ocicore.ListSubnetsResponse, err error)
+	ListVcns(ctx context.Context, request ocicore.ListVcnsRequest) (response ocicore.ListVcnsResponse, err error)
 	// ListVirtualCircuitBandwidthShapes(ctx context.Context, request ocicore.ListVirtualCircuitBandwidthShapesRequest) (response ocicore.ListVirtualCircuitBandwidthShapesResponse, err error)
 	// ListVirtualCircuitPublicPrefixes(ctx context.Context, request ocicore.ListVirtualCircuitPublicPrefixesRequest) (response ocicore.ListVirtualCircuitPublicPrefixesResponse, err error)
 	// ListVirtualCircuits(ctx context.Context, request ocicore.ListVirtualCircuitsRequest) (response ocicore.ListVirtualCircuitsResponse, err error)
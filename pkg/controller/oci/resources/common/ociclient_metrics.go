@@ -0,0 +1,56 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	"github.com/oracle/oci-manager/pkg/metrics"
+)
+
+var (
+	ociRequests = metrics.NewCounterVec("ocimanager_oci_requests_total",
+		"Total number of OCI API calls by service, operation and result code", "service", "operation", "code")
+	ociRequestDuration = metrics.NewHistogramVec("ocimanager_oci_request_duration_seconds",
+		"Latency of OCI API calls by service and operation", nil, "service", "operation")
+)
+
+// metricsInterceptor records count, latency and result code of every OCI call
+func metricsInterceptor(ctx context.Context, call OciCall, next OciCallHandler) (interface{}, error) {
+	start := time.Now()
+	response, err := next(ctx)
+	ociRequestDuration.Observe(time.Since(start).Seconds(), call.Service, call.Operation)
+	ociRequests.Inc(call.Service, call.Operation, errorCode(err))
+	return response, err
+}
+
+// errorCode returns the service error code label for an OCI call result
+func errorCode(err error) string {
+	if err == nil {
+		return "OK"
+	}
+	if serviceErr, ok := ocisdkcommon.IsServiceError(err); ok {
+		if serviceErr.GetCode() != "" {
+			return serviceErr.GetCode()
+		}
+		return strconv.Itoa(serviceErr.GetHTTPStatusCode())
+	}
+	return "ClientError"
+}
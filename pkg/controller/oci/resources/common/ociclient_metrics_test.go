@@ -0,0 +1,57 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	"github.com/oracle/oci-manager/pkg/metrics"
+)
+
+func TestInstrumentedClientMetrics(t *testing.T) {
+	client := resourcescommon.InstrumentVcnClient(fakeoci.NewVcnClient())
+
+	r, err := client.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+		CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.0.0.0/16")},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if r.Id == nil || *r.Id == "" {
+		t.Errorf("Response of the wrapped client was not passed through")
+	}
+
+	var buf bytes.Buffer
+	metrics.DefaultRegistry.Write(&buf)
+	out := buf.String()
+
+	for _, expected := range []string{
+		`ocimanager_oci_requests_total{service="vcn",operation="CreateVcn",code="OK"} 1`,
+		`ocimanager_oci_request_duration_seconds_count{service="vcn",operation="CreateVcn"} 1`,
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q in metrics output:\n%s", expected, out)
+		}
+	}
+}
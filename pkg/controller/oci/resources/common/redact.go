@@ -0,0 +1,73 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// redacted replaces the values of secret request fields in audit records and dry-run plans
+const redacted = "REDACTED"
+
+// secretFields are the redacted request fields, e.g. adminPassword or privateKey, matched case insensitive
+// against the field name without underscores
+var secretFields = []string{"password", "passphrase", "privatekey", "secret", "userdata"}
+
+// redact returns the request as generic JSON value with the values of secret fields replaced
+func redact(request interface{}) interface{} {
+	if request == nil {
+		return nil
+	}
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return redactValue(value)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if isSecretField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactValue(v[i])
+		}
+	}
+	return value
+}
+
+func isSecretField(name string) bool {
+	name = strings.ToLower(strings.Replace(name, "_", "", -1))
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
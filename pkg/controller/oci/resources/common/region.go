@@ -0,0 +1,41 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+)
+
+// regionProvider signs requests with the credentials of another provider and sends
+// them to the endpoints of region
+type regionProvider struct {
+	ocisdkcommon.ConfigurationProvider
+	region string
+}
+
+// Region returns the region the SDK clients are built for
+func (p regionProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+// WithRegion returns a configuration provider with the credentials of provider for region
+func WithRegion(provider ocisdkcommon.ConfigurationProvider, region string) ocisdkcommon.ConfigurationProvider {
+	if p, ok := provider.(regionProvider); ok {
+		provider = p.ConfigurationProvider
+	}
+	return regionProvider{ConfigurationProvider: provider, region: region}
+}
@@ -29,6 +29,8 @@ type ResourceType struct {
 	ResourcePlural string
 	ControllerName string
 	Validation     *apiextv1beta1.CustomResourceValidation
+	PrinterColumns []apiextv1beta1.CustomResourceColumnDefinition
+	Subresources   *apiextv1beta1.CustomResourceSubresources
 	AdapterFactory AdapterFactory
 }
 
@@ -36,11 +38,13 @@ var typeRegistry = make(map[string]ResourceType)
 
 // RegisterResourceType registers the resource as an adapter
 func RegisterResourceType(groupName, kind, resourcePlural, controllerName string, factory AdapterFactory) {
-	RegisterResourceTypeWithValidation(groupName, kind, resourcePlural, controllerName, nil, factory)
+	RegisterResourceTypeWithValidation(groupName, kind, resourcePlural, controllerName, nil, nil, factory)
 }
 
-// RegisterResourceTypeWithValidation returns a resource type struct with proper validation
-func RegisterResourceTypeWithValidation(groupName, kind, resourcePlural string, controllerName string, validation *apiextv1beta1.CustomResourceValidation, factory AdapterFactory) {
+// RegisterResourceTypeWithValidation returns a resource type struct with proper validation and the
+// printer columns of kubectl get. The status of every resource type is written by the controller
+// through the status subresource.
+func RegisterResourceTypeWithValidation(groupName, kind, resourcePlural string, controllerName string, validation *apiextv1beta1.CustomResourceValidation, columns []apiextv1beta1.CustomResourceColumnDefinition, factory AdapterFactory) {
 	_, ok := typeRegistry[kind]
 	if ok {
 		// TODO Is panicking ok given that this is part of a type-registration mechanism
@@ -52,6 +56,10 @@ func RegisterResourceTypeWithValidation(groupName, kind, resourcePlural string,
 		ResourcePlural: resourcePlural,
 		ControllerName: controllerName,
 		Validation:     validation,
+		PrinterColumns: columns,
+		Subresources: &apiextv1beta1.CustomResourceSubresources{
+			Status: &apiextv1beta1.CustomResourceSubresourceStatus{},
+		},
 		AdapterFactory: factory,
 	}
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// RetryPolicy controls how a controller retries and requeues the objects of a kind.
+// Zero fields are unset and take the value of the policy they are merged into.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries of a failed reconcile before the object is stalled
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// BaseDelay and MaxDelay bound the exponential backoff between retries of a failed reconcile
+	BaseDelay metav1.Duration `json:"baseDelay,omitempty"`
+	MaxDelay  metav1.Duration `json:"maxDelay,omitempty"`
+	// QPS and Burst limit the overall retry rate of the kind
+	QPS   float64 `json:"qps,omitempty"`
+	Burst int     `json:"burst,omitempty"`
+	// PendingBaseDelay and PendingMaxDelay bound the backoff while polling a pending OCI operation
+	PendingBaseDelay metav1.Duration `json:"pendingBaseDelay,omitempty"`
+	PendingMaxDelay  metav1.Duration `json:"pendingMaxDelay,omitempty"`
+	// PendingTimeouts is how long a Create, Update or Delete may stay pending before the object is stalled
+	PendingTimeouts map[string]metav1.Duration `json:"pendingTimeouts,omitempty"`
+}
+
+// DefaultRetryPolicy returns the policy used for kinds without configuration
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:       5,
+		BaseDelay:        metav1.Duration{Duration: 2 * time.Second},
+		MaxDelay:         metav1.Duration{Duration: 1000 * time.Second},
+		QPS:              10,
+		Burst:            100,
+		PendingBaseDelay: metav1.Duration{Duration: 2 * time.Second},
+		PendingMaxDelay:  metav1.Duration{Duration: time.Minute},
+		PendingTimeouts: map[string]metav1.Duration{
+			"Create": {Duration: 60 * time.Minute},
+			"Update": {Duration: 30 * time.Minute},
+			"Delete": {Duration: 30 * time.Minute},
+		},
+	}
+}
+
+// Merge returns the policy with the fields set in override replaced
+func (p RetryPolicy) Merge(override RetryPolicy) RetryPolicy {
+	if override.MaxRetries != 0 {
+		p.MaxRetries = override.MaxRetries
+	}
+	if override.BaseDelay.Duration != 0 {
+		p.BaseDelay = override.BaseDelay
+	}
+	if override.MaxDelay.Duration != 0 {
+		p.MaxDelay = override.MaxDelay
+	}
+	if override.QPS != 0 {
+		p.QPS = override.QPS
+	}
+	if override.Burst != 0 {
+		p.Burst = override.Burst
+	}
+	if override.PendingBaseDelay.Duration != 0 {
+		p.PendingBaseDelay = override.PendingBaseDelay
+	}
+	if override.PendingMaxDelay.Duration != 0 {
+		p.PendingMaxDelay = override.PendingMaxDelay
+	}
+	if len(override.PendingTimeouts) > 0 {
+		timeouts := make(map[string]metav1.Duration, len(p.PendingTimeouts)+len(override.PendingTimeouts))
+		for operation, timeout := range p.PendingTimeouts {
+			timeouts[operation] = timeout
+		}
+		for operation, timeout := range override.PendingTimeouts {
+			timeouts[operation] = timeout
+		}
+		p.PendingTimeouts = timeouts
+	}
+	return p
+}
+
+// PendingTimeout returns the timeout of a pending operation, 0 if it never times out
+func (p RetryPolicy) PendingTimeout(operation string) time.Duration {
+	return p.PendingTimeouts[operation].Duration
+}
+
+// RateLimiter returns the workqueue rate limiter implementing the retry backoff
+func (p RetryPolicy) RateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(p.BaseDelay.Duration, p.MaxDelay.Duration),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(p.QPS), p.Burst)},
+	)
+}
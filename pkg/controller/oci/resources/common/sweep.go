@@ -0,0 +1,69 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SweepPolicy controls the sweeper looking for orphaned OCI resources, i.e. resources tagged
+// by the cluster that no object manages. Zero fields are unset and take the value of the
+// policy they are merged into.
+type SweepPolicy struct {
+	// Disabled turns the sweeper off
+	Disabled bool `json:"disabled,omitempty"`
+	// Interval is the time between two sweeps
+	Interval metav1.Duration `json:"interval,omitempty"`
+	// GracePeriod is the age a resource must reach before it is reported, it covers
+	// resources whose OCID was not yet written to the status of their object
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
+	// Delete deletes the orphaned resources, by default they are only reported
+	Delete bool `json:"delete,omitempty"`
+	// Compartments are the OCIDs of compartments swept in addition to the ones of the
+	// Compartment objects, they are listed with the default credentials
+	Compartments []string `json:"compartments,omitempty"`
+}
+
+// DefaultSweepPolicy returns the policy used without configuration
+func DefaultSweepPolicy() SweepPolicy {
+	return SweepPolicy{
+		Interval:    metav1.Duration{Duration: time.Hour},
+		GracePeriod: metav1.Duration{Duration: 30 * time.Minute},
+	}
+}
+
+// Merge returns the policy with the fields set in override replaced
+func (p SweepPolicy) Merge(override SweepPolicy) SweepPolicy {
+	if override.Disabled {
+		p.Disabled = true
+	}
+	if override.Interval.Duration != 0 {
+		p.Interval = override.Interval
+	}
+	if override.GracePeriod.Duration != 0 {
+		p.GracePeriod = override.GracePeriod
+	}
+	if override.Delete {
+		p.Delete = true
+	}
+	if len(override.Compartments) > 0 {
+		p.Compartments = override.Compartments
+	}
+	return p
+}
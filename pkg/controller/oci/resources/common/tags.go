@@ -0,0 +1,171 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"sync/atomic"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+)
+
+// Freeform tags set on every OCI resource created by oci-manager. They take
+// precedence over user tags with the same key.
+const (
+	TagManagedBy = "managed-by"
+	TagClusterID = "cluster-id"
+	TagNamespace = "namespace"
+	TagName      = "name"
+	TagUID       = "uid"
+	TagKind      = "kind"
+
+	// ManagedByValue is the value of the managed-by tag
+	ManagedByValue = "oci-manager"
+)
+
+var clusterID atomic.Value
+
+// SetClusterID sets the id of the kubernetes cluster stamped on the OCI resources
+func SetClusterID(id string) {
+	clusterID.Store(id)
+}
+
+// ClusterID returns the id of the kubernetes cluster stamped on the OCI resources
+func ClusterID() string {
+	id, _ := clusterID.Load().(string)
+	return id
+}
+
+// OwnershipTags returns the freeform tags identifying the object of kind that owns an OCI resource
+func OwnershipTags(kind string, meta metav1.Object) map[string]string {
+	tags := map[string]string{
+		TagManagedBy: ManagedByValue,
+		TagNamespace: meta.GetNamespace(),
+		TagName:      meta.GetName(),
+		TagUID:       string(meta.GetUID()),
+		TagKind:      kind,
+	}
+	if id := ClusterID(); id != "" {
+		tags[TagClusterID] = id
+	}
+	return tags
+}
+
+// IsOwned returns true if the freeform tags mark a resource as managed by oci-manager
+func IsOwned(tags map[string]string) bool {
+	return tags[TagManagedBy] == ManagedByValue
+}
+
+// ReleasedTags returns the freeform tags without the ownership tags, a released resource
+// is no longer considered by the orphan sweeper
+func ReleasedTags(tags map[string]string) map[string]string {
+	released := make(map[string]string, len(tags))
+	for key, value := range tags {
+		switch key {
+		case TagManagedBy, TagClusterID, TagNamespace, TagName, TagUID, TagKind:
+		default:
+			released[key] = value
+		}
+	}
+	return released
+}
+
+// NewOwnedResource returns the owned resource with the attributes of an OCI resource
+func NewOwnedResource(id, displayName *string, timeCreated *ocisdkcommon.SDKTime, tags map[string]string) OwnedResource {
+	resource := OwnedResource{Tags: tags}
+	if id != nil {
+		resource.Id = *id
+	}
+	if displayName != nil {
+		resource.DisplayName = *displayName
+	}
+	if timeCreated != nil {
+		resource.TimeCreated = timeCreated.Time
+	}
+	return resource
+}
+
+// OwnedTags returns the user tags of the object with its ownership tags merged into the freeform tags
+func OwnedTags(kind string, meta metav1.Object, tags ocicommon.ResourceTags) ocicommon.ResourceTags {
+	owned := ocicommon.ResourceTags{
+		FreeformTags: make(map[string]string, len(tags.FreeformTags)+6),
+		DefinedTags:  tags.DefinedTags,
+	}
+	for key, value := range tags.FreeformTags {
+		owned.FreeformTags[key] = value
+	}
+	for key, value := range OwnershipTags(kind, meta) {
+		owned.FreeformTags[key] = value
+	}
+	return owned
+}
+
+// MergeTags returns the tags to update a resource carrying the current tags with. OCI replaces
+// all tags on update, tags set outside of oci-manager such as tag defaults are kept.
+func MergeTags(current, desired ocicommon.ResourceTags) ocicommon.ResourceTags {
+	merged := ocicommon.ResourceTags{FreeformTags: map[string]string{}}
+	for key, value := range current.FreeformTags {
+		merged.FreeformTags[key] = value
+	}
+	for key, value := range desired.FreeformTags {
+		merged.FreeformTags[key] = value
+	}
+	if len(current.DefinedTags) == 0 && len(desired.DefinedTags) == 0 {
+		return merged
+	}
+	merged.DefinedTags = map[string]map[string]interface{}{}
+	for _, tags := range []map[string]map[string]interface{}{current.DefinedTags, desired.DefinedTags} {
+		for namespace, values := range tags {
+			if merged.DefinedTags[namespace] == nil {
+				merged.DefinedTags[namespace] = map[string]interface{}{}
+			}
+			for key, value := range values {
+				merged.DefinedTags[namespace][key] = value
+			}
+		}
+	}
+	return merged
+}
+
+// CheckTags records the freeform and defined tags as drifted when a desired tag is missing
+// on the resource or has another value. Additional tags of the resource are not drift.
+func (d *Drift) CheckTags(desired, actual ocicommon.ResourceTags) {
+	for key, value := range desired.FreeformTags {
+		if actualValue, ok := actual.FreeformTags[key]; !ok || actualValue != value {
+			d.Add("freeformTags", desired.FreeformTags, actual.FreeformTags)
+			break
+		}
+	}
+	for namespace, values := range desired.DefinedTags {
+		if !containsDefinedTags(actual.DefinedTags[namespace], values) {
+			d.Add("definedTags", desired.DefinedTags, actual.DefinedTags)
+			break
+		}
+	}
+}
+
+func containsDefinedTags(actual, desired map[string]interface{}) bool {
+	for key, value := range desired {
+		actualValue, ok := actual[key]
+		if !ok || formatValue(actualValue) != formatValue(value) {
+			return false
+		}
+	}
+	return true
+}
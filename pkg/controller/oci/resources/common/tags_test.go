@@ -0,0 +1,108 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+)
+
+func TestOwnedTags(t *testing.T) {
+	resourcescommon.SetClusterID("cluster1")
+	defer resourcescommon.SetClusterID("")
+
+	meta := &metav1.ObjectMeta{Name: "vcn1", Namespace: "ns1", UID: "uid1"}
+	tags := resourcescommon.OwnedTags("Vcn", meta, ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "db", resourcescommon.TagName: "other"},
+		DefinedTags:  map[string]map[string]interface{}{"ops": {"cost-center": "42"}},
+	})
+
+	expected := map[string]string{
+		"team":                       "db",
+		resourcescommon.TagManagedBy: resourcescommon.ManagedByValue,
+		resourcescommon.TagClusterID: "cluster1",
+		resourcescommon.TagNamespace: "ns1",
+		resourcescommon.TagName:      "vcn1",
+		resourcescommon.TagUID:       "uid1",
+		resourcescommon.TagKind:      "Vcn",
+	}
+	if !reflect.DeepEqual(tags.FreeformTags, expected) {
+		t.Errorf("Expected freeform tags %v, got %v", expected, tags.FreeformTags)
+	}
+	if tags.DefinedTags["ops"]["cost-center"] != "42" {
+		t.Errorf("Expected the defined tags of the spec, got %v", tags.DefinedTags)
+	}
+
+	resourcescommon.SetClusterID("")
+	if _, ok := resourcescommon.OwnershipTags("Vcn", meta)[resourcescommon.TagClusterID]; ok {
+		t.Errorf("Expected no cluster-id tag without a cluster id")
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	current := ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "db", "owner": "console"},
+		DefinedTags:  map[string]map[string]interface{}{"defaults": {"created-by": "admin"}},
+	}
+	desired := ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "web"},
+		DefinedTags:  map[string]map[string]interface{}{"ops": {"cost-center": "42"}},
+	}
+
+	merged := resourcescommon.MergeTags(current, desired)
+	expected := ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "web", "owner": "console"},
+		DefinedTags: map[string]map[string]interface{}{
+			"defaults": {"created-by": "admin"},
+			"ops":      {"cost-center": "42"},
+		},
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("Expected %v, got %v", expected, merged)
+	}
+	if current.FreeformTags["team"] != "db" {
+		t.Errorf("Expected the current tags not to be modified, got %v", current.FreeformTags)
+	}
+}
+
+func TestDriftCheckTags(t *testing.T) {
+	desired := ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "db"},
+		DefinedTags:  map[string]map[string]interface{}{"ops": {"cost-center": "42"}},
+	}
+
+	var drift resourcescommon.Drift
+	drift.CheckTags(desired, ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "db", "owner": "console"},
+		DefinedTags:  map[string]map[string]interface{}{"ops": {"cost-center": "42"}, "defaults": {"created-by": "admin"}},
+	})
+	if !drift.Compliant() {
+		t.Fatalf("Expected additional tags of the resource not to drift, got %v", drift)
+	}
+
+	drift.CheckTags(desired, ocicommon.ResourceTags{
+		FreeformTags: map[string]string{"team": "web"},
+	})
+	if len(drift) != 2 || drift[0].Field != "freeformTags" || drift[1].Field != "definedTags" {
+		t.Errorf("Expected freeformTags and definedTags to drift, got %v", drift)
+	}
+}
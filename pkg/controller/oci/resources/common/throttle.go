@@ -0,0 +1,242 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/oracle/oci-manager/pkg/metrics"
+)
+
+// ThrottlePolicy limits the rate of the OCI calls of a service and controls how calls
+// answered with 429 or a 5xx status are retried. Zero fields are unset and take the
+// value of the policy they are merged into.
+type ThrottlePolicy struct {
+	// QPS and Burst size the token bucket shared by all controllers calling the service
+	QPS   float64 `json:"qps,omitempty"`
+	Burst int     `json:"burst,omitempty"`
+	// MaxRetries is the number of retries of a throttled call before it fails with a ThrottledError
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// BaseDelay and MaxDelay bound the jittered exponential backoff between retries,
+	// a retry-after hint of the service takes precedence over BaseDelay
+	BaseDelay metav1.Duration `json:"baseDelay,omitempty"`
+	MaxDelay  metav1.Duration `json:"maxDelay,omitempty"`
+}
+
+// DefaultThrottlePolicy returns the policy used for services without configuration
+func DefaultThrottlePolicy() ThrottlePolicy {
+	return ThrottlePolicy{
+		QPS:        10,
+		Burst:      20,
+		MaxRetries: 3,
+		BaseDelay:  metav1.Duration{Duration: time.Second},
+		MaxDelay:   metav1.Duration{Duration: 30 * time.Second},
+	}
+}
+
+// Merge returns the policy with the fields set in override replaced
+func (p ThrottlePolicy) Merge(override ThrottlePolicy) ThrottlePolicy {
+	if override.QPS != 0 {
+		p.QPS = override.QPS
+	}
+	if override.Burst != 0 {
+		p.Burst = override.Burst
+	}
+	if override.MaxRetries != 0 {
+		p.MaxRetries = override.MaxRetries
+	}
+	if override.BaseDelay.Duration != 0 {
+		p.BaseDelay = override.BaseDelay
+	}
+	if override.MaxDelay.Duration != 0 {
+		p.MaxDelay = override.MaxDelay
+	}
+	return p
+}
+
+// backoff returns the delay before retry attempt, starting at retryAfter if the service sent one
+func (p ThrottlePolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := p.BaseDelay.Duration
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	for i := 0; i < attempt && delay < p.MaxDelay.Duration; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay.Duration {
+		delay = p.MaxDelay.Duration
+	}
+	// up to 20% jitter so throttled workers do not retry in lockstep
+	if jitter := int64(delay) / 5; jitter > 0 {
+		delay += time.Duration(rand.Int63n(jitter))
+	}
+	if delay < retryAfter {
+		delay = retryAfter
+	}
+	return delay
+}
+
+// ThrottledError is returned by an OCI call the service kept answering with 429, or
+// by a read-only call it kept answering with a 5xx status. The call may be attempted
+// again after RetryAfter.
+type ThrottledError struct {
+	Call       OciCall
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%s %s throttled, retry after %v: %v", e.Call.Service, e.Call.Operation, e.RetryAfter, e.Err)
+}
+
+// Temporary reports that the call failed transiently and is not a resource error
+func (e *ThrottledError) Temporary() bool {
+	return true
+}
+
+// IsThrottled returns the ThrottledError if err is one
+func IsThrottled(err error) (*ThrottledError, bool) {
+	throttled, ok := err.(*ThrottledError)
+	return throttled, ok
+}
+
+var (
+	ociThrottled = metrics.NewCounterVec("ocimanager_oci_throttled_total",
+		"Total number of OCI API calls answered with 429 or a 5xx status by service, operation and result code", "service", "operation", "code")
+	ociThrottleWait = metrics.NewHistogramVec("ocimanager_oci_throttle_wait_seconds",
+		"Time OCI API calls waited for the rate limiter and retries by service", nil, "service")
+)
+
+// throttle holds the token bucket of every service
+type throttle struct {
+	lock     sync.Mutex
+	policy   ThrottlePolicy
+	services map[string]ThrottlePolicy
+	limiters map[string]*rate.Limiter
+}
+
+var ociThrottle = &throttle{policy: DefaultThrottlePolicy()}
+
+// SetThrottlePolicies replaces the throttle policy of all services and the per-service
+// overrides. Limiters are created again, the tokens of the previous limiters are dropped.
+func SetThrottlePolicies(policy ThrottlePolicy, services map[string]ThrottlePolicy) {
+	ociThrottle.lock.Lock()
+	defer ociThrottle.lock.Unlock()
+	ociThrottle.policy = DefaultThrottlePolicy().Merge(policy)
+	ociThrottle.services = services
+	ociThrottle.limiters = nil
+}
+
+// limiter returns the shared token bucket and the policy of a service
+func (t *throttle) limiter(service string) (*rate.Limiter, ThrottlePolicy) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	policy := t.policy.Merge(t.services[service])
+	limiter, ok := t.limiters[service]
+	if !ok {
+		if t.limiters == nil {
+			t.limiters = make(map[string]*rate.Limiter)
+		}
+		limiter = rate.NewLimiter(rate.Limit(policy.QPS), policy.Burst)
+		t.limiters[service] = limiter
+	}
+	return limiter, policy
+}
+
+// throttleInterceptor waits for a token of the service before every attempt of a call
+// and retries calls answered with 429, and read-only calls answered with a 5xx status.
+// A call that is still throttled after the retries fails with a ThrottledError, a
+// mutating call answered with a 5xx status fails with the service error right away.
+func throttleInterceptor(ctx context.Context, call OciCall, next OciCallHandler) (interface{}, error) {
+	limiter, policy := ociThrottle.limiter(call.Service)
+	start := time.Now()
+	defer func() {
+		ociThrottleWait.Observe(time.Since(start).Seconds(), call.Service)
+	}()
+
+	for attempt := 0; ; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+		response, err := next(ctx)
+		status := httpStatus(err)
+		if status != http.StatusTooManyRequests && status < http.StatusInternalServerError {
+			return response, err
+		}
+		ociThrottled.Inc(call.Service, call.Operation, errorCode(err))
+
+		// a mutating call failing with a 5xx status may have been applied, it is
+		// not retried and fails like any other error of the resource so that the
+		// next reconcile finds out and the retries of the object are counted
+		if status != http.StatusTooManyRequests && !isReadOnly(call.Operation) {
+			return response, err
+		}
+		delay := policy.backoff(attempt, retryAfter(response))
+		if attempt >= policy.MaxRetries {
+			return response, &ThrottledError{Call: call, RetryAfter: delay, Err: err}
+		}
+		glog.V(2).Infof("OCI %s %s throttled with status %d, retry in %v", call.Service, call.Operation, status, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return response, ctx.Err()
+		}
+	}
+}
+
+// httpStatus returns the status code of a service error, 0 for other errors
+func httpStatus(err error) int {
+	if serviceErr, ok := err.(ocisdkcommon.ServiceError); ok {
+		return serviceErr.GetHTTPStatusCode()
+	}
+	return 0
+}
+
+// retryAfter returns the delay requested by the Retry-After header of an sdk response
+func retryAfter(response interface{}) time.Duration {
+	ociResponse, ok := response.(ocisdkcommon.OCIResponse)
+	if !ok || ociResponse.HTTPResponse() == nil {
+		return 0
+	}
+	value := ociResponse.HTTPResponse().Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(when); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+)
+
+type serviceError struct {
+	status int
+	code   string
+}
+
+func (e serviceError) Error() string           { return e.code }
+func (e serviceError) GetHTTPStatusCode() int  { return e.status }
+func (e serviceError) GetMessage() string      { return e.code }
+func (e serviceError) GetCode() string         { return e.code }
+func (e serviceError) GetOpcRequestID() string { return "" }
+
+// throttledVcnClient fails the first calls with err and a Retry-After header
+type throttledVcnClient struct {
+	resourcescommon.VcnClientInterface
+	failures   int
+	calls      int
+	err        error
+	retryAfter string
+}
+
+func (c *throttledVcnClient) response() *http.Response {
+	header := http.Header{}
+	if c.retryAfter != "" {
+		header.Set("Retry-After", c.retryAfter)
+	}
+	return &http.Response{StatusCode: c.err.(serviceError).status, Header: header}
+}
+
+func (c *throttledVcnClient) GetVcn(ctx context.Context, request ocicore.GetVcnRequest) (ocicore.GetVcnResponse, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return ocicore.GetVcnResponse{RawResponse: c.response()}, c.err
+	}
+	return c.VcnClientInterface.GetVcn(ctx, request)
+}
+
+func (c *throttledVcnClient) CreateVcn(ctx context.Context, request ocicore.CreateVcnRequest) (ocicore.CreateVcnResponse, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return ocicore.CreateVcnResponse{RawResponse: c.response()}, c.err
+	}
+	return c.VcnClientInterface.CreateVcn(ctx, request)
+}
+
+func TestThrottle(t *testing.T) {
+	resourcescommon.SetThrottlePolicies(resourcescommon.ThrottlePolicy{
+		MaxRetries: 2,
+		BaseDelay:  metav1.Duration{Duration: time.Millisecond},
+		MaxDelay:   metav1.Duration{Duration: 10 * time.Millisecond},
+	}, nil)
+	defer resourcescommon.SetThrottlePolicies(resourcescommon.ThrottlePolicy{}, nil)
+
+	tooManyRequests := serviceError{status: http.StatusTooManyRequests, code: "TooManyRequests"}
+	unavailable := serviceError{status: http.StatusServiceUnavailable, code: "ServiceUnavailable"}
+	notFound := serviceError{status: http.StatusNotFound, code: "NotAuthorizedOrNotFound"}
+
+	testCases := []struct {
+		name          string
+		create        bool
+		failures      int
+		err           error
+		retryAfter    string
+		expectedCalls int
+		throttled     bool
+		minRetryAfter time.Duration
+	}{
+		{name: "retried until success", failures: 2, err: tooManyRequests, expectedCalls: 3},
+		{name: "read retried on 5xx", failures: 1, err: unavailable, expectedCalls: 2},
+		{name: "retries exhausted", failures: 5, err: tooManyRequests, expectedCalls: 3, throttled: true},
+		{name: "mutating call not retried on 5xx", create: true, failures: 1, err: unavailable, expectedCalls: 1},
+		{name: "mutating call retried on 429", create: true, failures: 1, err: tooManyRequests, expectedCalls: 2},
+		{name: "other errors not retried", failures: 1, err: notFound, expectedCalls: 1},
+		{name: "retry-after honoured", failures: 5, err: tooManyRequests, retryAfter: "1", expectedCalls: 3, throttled: true, minRetryAfter: time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fakeoci.NewVcnClient()
+			existing, err := fakeClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+				CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.0.0.0/16")},
+			})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+			throttledClient := &throttledVcnClient{VcnClientInterface: fakeClient, failures: tc.failures, err: tc.err, retryAfter: tc.retryAfter}
+			client := resourcescommon.InstrumentVcnClient(throttledClient)
+
+			start := time.Now()
+			if tc.create {
+				_, err = client.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+					CreateVcnDetails: ocicore.CreateVcnDetails{CidrBlock: ocisdkcommon.String("10.1.0.0/16")},
+				})
+			} else {
+				_, err = client.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: existing.Id})
+			}
+
+			if throttledClient.calls != tc.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", tc.expectedCalls, throttledClient.calls)
+			}
+			throttled, ok := resourcescommon.IsThrottled(err)
+			if ok != tc.throttled {
+				t.Fatalf("Expected throttled %v, got error %v", tc.throttled, err)
+			}
+			if !ok {
+				if tc.failures < tc.expectedCalls && err != nil {
+					t.Errorf("Expected the call to succeed, got %v", err)
+				} else if tc.failures >= tc.expectedCalls && err != tc.err {
+					t.Errorf("Expected the service error %v, got %v", tc.err, err)
+				}
+				return
+			}
+			if !throttled.Temporary() || throttled.Err != tc.err {
+				t.Errorf("Unexpected throttled error %#v", throttled)
+			}
+			if throttled.RetryAfter < tc.minRetryAfter {
+				t.Errorf("Expected retry after of at least %v, got %v", tc.minRetryAfter, throttled.RetryAfter)
+			}
+			if elapsed := time.Since(start); tc.minRetryAfter > 0 && elapsed < time.Duration(tc.expectedCalls-1)*tc.minRetryAfter {
+				t.Errorf("Expected retries to wait for the retry after hint, took %v", elapsed)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ package common
 
 import (
 	"strings"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
 )
 
 // StrPtrOrNil returns a pointer to the provided string
@@ -48,3 +50,9 @@ func Display(objName string, displayName string) *string {
 	}
 	return &display
 }
+
+// IsNotFound returns true if the error is an OCI service error for a missing resource
+func IsNotFound(err error) bool {
+	serviceErr, ok := ocisdkcommon.IsServiceError(err)
+	return ok && serviceErr.GetCode() == "NotAuthorizedOrNotFound"
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocice "github.com/oracle/oci-go-sdk/containerengine"
+	ocilb "github.com/oracle/oci-go-sdk/loadbalancer"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkRequestError is returned when an OCI work request failed or was canceled
+type WorkRequestError struct {
+	WorkRequest ocicommon.WorkRequestStatus
+}
+
+func (e *WorkRequestError) Error() string {
+	msg := fmt.Sprintf("work request %s %s", e.WorkRequest.Id, strings.ToLower(e.WorkRequest.Status))
+	if len(e.WorkRequest.Errors) > 0 {
+		msg += ": " + strings.Join(e.WorkRequest.Errors, "; ")
+	}
+	return msg
+}
+
+// IsWorkRequestFailed reports if err is a failed or canceled work request. The adapters
+// forget such a work request so that the next attempt issues a new operation, the
+// failure stays recorded in the work request status.
+func IsWorkRequestFailed(err error) bool {
+	_, ok := err.(*WorkRequestError)
+	return ok
+}
+
+// StartWorkRequest records the work request returned by a create, update or
+// delete call as accepted, the controller keeps the object pending until the
+// work request is done
+func StartWorkRequest(status *ocicommon.ResourceStatus, service, operation string, id *string) {
+	if id == nil {
+		return
+	}
+	now := metav1.Now()
+	status.WorkRequest = &ocicommon.WorkRequestStatus{
+		Id:        *id,
+		Service:   service,
+		Operation: operation,
+		Status:    string(ocilb.WorkRequestLifecycleStateAccepted),
+		Accepted:  &now,
+	}
+}
+
+// PollLoadBalancerWorkRequest fetches the load balancer work request and records its
+// progress in status. A succeeded work request is cleared from status, a failed one is
+// kept and returned as a WorkRequestError including the error details.
+func PollLoadBalancerWorkRequest(ctx context.Context, client LoadBalancerClientInterface, status *ocicommon.ResourceStatus, id *string) (ocilb.WorkRequest, error) {
+	resp, err := client.GetWorkRequest(ctx, ocilb.GetWorkRequestRequest{WorkRequestId: id})
+	if err != nil {
+		return resp.WorkRequest, err
+	}
+	wr := resp.WorkRequest
+
+	progress := workRequestProgress(status, "loadbalancer", *id, string(wr.LifecycleState), wr.TimeAccepted)
+	if wr.Type != nil {
+		progress.Operation = *wr.Type
+	}
+	if wr.Message != nil {
+		progress.Message = *wr.Message
+	}
+	progress.Errors = nil
+	for _, e := range wr.ErrorDetails {
+		progress.Errors = append(progress.Errors, fmt.Sprintf("%s: %s", e.ErrorCode, stringValue(e.Message)))
+	}
+	return wr, finishWorkRequest(status, progress)
+}
+
+// PollContainerEngineWorkRequest fetches the container engine work request and records
+// its progress in status. The errors of a failed work request are listed separately
+// since they are not part of the work request itself.
+func PollContainerEngineWorkRequest(ctx context.Context, client ContainerEngineClientInterface, status *ocicommon.ResourceStatus, id *string) (ocice.WorkRequest, error) {
+	resp, err := client.GetWorkRequest(ctx, ocice.GetWorkRequestRequest{WorkRequestId: id})
+	if err != nil {
+		return resp.WorkRequest, err
+	}
+	wr := resp.WorkRequest
+
+	progress := workRequestProgress(status, "containerengine", *id, string(wr.Status), wr.TimeAccepted)
+	if wr.OperationType != "" {
+		progress.Operation = string(wr.OperationType)
+	}
+	if progress.Failed() && len(progress.Errors) == 0 {
+		errorsResp, e := client.ListWorkRequestErrors(ctx, ocice.ListWorkRequestErrorsRequest{
+			CompartmentId: wr.CompartmentId,
+			WorkRequestId: id,
+		})
+		if e != nil {
+			glog.Errorf("ListWorkRequestErrors %s error: %v", *id, e)
+		}
+		for _, item := range errorsResp.Items {
+			progress.Errors = append(progress.Errors, fmt.Sprintf("%s: %s", stringValue(item.Code), stringValue(item.Message)))
+		}
+	}
+	return wr, finishWorkRequest(status, progress)
+}
+
+// workRequestProgress returns the work request progress recorded in status, the
+// progress is reset when status refers to another work request
+func workRequestProgress(status *ocicommon.ResourceStatus, service, id, state string, accepted *ocisdkcommon.SDKTime) *ocicommon.WorkRequestStatus {
+	progress := status.WorkRequest
+	if progress == nil || progress.Id != id {
+		progress = &ocicommon.WorkRequestStatus{Id: id, Service: service}
+	}
+	progress.Status = state
+	if accepted != nil && progress.Accepted == nil {
+		t := metav1.NewTime(accepted.Time)
+		progress.Accepted = &t
+	}
+	return progress
+}
+
+func finishWorkRequest(status *ocicommon.ResourceStatus, progress *ocicommon.WorkRequestStatus) error {
+	glog.V(4).Infof("%s work request %s state: %s", progress.Service, progress.Id, progress.Status)
+	if progress.Status == ocicommon.WorkRequestSucceeded {
+		status.WorkRequest = nil
+		return nil
+	}
+	status.WorkRequest = progress
+	if progress.Failed() {
+		return &WorkRequestError{WorkRequest: *progress}
+	}
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
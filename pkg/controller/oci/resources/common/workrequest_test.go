@@ -0,0 +1,136 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocice "github.com/oracle/oci-go-sdk/containerengine"
+	ocilb "github.com/oracle/oci-go-sdk/loadbalancer"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+)
+
+type workRequestLbClient struct {
+	resourcescommon.LoadBalancerClientInterface
+	workRequest ocilb.WorkRequest
+}
+
+func (c *workRequestLbClient) GetWorkRequest(ctx context.Context, request ocilb.GetWorkRequestRequest) (ocilb.GetWorkRequestResponse, error) {
+	return ocilb.GetWorkRequestResponse{WorkRequest: c.workRequest}, nil
+}
+
+type workRequestCeClient struct {
+	resourcescommon.ContainerEngineClientInterface
+	workRequest ocice.WorkRequest
+	errors      []ocice.WorkRequestError
+}
+
+func (c *workRequestCeClient) GetWorkRequest(ctx context.Context, request ocice.GetWorkRequestRequest) (ocice.GetWorkRequestResponse, error) {
+	return ocice.GetWorkRequestResponse{WorkRequest: c.workRequest}, nil
+}
+
+func (c *workRequestCeClient) ListWorkRequestErrors(ctx context.Context, request ocice.ListWorkRequestErrorsRequest) (ocice.ListWorkRequestErrorsResponse, error) {
+	return ocice.ListWorkRequestErrorsResponse{Items: c.errors}, nil
+}
+
+func TestPollLoadBalancerWorkRequest(t *testing.T) {
+	id := ocisdkcommon.String("wr1")
+	status := &ocicommon.ResourceStatus{}
+	resourcescommon.StartWorkRequest(status, "loadbalancer", "CreateLoadBalancer", id)
+
+	client := &workRequestLbClient{workRequest: ocilb.WorkRequest{
+		LifecycleState: ocilb.WorkRequestLifecycleStateInProgress,
+		Type:           ocisdkcommon.String("CreateLoadBalancer"),
+		Message:        ocisdkcommon.String("provisioning"),
+	}}
+	if _, err := resourcescommon.PollLoadBalancerWorkRequest(context.Background(), client, status, id); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if status.WorkRequest == nil || status.WorkRequest.Status != "IN_PROGRESS" || status.WorkRequest.Message != "provisioning" || status.WorkRequest.Accepted == nil {
+		t.Fatalf("Unexpected work request progress %#v", status.WorkRequest)
+	}
+
+	client.workRequest.LifecycleState = ocilb.WorkRequestLifecycleStateFailed
+	client.workRequest.ErrorDetails = []ocilb.WorkRequestError{{ErrorCode: ocilb.WorkRequestErrorErrorCodeBadInput, Message: ocisdkcommon.String("bad shape")}}
+	_, err := resourcescommon.PollLoadBalancerWorkRequest(context.Background(), client, status, id)
+	if _, ok := err.(*resourcescommon.WorkRequestError); !ok || !strings.Contains(err.Error(), "bad shape") {
+		t.Fatalf("Expected work request error with details, got %v", err)
+	}
+	if status.WorkRequest == nil || !status.WorkRequest.Failed() {
+		t.Errorf("Expected failed work request in status, got %#v", status.WorkRequest)
+	}
+
+	client.workRequest.LifecycleState = ocilb.WorkRequestLifecycleStateSucceeded
+	client.workRequest.ErrorDetails = nil
+	if _, err := resourcescommon.PollLoadBalancerWorkRequest(context.Background(), client, status, id); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if status.WorkRequest != nil {
+		t.Errorf("Expected succeeded work request to be cleared, got %#v", status.WorkRequest)
+	}
+}
+
+func TestPollContainerEngineWorkRequest(t *testing.T) {
+	id := ocisdkcommon.String("wr2")
+	status := &ocicommon.ResourceStatus{}
+	client := &workRequestCeClient{
+		workRequest: ocice.WorkRequest{
+			Status:        ocice.WorkRequestStatusFailed,
+			OperationType: ocice.WorkRequestOperationTypeClusterCreate,
+		},
+		errors: []ocice.WorkRequestError{{Code: ocisdkcommon.String("LimitExceeded"), Message: ocisdkcommon.String("cluster limit reached")}},
+	}
+
+	_, err := resourcescommon.PollContainerEngineWorkRequest(context.Background(), client, status, id)
+	if err == nil || !strings.Contains(err.Error(), "LimitExceeded: cluster limit reached") {
+		t.Fatalf("Expected work request error with listed errors, got %v", err)
+	}
+	if status.WorkRequest == nil || status.WorkRequest.Operation != "CLUSTER_CREATE" || len(status.WorkRequest.Errors) != 1 {
+		t.Errorf("Unexpected work request progress %#v", status.WorkRequest)
+	}
+}
+
+func TestIsWorkRequestFailed(t *testing.T) {
+	id := ocisdkcommon.String("wr3")
+	status := &ocicommon.ResourceStatus{}
+	resourcescommon.StartWorkRequest(status, "loadbalancer", "UpdateLoadBalancer", id)
+
+	client := &workRequestLbClient{workRequest: ocilb.WorkRequest{LifecycleState: ocilb.WorkRequestLifecycleStateInProgress}}
+	_, err := resourcescommon.PollLoadBalancerWorkRequest(context.Background(), client, status, id)
+	if resourcescommon.IsWorkRequestFailed(err) {
+		t.Fatalf("Expected running work request not to be failed")
+	}
+
+	client.workRequest.LifecycleState = ocilb.WorkRequestLifecycleStateFailed
+	_, err = resourcescommon.PollLoadBalancerWorkRequest(context.Background(), client, status, id)
+	if !resourcescommon.IsWorkRequestFailed(err) {
+		t.Fatalf("Expected failed work request, got %v", err)
+	}
+	// the adapters forget the failed work request, its failure stays in status
+	if status.WorkRequest == nil || status.WorkRequest.Id != "wr3" || !status.WorkRequest.Failed() {
+		t.Errorf("Expected failed work request in status, got %#v", status.WorkRequest)
+	}
+
+	if resourcescommon.IsWorkRequestFailed(errors.New("service unavailable")) {
+		t.Errorf("Expected service error not to be a failed work request")
+	}
+}
@@ -0,0 +1,1151 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by hack/gen-ociclient. DO NOT EDIT.
+
+package common
+
+import (
+	"context"
+	ocice "github.com/oracle/oci-go-sdk/containerengine"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	ocidb "github.com/oracle/oci-go-sdk/database"
+	ociid "github.com/oracle/oci-go-sdk/identity"
+	ocilb "github.com/oracle/oci-go-sdk/loadbalancer"
+)
+
+// InstrumentBlockStorageClient wraps a BlockStorageClientInterface so every call goes through the OCI call interceptors
+func InstrumentBlockStorageClient(client BlockStorageClientInterface) BlockStorageClientInterface {
+	return &instrumentedBlockStorageClient{client: client}
+}
+
+type instrumentedBlockStorageClient struct {
+	client BlockStorageClientInterface
+}
+
+// CreateVolume calls CreateVolume through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) CreateVolume(ctx context.Context, request ocicore.CreateVolumeRequest) (ocicore.CreateVolumeResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "CreateVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateVolumeResponse)
+	return response, err
+}
+
+// CreateVolumeBackup calls CreateVolumeBackup through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) CreateVolumeBackup(ctx context.Context, request ocicore.CreateVolumeBackupRequest) (ocicore.CreateVolumeBackupResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "CreateVolumeBackup", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateVolumeBackup(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateVolumeBackupResponse)
+	return response, err
+}
+
+// DeleteVolume calls DeleteVolume through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) DeleteVolume(ctx context.Context, request ocicore.DeleteVolumeRequest) (ocicore.DeleteVolumeResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "DeleteVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteVolumeResponse)
+	return response, err
+}
+
+// DeleteVolumeBackup calls DeleteVolumeBackup through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) DeleteVolumeBackup(ctx context.Context, request ocicore.DeleteVolumeBackupRequest) (ocicore.DeleteVolumeBackupResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "DeleteVolumeBackup", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteVolumeBackup(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteVolumeBackupResponse)
+	return response, err
+}
+
+// GetBootVolume calls GetBootVolume through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) GetBootVolume(ctx context.Context, request ocicore.GetBootVolumeRequest) (ocicore.GetBootVolumeResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "GetBootVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetBootVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.GetBootVolumeResponse)
+	return response, err
+}
+
+// GetVolume calls GetVolume through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) GetVolume(ctx context.Context, request ocicore.GetVolumeRequest) (ocicore.GetVolumeResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "GetVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.GetVolumeResponse)
+	return response, err
+}
+
+// GetVolumeBackup calls GetVolumeBackup through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) GetVolumeBackup(ctx context.Context, request ocicore.GetVolumeBackupRequest) (ocicore.GetVolumeBackupResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "GetVolumeBackup", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetVolumeBackup(ctx, request)
+	})
+	response, _ := r.(ocicore.GetVolumeBackupResponse)
+	return response, err
+}
+
+// ListVolumeBackups calls ListVolumeBackups through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) ListVolumeBackups(ctx context.Context, request ocicore.ListVolumeBackupsRequest) (ocicore.ListVolumeBackupsResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "ListVolumeBackups", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListVolumeBackups(ctx, request)
+	})
+	response, _ := r.(ocicore.ListVolumeBackupsResponse)
+	return response, err
+}
+
+// ListVolumes calls ListVolumes through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) ListVolumes(ctx context.Context, request ocicore.ListVolumesRequest) (ocicore.ListVolumesResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "ListVolumes", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListVolumes(ctx, request)
+	})
+	response, _ := r.(ocicore.ListVolumesResponse)
+	return response, err
+}
+
+// UpdateVolume calls UpdateVolume through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) UpdateVolume(ctx context.Context, request ocicore.UpdateVolumeRequest) (ocicore.UpdateVolumeResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "UpdateVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateVolumeResponse)
+	return response, err
+}
+
+// UpdateVolumeBackup calls UpdateVolumeBackup through the OCI call interceptors
+func (c *instrumentedBlockStorageClient) UpdateVolumeBackup(ctx context.Context, request ocicore.UpdateVolumeBackupRequest) (ocicore.UpdateVolumeBackupResponse, error) {
+	call := OciCall{Service: "blockstorage", Operation: "UpdateVolumeBackup", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateVolumeBackup(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateVolumeBackupResponse)
+	return response, err
+}
+
+// InstrumentComputeClient wraps a ComputeClientInterface so every call goes through the OCI call interceptors
+func InstrumentComputeClient(client ComputeClientInterface) ComputeClientInterface {
+	return &instrumentedComputeClient{client: client}
+}
+
+type instrumentedComputeClient struct {
+	client ComputeClientInterface
+}
+
+// AttachVolume calls AttachVolume through the OCI call interceptors
+func (c *instrumentedComputeClient) AttachVolume(ctx context.Context, request ocicore.AttachVolumeRequest) (ocicore.AttachVolumeResponse, error) {
+	call := OciCall{Service: "compute", Operation: "AttachVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.AttachVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.AttachVolumeResponse)
+	return response, err
+}
+
+// DetachVolume calls DetachVolume through the OCI call interceptors
+func (c *instrumentedComputeClient) DetachVolume(ctx context.Context, request ocicore.DetachVolumeRequest) (ocicore.DetachVolumeResponse, error) {
+	call := OciCall{Service: "compute", Operation: "DetachVolume", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DetachVolume(ctx, request)
+	})
+	response, _ := r.(ocicore.DetachVolumeResponse)
+	return response, err
+}
+
+// GetInstance calls GetInstance through the OCI call interceptors
+func (c *instrumentedComputeClient) GetInstance(ctx context.Context, request ocicore.GetInstanceRequest) (ocicore.GetInstanceResponse, error) {
+	call := OciCall{Service: "compute", Operation: "GetInstance", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetInstance(ctx, request)
+	})
+	response, _ := r.(ocicore.GetInstanceResponse)
+	return response, err
+}
+
+// GetVolumeAttachment calls GetVolumeAttachment through the OCI call interceptors
+func (c *instrumentedComputeClient) GetVolumeAttachment(ctx context.Context, request ocicore.GetVolumeAttachmentRequest) (ocicore.GetVolumeAttachmentResponse, error) {
+	call := OciCall{Service: "compute", Operation: "GetVolumeAttachment", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetVolumeAttachment(ctx, request)
+	})
+	response, _ := r.(ocicore.GetVolumeAttachmentResponse)
+	return response, err
+}
+
+// InstanceAction calls InstanceAction through the OCI call interceptors
+func (c *instrumentedComputeClient) InstanceAction(ctx context.Context, request ocicore.InstanceActionRequest) (ocicore.InstanceActionResponse, error) {
+	call := OciCall{Service: "compute", Operation: "InstanceAction", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.InstanceAction(ctx, request)
+	})
+	response, _ := r.(ocicore.InstanceActionResponse)
+	return response, err
+}
+
+// LaunchInstance calls LaunchInstance through the OCI call interceptors
+func (c *instrumentedComputeClient) LaunchInstance(ctx context.Context, request ocicore.LaunchInstanceRequest) (ocicore.LaunchInstanceResponse, error) {
+	call := OciCall{Service: "compute", Operation: "LaunchInstance", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.LaunchInstance(ctx, request)
+	})
+	response, _ := r.(ocicore.LaunchInstanceResponse)
+	return response, err
+}
+
+// ListBootVolumeAttachments calls ListBootVolumeAttachments through the OCI call interceptors
+func (c *instrumentedComputeClient) ListBootVolumeAttachments(ctx context.Context, request ocicore.ListBootVolumeAttachmentsRequest) (ocicore.ListBootVolumeAttachmentsResponse, error) {
+	call := OciCall{Service: "compute", Operation: "ListBootVolumeAttachments", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListBootVolumeAttachments(ctx, request)
+	})
+	response, _ := r.(ocicore.ListBootVolumeAttachmentsResponse)
+	return response, err
+}
+
+// ListVnicAttachments calls ListVnicAttachments through the OCI call interceptors
+func (c *instrumentedComputeClient) ListVnicAttachments(ctx context.Context, request ocicore.ListVnicAttachmentsRequest) (ocicore.ListVnicAttachmentsResponse, error) {
+	call := OciCall{Service: "compute", Operation: "ListVnicAttachments", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListVnicAttachments(ctx, request)
+	})
+	response, _ := r.(ocicore.ListVnicAttachmentsResponse)
+	return response, err
+}
+
+// ListImages calls ListImages through the OCI call interceptors
+func (c *instrumentedComputeClient) ListImages(ctx context.Context, request ocicore.ListImagesRequest) (ocicore.ListImagesResponse, error) {
+	call := OciCall{Service: "compute", Operation: "ListImages", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListImages(ctx, request)
+	})
+	response, _ := r.(ocicore.ListImagesResponse)
+	return response, err
+}
+
+// ListInstances calls ListInstances through the OCI call interceptors
+func (c *instrumentedComputeClient) ListInstances(ctx context.Context, request ocicore.ListInstancesRequest) (ocicore.ListInstancesResponse, error) {
+	call := OciCall{Service: "compute", Operation: "ListInstances", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListInstances(ctx, request)
+	})
+	response, _ := r.(ocicore.ListInstancesResponse)
+	return response, err
+}
+
+// ListShapes calls ListShapes through the OCI call interceptors
+func (c *instrumentedComputeClient) ListShapes(ctx context.Context, request ocicore.ListShapesRequest) (ocicore.ListShapesResponse, error) {
+	call := OciCall{Service: "compute", Operation: "ListShapes", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListShapes(ctx, request)
+	})
+	response, _ := r.(ocicore.ListShapesResponse)
+	return response, err
+}
+
+// TerminateInstance calls TerminateInstance through the OCI call interceptors
+func (c *instrumentedComputeClient) TerminateInstance(ctx context.Context, request ocicore.TerminateInstanceRequest) (ocicore.TerminateInstanceResponse, error) {
+	call := OciCall{Service: "compute", Operation: "TerminateInstance", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.TerminateInstance(ctx, request)
+	})
+	response, _ := r.(ocicore.TerminateInstanceResponse)
+	return response, err
+}
+
+// UpdateInstance calls UpdateInstance through the OCI call interceptors
+func (c *instrumentedComputeClient) UpdateInstance(ctx context.Context, request ocicore.UpdateInstanceRequest) (ocicore.UpdateInstanceResponse, error) {
+	call := OciCall{Service: "compute", Operation: "UpdateInstance", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateInstance(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateInstanceResponse)
+	return response, err
+}
+
+// InstrumentContainerEngineClient wraps a ContainerEngineClientInterface so every call goes through the OCI call interceptors
+func InstrumentContainerEngineClient(client ContainerEngineClientInterface) ContainerEngineClientInterface {
+	return &instrumentedContainerEngineClient{client: client}
+}
+
+type instrumentedContainerEngineClient struct {
+	client ContainerEngineClientInterface
+}
+
+// CreateCluster calls CreateCluster through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) CreateCluster(ctx context.Context, request ocice.CreateClusterRequest) (ocice.CreateClusterResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "CreateCluster", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateCluster(ctx, request)
+	})
+	response, _ := r.(ocice.CreateClusterResponse)
+	return response, err
+}
+
+// CreateKubeconfig calls CreateKubeconfig through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) CreateKubeconfig(ctx context.Context, request ocice.CreateKubeconfigRequest) (ocice.CreateKubeconfigResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "CreateKubeconfig", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateKubeconfig(ctx, request)
+	})
+	response, _ := r.(ocice.CreateKubeconfigResponse)
+	return response, err
+}
+
+// CreateNodePool calls CreateNodePool through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) CreateNodePool(ctx context.Context, request ocice.CreateNodePoolRequest) (ocice.CreateNodePoolResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "CreateNodePool", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateNodePool(ctx, request)
+	})
+	response, _ := r.(ocice.CreateNodePoolResponse)
+	return response, err
+}
+
+// DeleteCluster calls DeleteCluster through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) DeleteCluster(ctx context.Context, request ocice.DeleteClusterRequest) (ocice.DeleteClusterResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "DeleteCluster", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteCluster(ctx, request)
+	})
+	response, _ := r.(ocice.DeleteClusterResponse)
+	return response, err
+}
+
+// DeleteNodePool calls DeleteNodePool through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) DeleteNodePool(ctx context.Context, request ocice.DeleteNodePoolRequest) (ocice.DeleteNodePoolResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "DeleteNodePool", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteNodePool(ctx, request)
+	})
+	response, _ := r.(ocice.DeleteNodePoolResponse)
+	return response, err
+}
+
+// GetCluster calls GetCluster through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) GetCluster(ctx context.Context, request ocice.GetClusterRequest) (ocice.GetClusterResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "GetCluster", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetCluster(ctx, request)
+	})
+	response, _ := r.(ocice.GetClusterResponse)
+	return response, err
+}
+
+// GetNodePool calls GetNodePool through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) GetNodePool(ctx context.Context, request ocice.GetNodePoolRequest) (ocice.GetNodePoolResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "GetNodePool", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetNodePool(ctx, request)
+	})
+	response, _ := r.(ocice.GetNodePoolResponse)
+	return response, err
+}
+
+// GetWorkRequest calls GetWorkRequest through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) GetWorkRequest(ctx context.Context, request ocice.GetWorkRequestRequest) (ocice.GetWorkRequestResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "GetWorkRequest", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetWorkRequest(ctx, request)
+	})
+	response, _ := r.(ocice.GetWorkRequestResponse)
+	return response, err
+}
+
+// ListClusters calls ListClusters through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) ListClusters(ctx context.Context, request ocice.ListClustersRequest) (ocice.ListClustersResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "ListClusters", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListClusters(ctx, request)
+	})
+	response, _ := r.(ocice.ListClustersResponse)
+	return response, err
+}
+
+// ListNodePools calls ListNodePools through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) ListNodePools(ctx context.Context, request ocice.ListNodePoolsRequest) (ocice.ListNodePoolsResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "ListNodePools", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListNodePools(ctx, request)
+	})
+	response, _ := r.(ocice.ListNodePoolsResponse)
+	return response, err
+}
+
+// ListWorkRequestErrors calls ListWorkRequestErrors through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) ListWorkRequestErrors(ctx context.Context, request ocice.ListWorkRequestErrorsRequest) (ocice.ListWorkRequestErrorsResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "ListWorkRequestErrors", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListWorkRequestErrors(ctx, request)
+	})
+	response, _ := r.(ocice.ListWorkRequestErrorsResponse)
+	return response, err
+}
+
+// UpdateCluster calls UpdateCluster through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) UpdateCluster(ctx context.Context, request ocice.UpdateClusterRequest) (ocice.UpdateClusterResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "UpdateCluster", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateCluster(ctx, request)
+	})
+	response, _ := r.(ocice.UpdateClusterResponse)
+	return response, err
+}
+
+// UpdateNodePool calls UpdateNodePool through the OCI call interceptors
+func (c *instrumentedContainerEngineClient) UpdateNodePool(ctx context.Context, request ocice.UpdateNodePoolRequest) (ocice.UpdateNodePoolResponse, error) {
+	call := OciCall{Service: "containerengine", Operation: "UpdateNodePool", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateNodePool(ctx, request)
+	})
+	response, _ := r.(ocice.UpdateNodePoolResponse)
+	return response, err
+}
+
+// InstrumentDatabaseClient wraps a DatabaseClientInterface so every call goes through the OCI call interceptors
+func InstrumentDatabaseClient(client DatabaseClientInterface) DatabaseClientInterface {
+	return &instrumentedDatabaseClient{client: client}
+}
+
+type instrumentedDatabaseClient struct {
+	client DatabaseClientInterface
+}
+
+// CreateAutonomousDatabase calls CreateAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) CreateAutonomousDatabase(ctx context.Context, request ocidb.CreateAutonomousDatabaseRequest) (ocidb.CreateAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "CreateAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.CreateAutonomousDatabaseResponse)
+	return response, err
+}
+
+// DeleteAutonomousDatabase calls DeleteAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) DeleteAutonomousDatabase(ctx context.Context, request ocidb.DeleteAutonomousDatabaseRequest) (ocidb.DeleteAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "DeleteAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.DeleteAutonomousDatabaseResponse)
+	return response, err
+}
+
+// GenerateAutonomousDatabaseWallet calls GenerateAutonomousDatabaseWallet through the OCI call interceptors
+func (c *instrumentedDatabaseClient) GenerateAutonomousDatabaseWallet(ctx context.Context, request ocidb.GenerateAutonomousDatabaseWalletRequest) (ocidb.GenerateAutonomousDatabaseWalletResponse, error) {
+	call := OciCall{Service: "database", Operation: "GenerateAutonomousDatabaseWallet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GenerateAutonomousDatabaseWallet(ctx, request)
+	})
+	response, _ := r.(ocidb.GenerateAutonomousDatabaseWalletResponse)
+	return response, err
+}
+
+// GetAutonomousDatabase calls GetAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) GetAutonomousDatabase(ctx context.Context, request ocidb.GetAutonomousDatabaseRequest) (ocidb.GetAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "GetAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.GetAutonomousDatabaseResponse)
+	return response, err
+}
+
+// ListAutonomousDatabases calls ListAutonomousDatabases through the OCI call interceptors
+func (c *instrumentedDatabaseClient) ListAutonomousDatabases(ctx context.Context, request ocidb.ListAutonomousDatabasesRequest) (ocidb.ListAutonomousDatabasesResponse, error) {
+	call := OciCall{Service: "database", Operation: "ListAutonomousDatabases", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListAutonomousDatabases(ctx, request)
+	})
+	response, _ := r.(ocidb.ListAutonomousDatabasesResponse)
+	return response, err
+}
+
+// StartAutonomousDatabase calls StartAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) StartAutonomousDatabase(ctx context.Context, request ocidb.StartAutonomousDatabaseRequest) (ocidb.StartAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "StartAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.StartAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.StartAutonomousDatabaseResponse)
+	return response, err
+}
+
+// StopAutonomousDatabase calls StopAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) StopAutonomousDatabase(ctx context.Context, request ocidb.StopAutonomousDatabaseRequest) (ocidb.StopAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "StopAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.StopAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.StopAutonomousDatabaseResponse)
+	return response, err
+}
+
+// UpdateAutonomousDatabase calls UpdateAutonomousDatabase through the OCI call interceptors
+func (c *instrumentedDatabaseClient) UpdateAutonomousDatabase(ctx context.Context, request ocidb.UpdateAutonomousDatabaseRequest) (ocidb.UpdateAutonomousDatabaseResponse, error) {
+	call := OciCall{Service: "database", Operation: "UpdateAutonomousDatabase", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateAutonomousDatabase(ctx, request)
+	})
+	response, _ := r.(ocidb.UpdateAutonomousDatabaseResponse)
+	return response, err
+}
+
+// InstrumentIdentityClient wraps a IdentityClientInterface so every call goes through the OCI call interceptors
+func InstrumentIdentityClient(client IdentityClientInterface) IdentityClientInterface {
+	return &instrumentedIdentityClient{client: client}
+}
+
+type instrumentedIdentityClient struct {
+	client IdentityClientInterface
+}
+
+// GetCompartment calls GetCompartment through the OCI call interceptors
+func (c *instrumentedIdentityClient) GetCompartment(ctx context.Context, request ociid.GetCompartmentRequest) (ociid.GetCompartmentResponse, error) {
+	call := OciCall{Service: "identity", Operation: "GetCompartment", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetCompartment(ctx, request)
+	})
+	response, _ := r.(ociid.GetCompartmentResponse)
+	return response, err
+}
+
+// CreateCompartment calls CreateCompartment through the OCI call interceptors
+func (c *instrumentedIdentityClient) CreateCompartment(ctx context.Context, request ociid.CreateCompartmentRequest) (ociid.CreateCompartmentResponse, error) {
+	call := OciCall{Service: "identity", Operation: "CreateCompartment", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateCompartment(ctx, request)
+	})
+	response, _ := r.(ociid.CreateCompartmentResponse)
+	return response, err
+}
+
+// CreatePolicy calls CreatePolicy through the OCI call interceptors
+func (c *instrumentedIdentityClient) CreatePolicy(ctx context.Context, request ociid.CreatePolicyRequest) (ociid.CreatePolicyResponse, error) {
+	call := OciCall{Service: "identity", Operation: "CreatePolicy", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreatePolicy(ctx, request)
+	})
+	response, _ := r.(ociid.CreatePolicyResponse)
+	return response, err
+}
+
+// DeleteCompartment calls DeleteCompartment through the OCI call interceptors
+func (c *instrumentedIdentityClient) DeleteCompartment(ctx context.Context, request ociid.DeleteCompartmentRequest) (ociid.DeleteCompartmentResponse, error) {
+	call := OciCall{Service: "identity", Operation: "DeleteCompartment", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteCompartment(ctx, request)
+	})
+	response, _ := r.(ociid.DeleteCompartmentResponse)
+	return response, err
+}
+
+// DeletePolicy calls DeletePolicy through the OCI call interceptors
+func (c *instrumentedIdentityClient) DeletePolicy(ctx context.Context, request ociid.DeletePolicyRequest) (ociid.DeletePolicyResponse, error) {
+	call := OciCall{Service: "identity", Operation: "DeletePolicy", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeletePolicy(ctx, request)
+	})
+	response, _ := r.(ociid.DeletePolicyResponse)
+	return response, err
+}
+
+// GetPolicy calls GetPolicy through the OCI call interceptors
+func (c *instrumentedIdentityClient) GetPolicy(ctx context.Context, request ociid.GetPolicyRequest) (ociid.GetPolicyResponse, error) {
+	call := OciCall{Service: "identity", Operation: "GetPolicy", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetPolicy(ctx, request)
+	})
+	response, _ := r.(ociid.GetPolicyResponse)
+	return response, err
+}
+
+// ListAvailabilityDomains calls ListAvailabilityDomains through the OCI call interceptors
+func (c *instrumentedIdentityClient) ListAvailabilityDomains(ctx context.Context, request ociid.ListAvailabilityDomainsRequest) (ociid.ListAvailabilityDomainsResponse, error) {
+	call := OciCall{Service: "identity", Operation: "ListAvailabilityDomains", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListAvailabilityDomains(ctx, request)
+	})
+	response, _ := r.(ociid.ListAvailabilityDomainsResponse)
+	return response, err
+}
+
+// ListCompartments calls ListCompartments through the OCI call interceptors
+func (c *instrumentedIdentityClient) ListCompartments(ctx context.Context, request ociid.ListCompartmentsRequest) (ociid.ListCompartmentsResponse, error) {
+	call := OciCall{Service: "identity", Operation: "ListCompartments", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListCompartments(ctx, request)
+	})
+	response, _ := r.(ociid.ListCompartmentsResponse)
+	return response, err
+}
+
+// ListPolicies calls ListPolicies through the OCI call interceptors
+func (c *instrumentedIdentityClient) ListPolicies(ctx context.Context, request ociid.ListPoliciesRequest) (ociid.ListPoliciesResponse, error) {
+	call := OciCall{Service: "identity", Operation: "ListPolicies", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListPolicies(ctx, request)
+	})
+	response, _ := r.(ociid.ListPoliciesResponse)
+	return response, err
+}
+
+// UpdateCompartment calls UpdateCompartment through the OCI call interceptors
+func (c *instrumentedIdentityClient) UpdateCompartment(ctx context.Context, request ociid.UpdateCompartmentRequest) (ociid.UpdateCompartmentResponse, error) {
+	call := OciCall{Service: "identity", Operation: "UpdateCompartment", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateCompartment(ctx, request)
+	})
+	response, _ := r.(ociid.UpdateCompartmentResponse)
+	return response, err
+}
+
+// UpdatePolicy calls UpdatePolicy through the OCI call interceptors
+func (c *instrumentedIdentityClient) UpdatePolicy(ctx context.Context, request ociid.UpdatePolicyRequest) (ociid.UpdatePolicyResponse, error) {
+	call := OciCall{Service: "identity", Operation: "UpdatePolicy", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdatePolicy(ctx, request)
+	})
+	response, _ := r.(ociid.UpdatePolicyResponse)
+	return response, err
+}
+
+// InstrumentLoadBalancerClient wraps a LoadBalancerClientInterface so every call goes through the OCI call interceptors
+func InstrumentLoadBalancerClient(client LoadBalancerClientInterface) LoadBalancerClientInterface {
+	return &instrumentedLoadBalancerClient{client: client}
+}
+
+type instrumentedLoadBalancerClient struct {
+	client LoadBalancerClientInterface
+}
+
+// CreateBackend calls CreateBackend through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) CreateBackend(ctx context.Context, request ocilb.CreateBackendRequest) (ocilb.CreateBackendResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "CreateBackend", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateBackend(ctx, request)
+	})
+	response, _ := r.(ocilb.CreateBackendResponse)
+	return response, err
+}
+
+// CreateBackendSet calls CreateBackendSet through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) CreateBackendSet(ctx context.Context, request ocilb.CreateBackendSetRequest) (ocilb.CreateBackendSetResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "CreateBackendSet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateBackendSet(ctx, request)
+	})
+	response, _ := r.(ocilb.CreateBackendSetResponse)
+	return response, err
+}
+
+// CreateCertificate calls CreateCertificate through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) CreateCertificate(ctx context.Context, request ocilb.CreateCertificateRequest) (ocilb.CreateCertificateResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "CreateCertificate", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateCertificate(ctx, request)
+	})
+	response, _ := r.(ocilb.CreateCertificateResponse)
+	return response, err
+}
+
+// CreateListener calls CreateListener through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) CreateListener(ctx context.Context, request ocilb.CreateListenerRequest) (ocilb.CreateListenerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "CreateListener", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateListener(ctx, request)
+	})
+	response, _ := r.(ocilb.CreateListenerResponse)
+	return response, err
+}
+
+// CreateLoadBalancer calls CreateLoadBalancer through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) CreateLoadBalancer(ctx context.Context, request ocilb.CreateLoadBalancerRequest) (ocilb.CreateLoadBalancerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "CreateLoadBalancer", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateLoadBalancer(ctx, request)
+	})
+	response, _ := r.(ocilb.CreateLoadBalancerResponse)
+	return response, err
+}
+
+// DeleteBackend calls DeleteBackend through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) DeleteBackend(ctx context.Context, request ocilb.DeleteBackendRequest) (ocilb.DeleteBackendResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "DeleteBackend", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteBackend(ctx, request)
+	})
+	response, _ := r.(ocilb.DeleteBackendResponse)
+	return response, err
+}
+
+// DeleteBackendSet calls DeleteBackendSet through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) DeleteBackendSet(ctx context.Context, request ocilb.DeleteBackendSetRequest) (ocilb.DeleteBackendSetResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "DeleteBackendSet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteBackendSet(ctx, request)
+	})
+	response, _ := r.(ocilb.DeleteBackendSetResponse)
+	return response, err
+}
+
+// DeleteCertificate calls DeleteCertificate through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) DeleteCertificate(ctx context.Context, request ocilb.DeleteCertificateRequest) (ocilb.DeleteCertificateResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "DeleteCertificate", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteCertificate(ctx, request)
+	})
+	response, _ := r.(ocilb.DeleteCertificateResponse)
+	return response, err
+}
+
+// DeleteListener calls DeleteListener through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) DeleteListener(ctx context.Context, request ocilb.DeleteListenerRequest) (ocilb.DeleteListenerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "DeleteListener", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteListener(ctx, request)
+	})
+	response, _ := r.(ocilb.DeleteListenerResponse)
+	return response, err
+}
+
+// DeleteLoadBalancer calls DeleteLoadBalancer through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) DeleteLoadBalancer(ctx context.Context, request ocilb.DeleteLoadBalancerRequest) (ocilb.DeleteLoadBalancerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "DeleteLoadBalancer", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteLoadBalancer(ctx, request)
+	})
+	response, _ := r.(ocilb.DeleteLoadBalancerResponse)
+	return response, err
+}
+
+// GetBackend calls GetBackend through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) GetBackend(ctx context.Context, request ocilb.GetBackendRequest) (ocilb.GetBackendResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "GetBackend", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetBackend(ctx, request)
+	})
+	response, _ := r.(ocilb.GetBackendResponse)
+	return response, err
+}
+
+// GetBackendSet calls GetBackendSet through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) GetBackendSet(ctx context.Context, request ocilb.GetBackendSetRequest) (ocilb.GetBackendSetResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "GetBackendSet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetBackendSet(ctx, request)
+	})
+	response, _ := r.(ocilb.GetBackendSetResponse)
+	return response, err
+}
+
+// GetLoadBalancer calls GetLoadBalancer through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) GetLoadBalancer(ctx context.Context, request ocilb.GetLoadBalancerRequest) (ocilb.GetLoadBalancerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "GetLoadBalancer", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetLoadBalancer(ctx, request)
+	})
+	response, _ := r.(ocilb.GetLoadBalancerResponse)
+	return response, err
+}
+
+// GetWorkRequest calls GetWorkRequest through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) GetWorkRequest(ctx context.Context, request ocilb.GetWorkRequestRequest) (ocilb.GetWorkRequestResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "GetWorkRequest", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetWorkRequest(ctx, request)
+	})
+	response, _ := r.(ocilb.GetWorkRequestResponse)
+	return response, err
+}
+
+// ListLoadBalancers calls ListLoadBalancers through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) ListLoadBalancers(ctx context.Context, request ocilb.ListLoadBalancersRequest) (ocilb.ListLoadBalancersResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "ListLoadBalancers", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListLoadBalancers(ctx, request)
+	})
+	response, _ := r.(ocilb.ListLoadBalancersResponse)
+	return response, err
+}
+
+// UpdateBackend calls UpdateBackend through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) UpdateBackend(ctx context.Context, request ocilb.UpdateBackendRequest) (ocilb.UpdateBackendResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "UpdateBackend", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateBackend(ctx, request)
+	})
+	response, _ := r.(ocilb.UpdateBackendResponse)
+	return response, err
+}
+
+// UpdateBackendSet calls UpdateBackendSet through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) UpdateBackendSet(ctx context.Context, request ocilb.UpdateBackendSetRequest) (ocilb.UpdateBackendSetResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "UpdateBackendSet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateBackendSet(ctx, request)
+	})
+	response, _ := r.(ocilb.UpdateBackendSetResponse)
+	return response, err
+}
+
+// UpdateListener calls UpdateListener through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) UpdateListener(ctx context.Context, request ocilb.UpdateListenerRequest) (ocilb.UpdateListenerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "UpdateListener", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateListener(ctx, request)
+	})
+	response, _ := r.(ocilb.UpdateListenerResponse)
+	return response, err
+}
+
+// UpdateLoadBalancer calls UpdateLoadBalancer through the OCI call interceptors
+func (c *instrumentedLoadBalancerClient) UpdateLoadBalancer(ctx context.Context, request ocilb.UpdateLoadBalancerRequest) (ocilb.UpdateLoadBalancerResponse, error) {
+	call := OciCall{Service: "loadbalancer", Operation: "UpdateLoadBalancer", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateLoadBalancer(ctx, request)
+	})
+	response, _ := r.(ocilb.UpdateLoadBalancerResponse)
+	return response, err
+}
+
+// InstrumentVcnClient wraps a VcnClientInterface so every call goes through the OCI call interceptors
+func InstrumentVcnClient(client VcnClientInterface) VcnClientInterface {
+	return &instrumentedVcnClient{client: client}
+}
+
+type instrumentedVcnClient struct {
+	client VcnClientInterface
+}
+
+// CreateDhcpOptions calls CreateDhcpOptions through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateDhcpOptions(ctx context.Context, request ocicore.CreateDhcpOptionsRequest) (ocicore.CreateDhcpOptionsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateDhcpOptions", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateDhcpOptions(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateDhcpOptionsResponse)
+	return response, err
+}
+
+// CreateInternetGateway calls CreateInternetGateway through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateInternetGateway(ctx context.Context, request ocicore.CreateInternetGatewayRequest) (ocicore.CreateInternetGatewayResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateInternetGateway", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateInternetGateway(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateInternetGatewayResponse)
+	return response, err
+}
+
+// CreateRouteTable calls CreateRouteTable through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateRouteTable(ctx context.Context, request ocicore.CreateRouteTableRequest) (ocicore.CreateRouteTableResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateRouteTable", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateRouteTable(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateRouteTableResponse)
+	return response, err
+}
+
+// CreateSecurityList calls CreateSecurityList through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateSecurityList(ctx context.Context, request ocicore.CreateSecurityListRequest) (ocicore.CreateSecurityListResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateSecurityList", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateSecurityList(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateSecurityListResponse)
+	return response, err
+}
+
+// CreateSubnet calls CreateSubnet through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateSubnet(ctx context.Context, request ocicore.CreateSubnetRequest) (ocicore.CreateSubnetResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateSubnet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateSubnet(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateSubnetResponse)
+	return response, err
+}
+
+// CreateVcn calls CreateVcn through the OCI call interceptors
+func (c *instrumentedVcnClient) CreateVcn(ctx context.Context, request ocicore.CreateVcnRequest) (ocicore.CreateVcnResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "CreateVcn", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.CreateVcn(ctx, request)
+	})
+	response, _ := r.(ocicore.CreateVcnResponse)
+	return response, err
+}
+
+// DeleteDhcpOptions calls DeleteDhcpOptions through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteDhcpOptions(ctx context.Context, request ocicore.DeleteDhcpOptionsRequest) (ocicore.DeleteDhcpOptionsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteDhcpOptions", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteDhcpOptions(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteDhcpOptionsResponse)
+	return response, err
+}
+
+// DeleteInternetGateway calls DeleteInternetGateway through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteInternetGateway(ctx context.Context, request ocicore.DeleteInternetGatewayRequest) (ocicore.DeleteInternetGatewayResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteInternetGateway", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteInternetGateway(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteInternetGatewayResponse)
+	return response, err
+}
+
+// DeleteRouteTable calls DeleteRouteTable through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteRouteTable(ctx context.Context, request ocicore.DeleteRouteTableRequest) (ocicore.DeleteRouteTableResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteRouteTable", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteRouteTable(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteRouteTableResponse)
+	return response, err
+}
+
+// DeleteSecurityList calls DeleteSecurityList through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteSecurityList(ctx context.Context, request ocicore.DeleteSecurityListRequest) (ocicore.DeleteSecurityListResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteSecurityList", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteSecurityList(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteSecurityListResponse)
+	return response, err
+}
+
+// DeleteSubnet calls DeleteSubnet through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteSubnet(ctx context.Context, request ocicore.DeleteSubnetRequest) (ocicore.DeleteSubnetResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteSubnet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteSubnet(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteSubnetResponse)
+	return response, err
+}
+
+// DeleteVcn calls DeleteVcn through the OCI call interceptors
+func (c *instrumentedVcnClient) DeleteVcn(ctx context.Context, request ocicore.DeleteVcnRequest) (ocicore.DeleteVcnResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "DeleteVcn", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.DeleteVcn(ctx, request)
+	})
+	response, _ := r.(ocicore.DeleteVcnResponse)
+	return response, err
+}
+
+// GetDhcpOptions calls GetDhcpOptions through the OCI call interceptors
+func (c *instrumentedVcnClient) GetDhcpOptions(ctx context.Context, request ocicore.GetDhcpOptionsRequest) (ocicore.GetDhcpOptionsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetDhcpOptions", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetDhcpOptions(ctx, request)
+	})
+	response, _ := r.(ocicore.GetDhcpOptionsResponse)
+	return response, err
+}
+
+// GetInternetGateway calls GetInternetGateway through the OCI call interceptors
+func (c *instrumentedVcnClient) GetInternetGateway(ctx context.Context, request ocicore.GetInternetGatewayRequest) (ocicore.GetInternetGatewayResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetInternetGateway", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetInternetGateway(ctx, request)
+	})
+	response, _ := r.(ocicore.GetInternetGatewayResponse)
+	return response, err
+}
+
+// GetRouteTable calls GetRouteTable through the OCI call interceptors
+func (c *instrumentedVcnClient) GetRouteTable(ctx context.Context, request ocicore.GetRouteTableRequest) (ocicore.GetRouteTableResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetRouteTable", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetRouteTable(ctx, request)
+	})
+	response, _ := r.(ocicore.GetRouteTableResponse)
+	return response, err
+}
+
+// GetSecurityList calls GetSecurityList through the OCI call interceptors
+func (c *instrumentedVcnClient) GetSecurityList(ctx context.Context, request ocicore.GetSecurityListRequest) (ocicore.GetSecurityListResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetSecurityList", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetSecurityList(ctx, request)
+	})
+	response, _ := r.(ocicore.GetSecurityListResponse)
+	return response, err
+}
+
+// GetSubnet calls GetSubnet through the OCI call interceptors
+func (c *instrumentedVcnClient) GetSubnet(ctx context.Context, request ocicore.GetSubnetRequest) (ocicore.GetSubnetResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetSubnet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetSubnet(ctx, request)
+	})
+	response, _ := r.(ocicore.GetSubnetResponse)
+	return response, err
+}
+
+// GetVcn calls GetVcn through the OCI call interceptors
+func (c *instrumentedVcnClient) GetVcn(ctx context.Context, request ocicore.GetVcnRequest) (ocicore.GetVcnResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetVcn", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetVcn(ctx, request)
+	})
+	response, _ := r.(ocicore.GetVcnResponse)
+	return response, err
+}
+
+// GetVnic calls GetVnic through the OCI call interceptors
+func (c *instrumentedVcnClient) GetVnic(ctx context.Context, request ocicore.GetVnicRequest) (ocicore.GetVnicResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "GetVnic", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.GetVnic(ctx, request)
+	})
+	response, _ := r.(ocicore.GetVnicResponse)
+	return response, err
+}
+
+// ListDhcpOptions calls ListDhcpOptions through the OCI call interceptors
+func (c *instrumentedVcnClient) ListDhcpOptions(ctx context.Context, request ocicore.ListDhcpOptionsRequest) (ocicore.ListDhcpOptionsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListDhcpOptions", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListDhcpOptions(ctx, request)
+	})
+	response, _ := r.(ocicore.ListDhcpOptionsResponse)
+	return response, err
+}
+
+// ListInternetGateways calls ListInternetGateways through the OCI call interceptors
+func (c *instrumentedVcnClient) ListInternetGateways(ctx context.Context, request ocicore.ListInternetGatewaysRequest) (ocicore.ListInternetGatewaysResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListInternetGateways", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListInternetGateways(ctx, request)
+	})
+	response, _ := r.(ocicore.ListInternetGatewaysResponse)
+	return response, err
+}
+
+// ListRouteTables calls ListRouteTables through the OCI call interceptors
+func (c *instrumentedVcnClient) ListRouteTables(ctx context.Context, request ocicore.ListRouteTablesRequest) (ocicore.ListRouteTablesResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListRouteTables", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListRouteTables(ctx, request)
+	})
+	response, _ := r.(ocicore.ListRouteTablesResponse)
+	return response, err
+}
+
+// ListSecurityLists calls ListSecurityLists through the OCI call interceptors
+func (c *instrumentedVcnClient) ListSecurityLists(ctx context.Context, request ocicore.ListSecurityListsRequest) (ocicore.ListSecurityListsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListSecurityLists", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListSecurityLists(ctx, request)
+	})
+	response, _ := r.(ocicore.ListSecurityListsResponse)
+	return response, err
+}
+
+// ListSubnets calls ListSubnets through the OCI call interceptors
+func (c *instrumentedVcnClient) ListSubnets(ctx context.Context, request ocicore.ListSubnetsRequest) (ocicore.ListSubnetsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListSubnets", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListSubnets(ctx, request)
+	})
+	response, _ := r.(ocicore.ListSubnetsResponse)
+	return response, err
+}
+
+// ListVcns calls ListVcns through the OCI call interceptors
+func (c *instrumentedVcnClient) ListVcns(ctx context.Context, request ocicore.ListVcnsRequest) (ocicore.ListVcnsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "ListVcns", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.ListVcns(ctx, request)
+	})
+	response, _ := r.(ocicore.ListVcnsResponse)
+	return response, err
+}
+
+// UpdateDhcpOptions calls UpdateDhcpOptions through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateDhcpOptions(ctx context.Context, request ocicore.UpdateDhcpOptionsRequest) (ocicore.UpdateDhcpOptionsResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateDhcpOptions", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateDhcpOptions(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateDhcpOptionsResponse)
+	return response, err
+}
+
+// UpdateInternetGateway calls UpdateInternetGateway through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateInternetGateway(ctx context.Context, request ocicore.UpdateInternetGatewayRequest) (ocicore.UpdateInternetGatewayResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateInternetGateway", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateInternetGateway(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateInternetGatewayResponse)
+	return response, err
+}
+
+// UpdateRouteTable calls UpdateRouteTable through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateRouteTable(ctx context.Context, request ocicore.UpdateRouteTableRequest) (ocicore.UpdateRouteTableResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateRouteTable", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateRouteTable(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateRouteTableResponse)
+	return response, err
+}
+
+// UpdateSecurityList calls UpdateSecurityList through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateSecurityList(ctx context.Context, request ocicore.UpdateSecurityListRequest) (ocicore.UpdateSecurityListResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateSecurityList", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateSecurityList(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateSecurityListResponse)
+	return response, err
+}
+
+// UpdateSubnet calls UpdateSubnet through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateSubnet(ctx context.Context, request ocicore.UpdateSubnetRequest) (ocicore.UpdateSubnetResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateSubnet", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateSubnet(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateSubnetResponse)
+	return response, err
+}
+
+// UpdateVcn calls UpdateVcn through the OCI call interceptors
+func (c *instrumentedVcnClient) UpdateVcn(ctx context.Context, request ocicore.UpdateVcnRequest) (ocicore.UpdateVcnResponse, error) {
+	call := OciCall{Service: "vcn", Operation: "UpdateVcn", Request: request}
+	r, err := intercept(ctx, call, func(ctx context.Context) (interface{}, error) {
+		return c.client.UpdateVcn(ctx, request)
+	})
+	response, _ := r.(ocicore.UpdateVcnResponse)
+	return response, err
+}
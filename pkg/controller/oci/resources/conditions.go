@@ -0,0 +1,154 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Condition reasons set by the controller
+const (
+	reasonAvailable              = "Available"
+	reasonNotAvailable           = "NotAvailable"
+	reasonCreated                = "Created"
+	reasonUpdated                = "Updated"
+	reasonUpToDate               = "UpToDate"
+	reasonImported               = "Imported"
+	reasonCreateFailed           = "CreateFailed"
+	reasonImportFailed           = "ImportFailed"
+	reasonGetFailed              = "GetFailed"
+	reasonUpdateFailed           = "UpdateFailed"
+	reasonDeleteFailed           = "DeleteFailed"
+	reasonReleaseFailed          = "ReleaseFailed"
+	reasonDeleting               = "Deleting"
+	reasonDeleted                = "Deleted"
+	reasonOrphaned               = "Orphaned"
+	reasonInvalidDeletionPolicy  = "InvalidDeletionPolicy"
+	reasonInvalidDriftPolicy     = "InvalidDriftPolicy"
+	reasonDependentsPresent      = "DependentsPresent"
+	reasonDependenciesReady      = "DependenciesReady"
+	reasonWaitingForDependencies = "WaitingForDependencies"
+	reasonDependencyError        = "DependencyError"
+	reasonDependencyCycle        = "DependencyCycle"
+	reasonDriftReported          = "DriftReported"
+	reasonPaused                 = "Paused"
+	reasonNamespacePaused        = "NamespacePaused"
+	reasonDryRun                 = "DryRun"
+	reasonWorkRequestPending     = "WorkRequestPending"
+	reasonWorkRequestFailed      = "WorkRequestFailed"
+	reasonTimeout                = "Timeout"
+	reasonRetriesExhausted       = "RetriesExhausted"
+)
+
+// resourceStatus returns the common status of an object or nil if it has none
+func resourceStatus(obj runtime.Object) *ocicommon.ResourceStatus {
+	if obj == nil {
+		return nil
+	}
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	if resource, ok := obj.(ocicommon.ObjectInterface); ok {
+		return resource.GetResourceStatus()
+	}
+	return nil
+}
+
+func setCondition(obj runtime.Object, conditionType ocicommon.ConditionType, status ocicommon.ConditionStatus, reason, message string, generation int64) {
+	if s := resourceStatus(obj); s != nil {
+		s.SetCondition(conditionType, status, reason, message, generation)
+	}
+}
+
+// setReady derives the Ready condition from the availability of the OCI resource
+func setReady(obj runtime.Object, generation int64) {
+	resource, ok := obj.(ocicommon.ObjectInterface)
+	if !ok || resourceStatus(obj) == nil {
+		return
+	}
+	if resource.IsResource() {
+		setCondition(obj, ocicommon.ConditionReady, ocicommon.ConditionTrue, reasonAvailable, "", generation)
+	} else {
+		setCondition(obj, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonNotAvailable, "OCI resource is not available yet", generation)
+	}
+}
+
+// setSynced marks a successful reconcile of the given generation
+func setSynced(obj runtime.Object, reason string, generation int64) {
+	if s := resourceStatus(obj); s != nil {
+		s.SetCondition(ocicommon.ConditionSynced, ocicommon.ConditionTrue, reason, "", generation)
+		s.ObservedGeneration = generation
+		s.Plan = nil
+		s.RemoveCondition(ocicommon.ConditionStalled)
+		setReady(obj, generation)
+	}
+}
+
+// setSyncError records a failed reconcile, the error is kept in the condition message
+func setSyncError(obj runtime.Object, reason string, err error, generation int64) {
+	setCondition(obj, ocicommon.ConditionSynced, ocicommon.ConditionFalse, reason, err.Error(), generation)
+}
+
+// conditionsChanged reports whether the conditions, the reported drift, the dry-run plan or the
+// work request progress of object differ from the source object
+func conditionsChanged(source, object runtime.Object) bool {
+	sourceStatus, objectStatus := resourceStatus(source), resourceStatus(object)
+	if sourceStatus == nil || objectStatus == nil {
+		return sourceStatus != objectStatus
+	}
+	return sourceStatus.ObservedGeneration != objectStatus.ObservedGeneration ||
+		!reflect.DeepEqual(sourceStatus.Conditions, objectStatus.Conditions) ||
+		!reflect.DeepEqual(sourceStatus.Drift, objectStatus.Drift) ||
+		!reflect.DeepEqual(sourceStatus.Plan, objectStatus.Plan) ||
+		!reflect.DeepEqual(sourceStatus.WorkRequest, objectStatus.WorkRequest)
+}
+
+// conditionsTransitioned reports if a condition of object differs in status or reason from the cached
+// object for key. Unlike conditionsChanged it ignores the messages, which carry the opc-request-id
+// of every failed OCI call.
+func (c *Controller) conditionsTransitioned(key string, object runtime.Object) bool {
+	source, exists, err := c.informer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return false
+	}
+	sourceStatus, objectStatus := resourceStatus(source.(runtime.Object)), resourceStatus(object)
+	if sourceStatus == nil || objectStatus == nil {
+		return false
+	}
+	if len(sourceStatus.Conditions) != len(objectStatus.Conditions) {
+		return true
+	}
+	for _, condition := range objectStatus.Conditions {
+		cached := sourceStatus.GetCondition(condition.Type)
+		if cached == nil || cached.Status != condition.Status || cached.Reason != condition.Reason {
+			return true
+		}
+	}
+	return false
+}
+
+// conditionsChanged compares the conditions of object with the cached object for key
+func (c *Controller) conditionsChanged(key string, object runtime.Object) bool {
+	source, exists, err := c.informer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return false
+	}
+	return conditionsChanged(source.(runtime.Object), object)
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
 	"k8s.io/client-go/kubernetes"
+	"reflect"
+	"sync"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -31,32 +36,64 @@ import (
 	"github.com/golang/glog"
 	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
 	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
 	clientset "github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 	clientsetScheme "github.com/oracle/oci-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
 	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 )
 
 // OciGroupName constant is used for finalizers string
 const (
-	OciGroupName            = "oci.oracle.com"
-	eventTypeResourceUpdate = "ResourceUpdate"
-	eventTypeResourceError  = "ResourceError"
+	OciGroupName               = "oci.oracle.com"
+	eventTypeResourceUpdate    = "ResourceUpdate"
+	eventTypeResourceError     = "ResourceError"
+	eventTypeResourceDrift     = "ResourceDrift"
+	eventTypeResourcePaused    = "ResourcePaused"
+	eventTypeResourcePlan      = "ResourcePlan"
+	eventTypeResourceStall     = "ResourceStalled"
+	eventTypeResourceThrottled = "ResourceThrottled"
 )
 
 // Controller of resource create/update/delete events
 type Controller struct {
 	//resourceclient *oci.Client
-	queue    workqueue.RateLimitingInterface
-	queueMap map[string]workqueue.RateLimitingInterface
-	informer cache.SharedInformer
-	adapter  resourcescommon.ResourceTypeAdapter
-	factory  informers.SharedInformerFactory
-	recorder record.EventRecorder
+	queue      workqueue.RateLimitingInterface
+	queueMap   map[string]workqueue.RateLimitingInterface
+	informer   cache.SharedIndexInformer
+	adapter    resourcescommon.ResourceTypeAdapter
+	factory    informers.SharedInformerFactory
+	namespaces corelisters.NamespaceLister
+	recorder   record.EventRecorder
+	policy     resourcescommon.RetryPolicy
+	pending    *pendingTracker
+
+	stalledLock sync.Mutex
+	stalled     map[string]stall
+
+	// every reconcile holds reconciling for reading, Drain waits for them by taking it
+	// for writing. Once stopCh is closed the workers take no further keys.
+	reconciling sync.RWMutex
+	stopCh      <-chan struct{}
+
+	credentials  *Credentials
+	provider     ocisdkcommon.ConfigurationProvider
+	newAdapter   func(ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter
+	adaptersLock sync.Mutex
+	adapters     map[string]credentialsAdapter
+}
+
+// stall records the object state a stalled key gave up on, the key is
+// reconciled again once the object changes or its retry annotation is bumped
+type stall struct {
+	generation int64
+	retry      string
+	deleting   bool
 }
 
 // Start a new controller for a type adapter
@@ -65,28 +102,43 @@ func Start(
 	kubeclient kubernetes.Interface,
 	ociconfig ocisdkcommon.ConfigurationProvider,
 	informerFactory informers.SharedInformerFactory,
+	namespaces coreinformers.NamespaceInformer,
+	credentials *Credentials,
 	stopChan <-chan struct{},
 	adapterFactory resourcescommon.AdapterFactory,
 	adapterSpecificArgs map[string]interface{},
 	queueMap map[string]workqueue.RateLimitingInterface,
+	workers int,
+	policy resourcescommon.RetryPolicy,
 ) *Controller {
 	adapter := adapterFactory(clientset, kubeclient, ociconfig, adapterSpecificArgs)
-	controller := New(adapter, kubeclient, informerFactory, queueMap)
-	controller.Run(stopChan)
+	controller := New(adapter, kubeclient, informerFactory, namespaces, queueMap)
+	controller.setRetryPolicy(policy)
+	controller.setAdapterFactory(ociconfig, func(provider ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter {
+		return adapterFactory(clientset, kubeclient, provider, adapterSpecificArgs)
+	})
+	if credentials != nil {
+		controller.setCredentials(credentials)
+	}
+	controller.Run(workers, stopChan)
 	return controller
 }
 
-// New initializes a controller object
+// New initializes a controller object. The namespace informer is optional,
+// without it only the paused annotation of the object itself is honoured
 func New(adapter resourcescommon.ResourceTypeAdapter,
 	kubeclient kubernetes.Interface,
 	informerFactory informers.SharedInformerFactory,
+	namespaces coreinformers.NamespaceInformer,
 	queueMap map[string]workqueue.RateLimitingInterface) *Controller {
 	c := &Controller{
 		adapter:  adapter,
 		queue:    queueMap[adapter.Kind()],
 		queueMap: queueMap,
 		factory:  informerFactory,
+		stalled:  make(map[string]stall),
 	}
+	c.setRetryPolicy(resourcescommon.DefaultRetryPolicy())
 
 	glog.V(4).Infof("Creating event broadcaster for resource %s", adapter.Kind())
 	eventBroadcaster := record.NewBroadcaster()
@@ -106,19 +158,36 @@ func New(adapter resourcescommon.ResourceTypeAdapter,
 
 	c.informer = genericInfomer.Informer()
 
+	// dependents are looked up by the controllers of the parent kinds
+	if _, ok := c.informer.GetIndexer().GetIndexers()[dependencyIndex]; !ok {
+		if err := c.informer.AddIndexers(cache.Indexers{dependencyIndex: c.indexDependencies}); err != nil {
+			glog.Errorf("Error adding dependency index for resource: %s - %v", adapter.Resource(), err)
+		}
+	}
+
 	c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			key, err := cache.MetaNamespaceKeyFunc(obj)
 			if err == nil {
 				// fmt.Printf("EVENT ADD key %s: %#v\n", key, obj)
 				c.queue.Add(key)
+				c.enqueueDependencies(obj)
 			}
 		},
 		UpdateFunc: func(old, cur interface{}) {
 			key, err := cache.MetaNamespaceKeyFunc(cur)
 			if err == nil {
 				// fmt.Printf("EVENT UPDATE %v %v\n", old, cur)
+				if retryAnnotation(old) != retryAnnotation(cur) {
+					// a forced retry starts with a fresh backoff and timeout
+					c.queue.Forget(key)
+					c.pending.restart(key, time.Now())
+				}
 				c.queue.Add(key)
+				if resourceVersion(old) != resourceVersion(cur) {
+					c.enqueueDependencies(old)
+					c.enqueueDependencies(cur)
+				}
 			}
 		},
 		DeleteFunc: func(obj interface{}) {
@@ -126,18 +195,65 @@ func New(adapter resourcescommon.ResourceTypeAdapter,
 			if err == nil {
 				// fmt.Printf("EVENT DELETE %v\n", obj)
 				c.queue.Add(key)
+				c.enqueueDependencies(obj)
 			}
 		},
 	})
 
+	if namespaces != nil {
+		c.namespaces = namespaces.Lister()
+		namespaces.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, cur interface{}) {
+				oldns, curns := old.(*corev1.Namespace), cur.(*corev1.Namespace)
+				if ocicommon.IsPaused(oldns) != ocicommon.IsPaused(curns) ||
+					oldns.Annotations[ocicommon.RegionAnnotation] != curns.Annotations[ocicommon.RegionAnnotation] {
+					c.enqueueNamespace(curns.Name)
+				}
+			},
+		})
+	}
+
 	return c
 
 }
 
-// Run turns on the controller
-func (c *Controller) Run(stopCh <-chan struct{}) {
+// setRetryPolicy sets the retry policy, it must be called before the controller runs
+func (c *Controller) setRetryPolicy(policy resourcescommon.RetryPolicy) {
+	c.policy = policy
+	c.pending = newPendingTracker(policy.PendingBaseDelay.Duration, policy.PendingMaxDelay.Duration)
+}
+
+func resourceVersion(obj interface{}) string {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		return accessor.GetResourceVersion()
+	}
+	return ""
+}
+
+func retryAnnotation(obj interface{}) string {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		return accessor.GetAnnotations()[ocicommon.RetryAnnotation]
+	}
+	return ""
+}
+
+// enqueueNamespace queues all objects of the namespace, i.e. after it was paused or resumed
+func (c *Controller) enqueueNamespace(namespace string) {
+	for _, key := range c.informer.GetStore().ListKeys() {
+		if ns, _, err := cache.SplitMetaNamespaceKey(key); err == nil && ns == namespace {
+			c.queue.Add(key)
+		}
+	}
+}
+
+// Run turns on the controller with the given number of workers.
+// The workqueue never hands the same key to two workers at the same time,
+// so a key is always reconciled serially while different keys of the same
+// kind are processed in parallel.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	defer utilruntime.HandleCrash()
 
+	c.stopCh = stopCh
 	go c.informer.Run(stopCh)
 
 	if !cache.WaitForCacheSync(stopCh, c.HasSynced) {
@@ -145,8 +261,13 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		return
 	}
 
-	// TODO add multiple workers for performance
-	go wait.Until(c.worker, time.Second, stopCh)
+	if workers < 1 {
+		workers = 1
+	}
+	glog.V(2).Infof("Starting %d workers for %s", workers, c.adapter.Kind())
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
 
 	go func() {
 		<-stopCh
@@ -160,6 +281,34 @@ func (c *Controller) HasSynced() bool {
 	return c.informer.HasSynced()
 }
 
+// Drain waits up to timeout for the reconciles in flight once the controller is stopped, their
+// status is written before they finish. It returns false if they did not finish in time.
+func (c *Controller) Drain(timeout time.Duration) bool {
+	drained := make(chan struct{})
+	go func() {
+		c.reconciling.Lock()
+		c.reconciling.Unlock()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return true
+	case <-time.After(timeout):
+		glog.Warningf("Timed out waiting for the %s reconciles in flight", c.adapter.Kind())
+		return false
+	}
+}
+
+// isStopped returns true once the stop channel of Run is closed
+func (c *Controller) isStopped() bool {
+	select {
+	case <-c.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
 // LastSyncResourceVersion is required for the cache.Controller interface.
 func (c *Controller) LastSyncResourceVersion() string {
 	return c.informer.LastSyncResourceVersion()
@@ -180,14 +329,30 @@ func (c *Controller) dequeue() bool {
 	}
 	defer c.queue.Done(key)
 
+	// the queued keys are left to the next leader once the controller is stopped
+	c.reconciling.RLock()
+	defer c.reconciling.RUnlock()
+	if c.isStopped() {
+		return false
+	}
+
+	startTime := time.Now()
 	object, err, retry := c.reconcile(key.(string))
 
-	if err == nil {
-		if retry && c.queue.NumRequeues(key) <= 50 {
-			glog.V(4).Infof("Resubmit for reconcile key: %v", key)
-			c.queue.AddAfter(key, 3*time.Second)
-			return true
+	outcome := reconcileOutcome(object, err, retry)
+	reconcileTotal.Inc(c.adapter.Kind(), outcome)
+	reconcileDuration.Observe(time.Since(startTime).Seconds(), c.adapter.Kind(), outcome)
+
+	if err == nil && retry {
+		// persist condition transitions while the resource is still pending
+		if c.poll(key.(string), object) && object != nil && c.conditionsChanged(key.(string), object) {
+			c.report(key.(string), object)
 		}
+		return true
+	}
+	c.pending.forget(key.(string))
+
+	if err == nil {
 		// No error, no retries reset the ratelimit counters and update the object
 		err := c.report(key.(string), object)
 		if err != nil {
@@ -195,30 +360,136 @@ func (c *Controller) dequeue() bool {
 		} else {
 			c.queue.Forget(key)
 		}
-	} else if c.queue.NumRequeues(key) <= 5 {
+	} else if throttled, ok := resourcescommon.IsThrottled(err); ok {
+		// throttling is not a failure of the object, it does not count towards stalling it
+		c.throttled(key.(string), object, throttled)
+	} else if c.queue.NumRequeues(key) < c.policy.MaxRetries {
 		if apierrors.IsConflict(err) {
 			glog.V(4).Infof("Conflict during reconcile %s key %v - %v", c.adapter.Kind(), key, err)
 		} else {
 			glog.Errorf("Error reconciling %s key %v - %v", c.adapter.Kind(), key, err)
+			// persist the failed condition while the key is retried
+			if object != nil && c.conditionsTransitioned(key.(string), object) {
+				c.report(key.(string), object)
+			}
 		}
 		c.queue.AddRateLimited(key)
 	} else {
 		// err != nil and too many retries
-		c.report(key.(string), object)
-		c.queue.Forget(key)
 		utilruntime.HandleError(err)
+		c.stall(key.(string), object, reasonRetriesExhausted, err.Error())
 	}
 
 	return true
 }
 
-// Report object changes to the client to update/save
+// throttled requeues an object whose OCI call was throttled once the service allows it again
+func (c *Controller) throttled(key string, object runtime.Object, throttled *resourcescommon.ThrottledError) {
+	glog.V(2).Infof("Throttled reconciling %s key %v, retry in %v - %v", c.adapter.Kind(), key, throttled.RetryAfter, throttled.Err)
+	if object == nil {
+		if obj, exists, err := c.informer.GetStore().GetByKey(key); err == nil && exists {
+			object = obj.(runtime.Object)
+		}
+	}
+	if object != nil {
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourceThrottled,
+			fmt.Sprintf("OCI %s %s throttled, retry in %v", throttled.Call.Service, throttled.Call.Operation, throttled.RetryAfter))
+	}
+	c.queue.AddAfter(key, throttled.RetryAfter)
+}
+
+// poll requeues a pending object with exponential backoff until its operation times out.
+// It reports if the object is still polled, a timed out object is stalled.
+func (c *Controller) poll(key string, object runtime.Object) bool {
+	deleting := false
+	if object != nil {
+		deleting = c.adapter.ObjectMeta(object).GetDeletionTimestamp() != nil
+	}
+	operation, started := pendingOperation(object, deleting)
+	timeout := c.policy.PendingTimeout(operation)
+	delay, expired := c.pending.next(key, operation, started, timeout, time.Now())
+	if !expired {
+		glog.V(4).Infof("Resubmit for reconcile key: %v in %v", key, delay)
+		c.queue.AddAfter(key, delay)
+		return true
+	}
+
+	c.pending.forget(key)
+	msg := fmt.Sprintf("%s of OCI resource did not complete within %v", operation, timeout)
+	if object != nil {
+		setCondition(object, ocicommon.ConditionSynced, ocicommon.ConditionFalse, reasonTimeout, msg, c.adapter.ObjectMeta(object).GetGeneration())
+	}
+	c.stall(key, object, reasonTimeout, msg)
+	return false
+}
+
+// stall gives up on the object after its retries are exhausted or its pending operation
+// timed out. The Stalled condition holds the last error until the object is reconciled
+// again because its spec changed, it is deleted or its retry annotation is bumped.
+func (c *Controller) stall(key string, object runtime.Object, reason, msg string) {
+	c.queue.Forget(key)
+	if object == nil {
+		obj, exists, err := c.informer.GetStore().GetByKey(key)
+		if err != nil || !exists {
+			return
+		}
+		object = obj.(runtime.Object).DeepCopyObject()
+	}
+	objectmeta := c.adapter.ObjectMeta(object)
+
+	c.stalledLock.Lock()
+	c.stalled[key] = stall{
+		generation: objectmeta.GetGeneration(),
+		retry:      objectmeta.GetAnnotations()[ocicommon.RetryAnnotation],
+		deleting:   objectmeta.GetDeletionTimestamp() != nil,
+	}
+	c.stalledLock.Unlock()
+
+	setCondition(object, ocicommon.ConditionStalled, ocicommon.ConditionTrue, reason, msg, objectmeta.GetGeneration())
+	if c.conditionsChanged(key, object) {
+		glog.Errorf("Stalled reconciling %s key %v - %s", c.adapter.Kind(), key, msg)
+		c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceStall, msg)
+	}
+	c.report(key, object)
+}
+
+// isStalled reports if the object is still stalled, a stall is released as soon
+// as the object changed in a way that makes another attempt worthwhile
+func (c *Controller) isStalled(key string, object runtime.Object, objectmeta metav1.Object) bool {
+	c.stalledLock.Lock()
+	defer c.stalledLock.Unlock()
+
+	stalled, ok := c.stalled[key]
+	if !ok {
+		return false
+	}
+	if stalled.generation == objectmeta.GetGeneration() &&
+		stalled.retry == objectmeta.GetAnnotations()[ocicommon.RetryAnnotation] &&
+		stalled.deleting == (objectmeta.GetDeletionTimestamp() != nil) {
+		return true
+	}
+
+	glog.V(1).Infof("Retrying stalled %s %s", c.adapter.Kind(), key)
+	delete(c.stalled, key)
+	if stalled.retry == objectmeta.GetAnnotations()[ocicommon.RetryAnnotation] {
+		// a forced retry was already reset when the annotation changed
+		c.queue.Forget(key)
+		c.pending.forget(key)
+	}
+	if s := resourceStatus(object); s != nil {
+		s.RemoveCondition(ocicommon.ConditionStalled)
+	}
+	return false
+}
+
+// Report object changes to the client to update/save, the status is written
+// through the status subresource
 func (c *Controller) report(key string, object runtime.Object) error {
 	kind := c.adapter.Kind()
 	if object != nil {
 		glog.V(1).Infof("Updating object %s  %s \n", kind, key)
 		glog.V(4).Infof("Updating object %s  %s --- %#v\n", kind, key, object)
-		if _, e := c.adapter.UpdateObject(object); e != nil {
+		if e := c.write(key, object); e != nil {
 			if apierrors.IsConflict(e) {
 				glog.V(4).Infof("Conflict updating reconciled CRD %s key %v - %v", c.adapter.Kind(), key, e)
 			} else {
@@ -229,7 +500,7 @@ func (c *Controller) report(key string, object runtime.Object) error {
 		c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourceUpdate, fmt.Sprintf("Updated CRD resource %s  %s", kind, key))
 
 		//signal all Dependents about parent update
-		for depKind, depKeys := range c.adapter.Dependents(object) {
+		for depKind, depKeys := range c.dependents(object) {
 			for _, depKey := range depKeys {
 				if _, ok := c.queueMap[depKind]; ok {
 					glog.V(4).Infof("Signal to %s, %s, about update on %s %#v", depKind, depKey, key, object)
@@ -243,6 +514,56 @@ func (c *Controller) report(key string, object runtime.Object) error {
 	return nil
 }
 
+// write saves the object, the apiserver ignores the status of object updates and all
+// but the status of status updates. The object itself is only updated if its metadata
+// or spec changed.
+func (c *Controller) write(key string, object runtime.Object) error {
+	objectmeta := c.adapter.ObjectMeta(object)
+	if c.isObjectChanged(key, object) {
+		updated, err := c.adapter.UpdateObject(object)
+		if err != nil {
+			return err
+		}
+		// the object is gone once its last finalizer is removed
+		if objectmeta.DeletionTimestamp != nil && len(objectmeta.GetFinalizers()) == 0 {
+			return nil
+		}
+		objectmeta.SetResourceVersion(c.adapter.ObjectMeta(updated).GetResourceVersion())
+	}
+	_, err := c.adapter.UpdateObjectStatus(object)
+	return err
+}
+
+// isObjectChanged reports if anything but the status differs from the cached object
+func (c *Controller) isObjectChanged(key string, object runtime.Object) bool {
+	cached, exists, err := c.informer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return true
+	}
+	current, err := withoutStatus(object)
+	if err != nil {
+		return true
+	}
+	previous, err := withoutStatus(cached.(runtime.Object))
+	if err != nil {
+		return true
+	}
+	return !reflect.DeepEqual(current, previous)
+}
+
+func withoutStatus(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "status")
+	return fields, nil
+}
+
 // Reconcile the resource with the incoming object specification.
 // This is the main func doing the work to manage the lifecycle of a resource
 func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error, retry bool) {
@@ -276,6 +597,32 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 		return object, nil, false
 	}
 
+	generation := objectmeta.Generation
+
+	// Credentials and region
+	// The OCI calls of the object are made with the credentials selected by its
+	// namespace, an object pending delete keeps its finalizer until they are usable
+	oci, credentials, err := c.ociAdapter(objectmeta, c.objectRegion(object))
+	if err != nil {
+		return c.refuseCredentials(kind, key, source, object, err, generation)
+	}
+	setCredentialsReady(object, credentials, generation)
+	oci = c.auditedAdapter(oci, object, objectmeta)
+
+	// Paused
+	// Nothing is created, updated or deleted while the object or its namespace
+	// is paused, an object pending delete keeps its finalizer until resumed
+	if paused, reason := c.isPaused(objectmeta); paused {
+		return c.pause(oci, kind, key, source, object, reason, generation)
+	}
+	c.resume(kind, key, object)
+
+	// Stalled
+	// Retries are exhausted, nothing is attempted until the object changes
+	if c.isStalled(key, object, objectmeta) {
+		return nil, nil, false
+	}
+
 	// Delete
 	// If we have a pending delete first to start the delete flow
 	// by removing the remote resource object first and them cleaning
@@ -283,36 +630,51 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	// of the object itself. This will require several reconcile passes to complete
 	if objectmeta.DeletionTimestamp != nil {
 
+		// An unknown policy, e.g. a typo of Orphan, must not delete the OCI resource
+		policy := deletionPolicy(object, objectmeta)
+		if !policy.IsValid() {
+			return c.refuseDeletion(kind, key, source, object, policy, generation)
+		}
+
+		// An orphaned resource is left in OCI so there is no delete to order
+		// with its dependents
+		if policy == ocicommon.DeletionPolicyOrphan {
+			return c.orphan(oci, kind, key, source, object, generation)
+		}
+
 		if c.haveDeps(object) {
 			format := "Dependents still present on resource %s  %s, re-submit for reconcile with backoff \n"
 			glog.V(2).Infof(format, kind, key)
-			return nil, nil, true
+			setCondition(object, ocicommon.ConditionDeleting, ocicommon.ConditionTrue, reasonDependentsPresent,
+				fmt.Sprintf("Waiting for dependents to be deleted: %v", c.dependents(object)), generation)
+			return object, nil, true
 		}
 
 		if c.adapter.Id(object) != "" {
 
 			glog.V(1).Infof("Deleting resource %s  %s \n", kind, key)
 			glog.V(4).Infof("Deleting resource %s  %s %#v\n", kind, key, object)
-			object, err = c.adapter.Delete(object)
+			deleted, err := oci.Delete(object)
+			if planned, ok := resourcescommon.IsPlannedCall(err); ok {
+				return c.plan(kind, key, source, object, planned, generation)
+			}
+			object = deleted
 			if err != nil {
 				glog.Errorf("ERROR deleting resource kind %s and key %s: %#v\n", kind, key, err)
+				setSyncError(object, reasonDeleteFailed, err, generation)
 				return object, err, false
 			} else if getResourceState(object) == ocicommon.ResourceStatePending {
+				setCondition(object, ocicommon.ConditionDeleting, ocicommon.ConditionTrue, reasonDeleting, "Deleting OCI resource", generation)
 				return object, nil, true
 			}
 		}
 
-		err = c.removeDependencyFromParent(object)
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				return object, err, false
-			}
-		}
-
 		if len(objectmeta.GetFinalizers()) > 0 {
 			objectmeta.SetFinalizers([]string{})
 		}
 
+		setCondition(object, ocicommon.ConditionDeleting, ocicommon.ConditionTrue, reasonDeleted, "OCI resource deleted", generation)
+		setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonDeleted, "OCI resource deleted", generation)
 		return object, nil, false
 
 	}
@@ -320,11 +682,24 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	//Create
 	//check dependencies
 	if ready, err := c.isDependencyReady(object); !ready {
+		if cycle, ok := err.(*dependencyCycleError); ok {
+			return c.refuseDependencyCycle(kind, key, source, object, cycle, generation)
+		}
+		if mismatch, ok := err.(*regionError); ok {
+			return c.refuseRegion(kind, key, source, object, mismatch, generation)
+		}
 		if err != nil {
+			setCondition(object, ocicommon.ConditionDependenciesReady, ocicommon.ConditionFalse, reasonDependencyError, err.Error(), generation)
 			return nil, err, false
 		}
+		setCondition(object, ocicommon.ConditionDependenciesReady, ocicommon.ConditionFalse, reasonWaitingForDependencies, "Waiting for dependencies to be ready", generation)
+		setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonWaitingForDependencies, "Waiting for dependencies to be ready", generation)
+		if conditionsChanged(source, object) {
+			return object, nil, false
+		}
 		return nil, nil, false
 	}
+	setCondition(object, ocicommon.ConditionDependenciesReady, ocicommon.ConditionTrue, reasonDependenciesReady, "", generation)
 
 	// Create
 	// The Id indicates if the resource is already created or it's pending.
@@ -332,17 +707,31 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	// from the beginning since the object was updated. It should be skipped on
 	// the next loop and proceed with the Get below to validate the create
 	if c.adapter.Id(object) == "" {
+		if ocid := importOcid(object, objectmeta); ocid != "" {
+			return c.adopt(oci, kind, key, object, ocid, generation)
+		}
+
 		glog.V(1).Infof("Creating resource %s  %s \n", kind, key)
 		glog.V(5).Infof("Creating resource %s  %s --- %#v\n", kind, key, object)
-		created, err := c.adapter.Create(object)
+		clearFailedWorkRequest(object)
+		created, err := oci.Create(object)
+		if planned, ok := resourcescommon.IsPlannedCall(err); ok {
+			return c.plan(kind, key, source, object, planned, generation)
+		}
 		if err != nil {
 			errMsg := fmt.Sprintf("ERROR creating resource kind %s and key %s: %#v\n", kind, key, err)
-			glog.Errorf(errMsg)
+			glog.Error(errMsg)
 			c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+			setSyncError(object, reasonCreateFailed, err, generation)
+			setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonCreateFailed, err.Error(), generation)
 			return object, err, false
 		}
+		if wr := workRequest(created); wr != nil {
+			return c.waitForWorkRequest(kind, key, source, created, wr, generation)
+		}
 		if created != nil {
 			c.recorder.Event(created, corev1.EventTypeNormal, eventTypeResourceUpdate, fmt.Sprintf("Created OCI resource %s  %s", kind, key))
+			setSynced(created, reasonCreated, generation)
 		}
 		return created, nil, false
 	}
@@ -351,13 +740,15 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	// If the current state of the object is not pending a delete or create
 	// we proceed with a Get call to fetch the remote resource so we can compare
 	// to the current object
-	found, err := c.adapter.Get(object)
+	found, err := oci.Get(object)
 	if err != nil {
 		errMsg := fmt.Sprintf("ERROR getting resource kind %s and key %s: %#v\n", kind, key, err)
-		glog.Errorf(errMsg)
+		glog.Error(errMsg)
 		c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+		setSyncError(found, reasonGetFailed, err, generation)
 		return found, err, false
 	} else if getResourceState(found) == ocicommon.ResourceStatePending {
+		setReady(found, generation)
 		return found, nil, true
 	}
 
@@ -369,24 +760,44 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 	// that needs to be corrected since we are the source of truth for the resource
 
 	if found != nil {
-		if !c.adapter.IsResourceCompliant(found) {
+		// Only field level drift is subject to the drift policy, a resource that
+		// is not compliant because of its state (i.e. a pending work request) is
+		// always handed to Update
+		compliant, drift := c.adapter.IsResourceCompliant(found)
+		policy := driftPolicy(object, objectmeta)
+		if !policy.IsValid() && (compliant || len(drift) > 0) {
+			return c.refuseDriftPolicy(kind, key, source, found, policy, generation)
+		}
+		if !compliant && (len(drift) == 0 || policy == ocicommon.DriftPolicyEnforce) {
 			glog.V(1).Infof("Updating resource %s  %s %#v\n", kind, key, found)
 			//this updates underlying oci resource not the crd
 			// crd will get updated in report func
-			updated, err := c.adapter.Update(object)
+			clearFailedWorkRequest(object)
+			updated, err := oci.Update(object)
+			if planned, ok := resourcescommon.IsPlannedCall(err); ok {
+				return c.plan(kind, key, source, found, planned, generation)
+			}
 			if err != nil {
 				errMsg := fmt.Sprintf("ERROR updating resource kind %s and key %s: %#v\n", kind, key, err)
-				glog.Errorf(errMsg)
+				glog.Error(errMsg)
 				c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+				setSyncError(object, reasonUpdateFailed, err, generation)
 				return object, err, true
 			}
+			if wr := workRequest(updated); wr != nil {
+				return c.waitForWorkRequest(kind, key, source, updated, wr, generation)
+			}
 			if updated != nil {
 				c.recorder.Event(updated, corev1.EventTypeNormal, eventTypeResourceUpdate, fmt.Sprintf("Updated OCI resource %s  %s", kind, key))
+				setSynced(updated, reasonUpdated, generation)
 			}
 
 			return updated, nil, false
 		}
 
+		c.recordDrift(kind, key, found, drift, policy, generation)
+		setSynced(found, reasonUpToDate, generation)
+
 		if c.adapter.IsResourceStatusChanged(source, found) {
 			//TODO add adapter call to get current resource status
 			logMsg := fmt.Sprintf("LifeCycleState of resource %s changed", key)
@@ -394,6 +805,10 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 			c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourceUpdate, logMsg)
 			return found, nil, false
 		}
+
+		if conditionsChanged(source, found) {
+			return found, nil, false
+		}
 	}
 
 	// Finally if there is no change it's a no-op
@@ -402,63 +817,334 @@ func (c *Controller) reconcile(key string) (reconciled runtime.Object, err error
 
 }
 
-func (c *Controller) isDependencyReady(obj runtime.Object) (bool, error) {
-
-	glog.V(4).Infof("Checking parent dependency on %s %#v", obj.GetObjectKind().GroupVersionKind().Kind, obj)
+// isPaused reports if reconcile of the object is paused by its own or its namespace annotation
+func (c *Controller) isPaused(objectmeta metav1.Object) (bool, string) {
+	if ocicommon.IsPaused(objectmeta) {
+		return true, reasonPaused
+	}
+	if c.namespaces == nil || objectmeta.GetNamespace() == "" {
+		return false, ""
+	}
+	if ns, err := c.namespaces.Get(objectmeta.GetNamespace()); err == nil && ocicommon.IsPaused(ns) {
+		return true, reasonNamespacePaused
+	}
+	return false, ""
+}
 
-	var deps []runtime.Object
+// pause only refreshes the status of a paused object from the existing OCI resource
+func (c *Controller) pause(oci resourcescommon.ResourceTypeAdapter, kind, key string, source, object runtime.Object, reason string, generation int64) (runtime.Object, error, bool) {
+	glog.V(2).Infof("Reconcile of resource %s  %s is paused (%s)\n", kind, key, reason)
 
-	labelSelectorsMap := c.adapter.DependsOn(obj)
+	if status := resourceStatus(source); status != nil && !status.IsConditionTrue(ocicommon.ConditionPaused) {
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourcePaused, fmt.Sprintf("Paused reconcile of resource %s  %s", kind, key))
+	}
 
-	if labelSelectorsMap != nil && len(labelSelectorsMap) > 0 {
-		for kinds, selector := range labelSelectorsMap {
-			sgvr := c.adapter.GroupVersionWithResource()
-			sgvr.Resource = kinds
-			informer, err := c.factory.ForResource(sgvr)
-			if err != nil {
-				return false, err
-			}
-			selector := labels.Set(selector.LabelSelector).AsSelectorPreValidated()
-			depOns, err := informer.Lister().List(selector)
-			if err != nil || len(depOns) == 0 {
-				glog.V(4).Infof("No ready parents found for %s %#v", obj.GetObjectKind().GroupVersionKind().Kind, obj)
-				return false, err
+	objectmeta := c.adapter.ObjectMeta(object)
+	if objectmeta.DeletionTimestamp == nil && c.adapter.Id(object) != "" {
+		found, err := oci.Get(object)
+		if err != nil {
+			glog.Errorf("ERROR getting paused resource kind %s and key %s: %#v\n", kind, key, err)
+			setSyncError(object, reasonGetFailed, err, generation)
+			setCondition(object, ocicommon.ConditionPaused, ocicommon.ConditionTrue, reason, "Create, update and delete are skipped", generation)
+			return object, err, false
+		}
+		if found != nil {
+			setReady(found, generation)
+			setCondition(found, ocicommon.ConditionPaused, ocicommon.ConditionTrue, reason, "Create, update and delete are skipped", generation)
+			if conditionsChanged(source, found) || c.adapter.IsResourceStatusChanged(source, found) {
+				return found, nil, false
 			}
-			deps = append(deps, depOns...)
+			return nil, nil, false
 		}
 	}
 
-	depRefs, err := c.adapter.DependsOnRefs(obj)
-	if err != nil {
-		return false, err
+	setCondition(object, ocicommon.ConditionPaused, ocicommon.ConditionTrue, reason, "Create, update and delete are skipped", generation)
+	if conditionsChanged(source, object) {
+		return object, nil, false
 	}
+	return nil, nil, false
+}
 
-	deps = append(deps, depRefs...)
+// resume clears the Paused condition once the object is no longer paused
+func (c *Controller) resume(kind, key string, object runtime.Object) {
+	status := resourceStatus(object)
+	if status == nil || status.GetCondition(ocicommon.ConditionPaused) == nil {
+		return
+	}
+	status.RemoveCondition(ocicommon.ConditionPaused)
+	c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourcePaused, fmt.Sprintf("Resumed reconcile of resource %s  %s", kind, key))
+}
 
-	for _, dep := range deps {
-		depCopy := dep.DeepCopyObject()
-		depObj := depCopy.(ocicommon.ObjectInterface)
-		if reged, err := depObj.IsDependentRegistered(c.adapter.Kind(), obj); !reged && err == nil {
-			depObj.AddDependent(c.adapter.Kind(), obj)
+// plan records the OCI call held back in dry-run mode in the status instead of failing the reconcile
+func (c *Controller) plan(kind, key string, source, object runtime.Object, planned *resourcescommon.PlannedCallError, generation int64) (runtime.Object, error, bool) {
+	status := resourceStatus(object)
+	if status == nil {
+		return nil, nil, false
+	}
 
-			_, err = c.adapter.UpdateForResource(depObj.GetGroupVersionResource(), depCopy)
+	plan := planned.Plan()
+	if !reflect.DeepEqual(status.Plan, plan) {
+		glog.V(1).Infof("Dry-run of resource %s  %s planned %s %s\n", kind, key, plan.Service, plan.Operation)
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourcePlan,
+			fmt.Sprintf("Dry-run would call %s %s for resource %s  %s", plan.Service, plan.Operation, kind, key))
+	}
+	status.Plan = plan
+	setCondition(object, ocicommon.ConditionSynced, ocicommon.ConditionFalse, reasonDryRun, planned.Error(), generation)
 
-			if err != nil {
-				return false, err
-			}
+	if conditionsChanged(source, object) {
+		return object, nil, false
+	}
+	return nil, nil, false
+}
+
+// workRequest returns the OCI work request the object is waiting for, succeeded work
+// requests are cleared by the adapters so it is either still running or failed
+func workRequest(obj runtime.Object) *ocicommon.WorkRequestStatus {
+	if status := resourceStatus(obj); status != nil {
+		return status.WorkRequest
+	}
+	return nil
+}
+
+// waitForWorkRequest keeps the object pending while its work request runs and records
+// a failed work request with its errors. The adapters forget a failed work request, it
+// is returned as error so that the operation is issued again with the retry backoff
+// until the object stalls.
+func (c *Controller) waitForWorkRequest(kind, key string, source, object runtime.Object, wr *ocicommon.WorkRequestStatus, generation int64) (runtime.Object, error, bool) {
+	if !wr.Failed() {
+		msg := fmt.Sprintf("Waiting for %s work request %s: %s", wr.Service, wr.Id, wr.Status)
+		if wr.Message != "" {
+			msg += " - " + wr.Message
 		}
-		if depObj.GetResourceID() == "" {
-			glog.V(4).Infof("Parent %#v is not ready", dep)
-			return false, nil
+		setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonWorkRequestPending, msg, generation)
+		return object, nil, true
+	}
+
+	err := &resourcescommon.WorkRequestError{WorkRequest: *wr}
+	setSyncError(object, reasonWorkRequestFailed, err, generation)
+	setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonWorkRequestFailed, err.Error(), generation)
+	if conditionsChanged(source, object) {
+		errMsg := fmt.Sprintf("ERROR %s %s %s: %v", wr.Operation, kind, key, err)
+		glog.Error(errMsg)
+		c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+	}
+	return object, err, false
+}
+
+// clearFailedWorkRequest forgets the failed work request of a previous attempt before
+// the operation is issued again
+func clearFailedWorkRequest(obj runtime.Object) {
+	if status := resourceStatus(obj); status != nil && status.WorkRequest != nil && status.WorkRequest.Failed() {
+		status.WorkRequest = nil
+	}
+}
+
+// refuseDependencyCycle records a dependency that would close a cycle, it is not registered
+// and not retried until the object is synced again
+func (c *Controller) refuseDependencyCycle(kind, key string, source, object runtime.Object, cycle *dependencyCycleError, generation int64) (runtime.Object, error, bool) {
+	setCondition(object, ocicommon.ConditionDependenciesReady, ocicommon.ConditionFalse, reasonDependencyCycle, cycle.Error(), generation)
+	setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonDependencyCycle, cycle.Error(), generation)
+	if !conditionsChanged(source, object) {
+		return nil, nil, false
+	}
+	errMsg := fmt.Sprintf("ERROR refusing dependency of %s %s: %v", kind, key, cycle)
+	glog.Error(errMsg)
+	c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+	return object, nil, false
+}
+
+// orphan completes the delete of an object without deleting its OCI resource. The ownership
+// tags are removed from the resource first, so that the orphan sweeper leaves it alone.
+func (c *Controller) orphan(oci resourcescommon.ResourceTypeAdapter, kind, key string, source, object runtime.Object, generation int64) (runtime.Object, error, bool) {
+	glog.V(1).Infof("Orphaning resource %s  %s \n", kind, key)
+
+	if owned, ok := oci.(resourcescommon.OwnedResourceAdapter); ok && c.adapter.Id(object) != "" {
+		err := owned.ReleaseOwned(c.adapter.Id(object))
+		if resourcescommon.IsNotFound(err) {
+			err = nil
+		}
+		if planned, ok := resourcescommon.IsPlannedCall(err); ok {
+			return c.plan(kind, key, source, object, planned, generation)
+		}
+		if err != nil {
+			glog.Errorf("ERROR releasing resource kind %s and key %s: %#v\n", kind, key, err)
+			setSyncError(object, reasonReleaseFailed, err, generation)
+			return object, err, false
 		}
 	}
 
-	return true, nil
+	objectmeta := c.adapter.ObjectMeta(object)
+	if len(objectmeta.GetFinalizers()) > 0 {
+		objectmeta.SetFinalizers([]string{})
+	}
+
+	if id := c.adapter.Id(object); id != "" {
+		c.recorder.Event(object, corev1.EventTypeNormal, eventTypeResourceUpdate, fmt.Sprintf("Orphaned OCI resource %s  %s with id %s", kind, key, id))
+	}
+	setCondition(object, ocicommon.ConditionDeleting, ocicommon.ConditionTrue, reasonOrphaned, "OCI resource orphaned", generation)
+	return object, nil, false
+}
+
+// refuseDeletion keeps the OCI resource and the finalizer of an object with an unknown deletion
+// policy, the object is deleted once the policy is corrected
+func (c *Controller) refuseDeletion(kind, key string, source, object runtime.Object, policy ocicommon.DeletionPolicy, generation int64) (runtime.Object, error, bool) {
+	msg := fmt.Sprintf("Unknown deletion policy %q, expected %s or %s, the OCI resource is not deleted",
+		policy, ocicommon.DeletionPolicyDelete, ocicommon.DeletionPolicyOrphan)
+	setCondition(object, ocicommon.ConditionDeleting, ocicommon.ConditionFalse, reasonInvalidDeletionPolicy, msg, generation)
+	setCondition(object, ocicommon.ConditionSynced, ocicommon.ConditionFalse, reasonInvalidDeletionPolicy, msg, generation)
+	if !conditionsChanged(source, object) {
+		return nil, nil, false
+	}
+	glog.Errorf("ERROR deleting resource %s %s: %s", kind, key, msg)
+	c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, msg)
+	return object, nil, false
+}
+
+// refuseDriftPolicy leaves the OCI resource of an object with an unknown drift policy alone,
+// a typo of Report or Ignore must not update it and a typo of Enforce must not hide the drift
+func (c *Controller) refuseDriftPolicy(kind, key string, source, object runtime.Object, policy ocicommon.DriftPolicy, generation int64) (runtime.Object, error, bool) {
+	msg := fmt.Sprintf("Unknown drift policy %q, expected %s, %s or %s, the OCI resource is not updated",
+		policy, ocicommon.DriftPolicyEnforce, ocicommon.DriftPolicyReport, ocicommon.DriftPolicyIgnore)
+	setCondition(object, ocicommon.ConditionSynced, ocicommon.ConditionFalse, reasonInvalidDriftPolicy, msg, generation)
+	if !conditionsChanged(source, object) {
+		return nil, nil, false
+	}
+	glog.Errorf("ERROR updating resource %s %s: %s", kind, key, msg)
+	c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, msg)
+	return object, nil, false
+}
+
+// recordDrift keeps the drifted fields in the status when the drift is only reported
+func (c *Controller) recordDrift(kind, key string, found runtime.Object, drift resourcescommon.Drift, policy ocicommon.DriftPolicy, generation int64) {
+	status := resourceStatus(found)
+	if status == nil {
+		return
+	}
+
+	if policy != ocicommon.DriftPolicyReport || len(drift) == 0 {
+		status.Drift = nil
+		status.RemoveCondition(ocicommon.ConditionDrifted)
+		return
+	}
+
+	if !reflect.DeepEqual(status.Drift, []ocicommon.FieldDrift(drift)) {
+		c.recorder.Event(found, corev1.EventTypeWarning, eventTypeResourceDrift, fmt.Sprintf("OCI resource %s  %s drifted from spec: %s", kind, key, drift))
+	}
+	status.Drift = drift
+	setCondition(found, ocicommon.ConditionDrifted, ocicommon.ConditionTrue, reasonDriftReported,
+		fmt.Sprintf("%d field(s) differ from the spec", len(drift)), generation)
+}
+
+// adopt brings an existing OCI resource under management instead of creating a new one.
+// The OCID is only persisted once the resource was found in the expected compartment.
+func (c *Controller) adopt(oci resourcescommon.ResourceTypeAdapter, kind, key string, object runtime.Object, ocid string, generation int64) (runtime.Object, error, bool) {
+	fail := func(err error) (runtime.Object, error, bool) {
+		errMsg := fmt.Sprintf("ERROR importing resource kind %s and key %s: %v\n", kind, key, err)
+		glog.Error(errMsg)
+		c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+		setSyncError(object, reasonImportFailed, err, generation)
+		setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, reasonImportFailed, err.Error(), generation)
+		return object, err, false
+	}
+
+	if _, ok := object.(ocicommon.ImportInterface); !ok {
+		return fail(fmt.Errorf("%s does not support import by OCID", kind))
+	}
+
+	glog.V(1).Infof("Importing resource %s  %s with id %s\n", kind, key, ocid)
+	candidate := object.DeepCopyObject()
+	candidate.(ocicommon.ImportInterface).SetResourceID(ocid)
+	found, err := oci.Get(candidate)
+	if err != nil {
+		return fail(err)
+	}
+
+	imported, ok := found.(ocicommon.ImportInterface)
+	if !ok {
+		return fail(fmt.Errorf("%s does not support import by OCID", kind))
+	}
+	if !importFound(found) {
+		return fail(fmt.Errorf("OCID %s not found or not authorized", ocid))
+	}
+	compartmentId, err := c.compartmentId(candidate)
+	if err != nil {
+		return fail(err)
+	}
+	if compartmentId != "" && imported.GetResourceCompartmentID() != compartmentId {
+		return fail(fmt.Errorf("OCI resource %s is in compartment %s but compartmentRef %s resolves to %s",
+			ocid, imported.GetResourceCompartmentID(), imported.GetCompartmentRef(), compartmentId))
+	}
+
+	// the first update after the import would overwrite the fields that differ from the
+	// spec, the import is only done once they match or the drift is not enforced
+	msg := fmt.Sprintf("Imported OCI resource %s  %s with id %s", kind, key, ocid)
+	if _, drift := c.adapter.IsResourceCompliant(found); len(importDrift(drift)) > 0 {
+		policy := driftPolicy(object, c.adapter.ObjectMeta(object))
+		if !policy.IsValid() {
+			return fail(fmt.Errorf("OCI resource %s differs from the spec and the drift policy %q is unknown: %s",
+				ocid, policy, importDrift(drift)))
+		}
+		if policy == ocicommon.DriftPolicyEnforce {
+			return fail(fmt.Errorf("OCI resource %s differs from the spec, update the spec or set the drift policy to %s to import it as is: %s",
+				ocid, ocicommon.DriftPolicyReport, importDrift(drift)))
+		}
+		msg += fmt.Sprintf(", it differs from the spec: %s", importDrift(drift))
+	}
+	c.recorder.Event(found, corev1.EventTypeNormal, eventTypeResourceUpdate, msg)
+	setSynced(found, reasonImported, generation)
+	return found, nil, false
 }
 
-func (c *Controller) removeDependencyFromParent(obj runtime.Object) error {
+// importFound reports if the Get of an import found the OCI resource. The adapters take a
+// resource that is not found or not authorized as deleted, the Get then succeeds and
+// leaves the resource without its compartment or lifecycle state.
+func importFound(obj runtime.Object) bool {
+	if imported, ok := obj.(ocicommon.ImportInterface); ok && imported.GetResourceCompartmentID() != "" {
+		return true
+	}
+	if lifecycle, ok := obj.(interface{ GetResourceLifecycleState() string }); ok && lifecycle.GetResourceLifecycleState() != "" {
+		return true
+	}
+	return false
+}
 
-	glog.V(4).Infof("Removing parent dependency of %s %#v", obj.GetObjectKind().GroupVersionKind().Kind, obj)
+// importDrift returns the drift of an imported resource without its tags, the tags of the
+// spec are merged into the ones of the resource rather than replacing them
+func importDrift(drift resourcescommon.Drift) resourcescommon.Drift {
+	var fields resourcescommon.Drift
+	for _, field := range drift {
+		if field.Field != "freeformTags" && field.Field != "definedTags" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// compartmentId resolves the compartmentRef of an object to an OCID, or empty if it has none
+func (c *Controller) compartmentId(obj runtime.Object) (string, error) {
+	ref := obj.(ocicommon.ImportInterface).GetCompartmentRef()
+	if ref == "" || resourcescommon.IsOcid(ref) {
+		return ref, nil
+	}
+
+	deps, err := c.adapter.DependsOnRefs(obj)
+	if err != nil {
+		return "", err
+	}
+	for _, dep := range deps {
+		if compartment, ok := dep.(*ociidentityv1alpha1.Compartment); ok && compartment.Name == ref {
+			if compartment.GetResourceID() == "" {
+				return "", fmt.Errorf("compartment %s is not ready", ref)
+			}
+			return compartment.GetResourceID(), nil
+		}
+	}
+	return "", fmt.Errorf("compartment %s not found", ref)
+}
+
+func (c *Controller) isDependencyReady(obj runtime.Object) (bool, error) {
+
+	glog.V(4).Infof("Checking parent dependency on %s %#v", obj.GetObjectKind().GroupVersionKind().Kind, obj)
 
 	var deps []runtime.Object
 
@@ -466,17 +1152,10 @@ func (c *Controller) removeDependencyFromParent(obj runtime.Object) error {
 
 	if labelSelectorsMap != nil && len(labelSelectorsMap) > 0 {
 		for kinds, selector := range labelSelectorsMap {
-			glog.V(2).Infof("Removing dependency from %s", kinds)
-			sgvr := c.adapter.GroupVersionWithResource()
-			sgvr.Resource = kinds
-			informer, err := c.factory.ForResource(sgvr)
-			if err != nil {
-				return err
-			}
-			selector := labels.Set(selector.LabelSelector).AsSelectorPreValidated()
-			depOns, err := informer.Lister().List(selector)
-			if err != nil {
-				return err
+			depOns, err := c.listDependsOn(kinds, selector)
+			if err != nil || len(depOns) == 0 {
+				glog.V(4).Infof("No ready parents found for %s %#v", obj.GetObjectKind().GroupVersionKind().Kind, obj)
+				return false, err
 			}
 			deps = append(deps, depOns...)
 		}
@@ -484,35 +1163,55 @@ func (c *Controller) removeDependencyFromParent(obj runtime.Object) error {
 
 	depRefs, err := c.adapter.DependsOnRefs(obj)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	deps = append(deps, depRefs...)
 
-	depsFound := false
 	for _, dep := range deps {
-		depCopy := dep.DeepCopyObject()
-		depObj := depCopy.(ocicommon.ObjectInterface)
-		if reged, err := depObj.IsDependentRegistered(c.adapter.Kind(), obj); reged && err == nil {
-			glog.V(2).Infof("Removing parent dependency of %s/%s from %#v", obj.GetObjectKind().GroupVersionKind().Kind, c.adapter.ObjectMeta(obj).Name, depCopy)
-			depObj.RemoveDependent(c.adapter.Kind(), obj)
-			_, err = c.adapter.UpdateForResource(depObj.GetGroupVersionResource(), depCopy)
-			if err != nil {
-				return err
-			}
-			depsFound = true
+		if cycle := c.dependencyCycle(obj, dep); cycle != nil {
+			return false, &dependencyCycleError{cycle: cycle}
+		}
+		if dep.(ocicommon.ObjectInterface).GetResourceID() == "" {
+			glog.V(4).Infof("Parent %#v is not ready", dep)
+			return false, nil
 		}
 	}
 
-	if depsFound {
-		return fmt.Errorf("Still have parent dependencies of %s %s", c.adapter.Kind(), c.adapter.ObjectMeta(obj).Name)
+	if mismatch := c.checkRegion(obj, deps); mismatch != nil {
+		return false, mismatch
 	}
-	return nil
+	return true, nil
 }
 
 func (c *Controller) haveDeps(obj runtime.Object) bool {
-	return c.adapter.Dependents(obj) != nil && len(c.adapter.Dependents(obj)) > 0
+	return len(c.dependents(obj)) > 0
 }
+
+func deletionPolicy(obj runtime.Object, objectmeta metav1.Object) ocicommon.DeletionPolicy {
+	var policy *ocicommon.ResourcePolicy
+	if resource, ok := obj.(ocicommon.ObjectInterface); ok {
+		policy = resource.GetResourcePolicy()
+	}
+	return policy.GetDeletionPolicy(objectmeta)
+}
+
+func driftPolicy(obj runtime.Object, objectmeta metav1.Object) ocicommon.DriftPolicy {
+	var policy *ocicommon.ResourcePolicy
+	if resource, ok := obj.(ocicommon.ObjectInterface); ok {
+		policy = resource.GetResourcePolicy()
+	}
+	return policy.GetDriftPolicy(objectmeta)
+}
+
+func importOcid(obj runtime.Object, objectmeta metav1.Object) string {
+	var policy *ocicommon.ResourcePolicy
+	if resource, ok := obj.(ocicommon.ObjectInterface); ok {
+		policy = resource.GetResourcePolicy()
+	}
+	return policy.GetImportOcid(objectmeta)
+}
+
 func getResourceState(obj runtime.Object) ocicommon.ResourceState {
 	resource := obj.(ocicommon.ObjectInterface)
 	return resource.GetResourceState()
@@ -17,13 +17,27 @@ limitations under the License.
 package resources
 
 import (
+	"context"
+	"errors"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
 	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
 	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
 	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
 	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -75,8 +89,8 @@ func TestControllerCreateHandle(t *testing.T) {
 
 	t.Log("Starting controller informers")
 	kubeclient := fake.NewSimpleClientset()
-	controller := New(vcnAdapter, kubeclient, informerFactory, workQueues)
-	controller.Run(stopCh)
+	controller := New(vcnAdapter, kubeclient, informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
 
 	time.Sleep(1 * time.Second)
 
@@ -90,6 +104,18 @@ func TestControllerCreateHandle(t *testing.T) {
 		t.Logf("Vcn ocid populated - %s", realizedVcn.GetResourceID())
 	}
 
+	for _, conditionType := range []ocicommon.ConditionType{ocicommon.ConditionSynced, ocicommon.ConditionDependenciesReady} {
+		if !realizedVcn.Status.IsConditionTrue(conditionType) {
+			t.Errorf("Expected condition %s to be true, got %#v", conditionType, realizedVcn.Status.Conditions)
+		}
+	}
+	if ready := realizedVcn.Status.GetCondition(ocicommon.ConditionReady); ready == nil || ready.Status != ocicommon.ConditionFalse {
+		t.Errorf("Expected Ready condition to be false until the vcn is available, got %#v", ready)
+	}
+	if realizedVcn.Status.State != ocicommon.ResourceStateProcessed {
+		t.Errorf("Expected legacy state to be populated, got %q", realizedVcn.Status.State)
+	}
+
 	var stop struct{}
 	stopCh <- stop
 
@@ -140,8 +166,8 @@ func TestControllerDeletHandle(t *testing.T) {
 
 	t.Log("Starting controller informers")
 	kubeclient := fake.NewSimpleClientset()
-	controller := New(vcnAdapter, kubeclient, informerFactory, workQueues)
-	controller.Run(stopCh)
+	controller := New(vcnAdapter, kubeclient, informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
 
 	time.Sleep(1 * time.Second)
 
@@ -158,3 +184,1005 @@ func TestControllerDeletHandle(t *testing.T) {
 	stopCh <- stop
 
 }
+
+// serialAdapter wraps an adapter to track how many reconciles of the same
+// key overlap while several workers are running
+type serialAdapter struct {
+	resourcescommon.ResourceTypeAdapter
+	lock        sync.Mutex
+	inflight    map[string]int
+	overlapped  bool
+	maxInflight int
+}
+
+func (a *serialAdapter) track(obj runtime.Object, fn func() (runtime.Object, error)) (runtime.Object, error) {
+	key, _ := cache.MetaNamespaceKeyFunc(obj)
+	a.lock.Lock()
+	a.inflight[key]++
+	if a.inflight[key] > 1 {
+		a.overlapped = true
+	}
+	total := 0
+	for _, n := range a.inflight {
+		total += n
+	}
+	if total > a.maxInflight {
+		a.maxInflight = total
+	}
+	a.lock.Unlock()
+
+	time.Sleep(100 * time.Millisecond)
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.inflight[key]--
+	return fn()
+}
+
+func (a *serialAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	return a.track(obj, func() (runtime.Object, error) { return a.ResourceTypeAdapter.Create(obj) })
+}
+
+func (a *serialAdapter) Get(obj runtime.Object) (runtime.Object, error) {
+	return a.track(obj, func() (runtime.Object, error) { return a.ResourceTypeAdapter.Get(obj) })
+}
+
+func TestControllerMultipleWorkers(t *testing.T) {
+
+	t.Log("Testing OCI controller with multiple workers")
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := fakeoci.NewVcnClient()
+
+	adapter := &serialAdapter{
+		ResourceTypeAdapter: coreresources.NewVcnAdapterBasic(clientset, vcnClient),
+		inflight:            make(map[string]int),
+	}
+
+	names := []string{"vcn.test1", "vcn.test2", "vcn.test3", "vcn.test4"}
+	for _, name := range names {
+		vcn := corev1alpha1.Vcn{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  fakeNs,
+				Finalizers: []string{"ocimanager"},
+			},
+			Spec: corev1alpha1.VcnSpec{
+				CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			},
+		}
+		if _, err := adapter.CreateObject(&vcn); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[adapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	kubeclient := fake.NewSimpleClientset()
+	controller := New(adapter, kubeclient, informerFactory, nil, workQueues)
+	controller.Run(4, stopCh)
+
+	// queue the same keys again while the first pass is still running
+	for _, name := range names {
+		workQueues[adapter.Kind()].Add(fakeNs + "/" + name)
+	}
+
+	time.Sleep(2 * time.Second)
+	close(stopCh)
+
+	for _, name := range names {
+		realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get(name, metav1.GetOptions{})
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		} else if realizedVcn.GetResourceID() == "" {
+			t.Errorf("Vcn %s resource id was not populated", name)
+		}
+	}
+
+	adapter.lock.Lock()
+	defer adapter.lock.Unlock()
+	if adapter.overlapped {
+		t.Errorf("Same key was reconciled by two workers at once")
+	}
+	if adapter.maxInflight < 2 {
+		t.Errorf("Expected keys to be reconciled in parallel, max in flight %d", adapter.maxInflight)
+	}
+}
+
+func TestControllerDeletionPolicy(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		policy       ocicommon.DeletionPolicy
+		expectDelete bool
+		invalid      bool
+	}{
+		{name: "default", expectDelete: true},
+		{name: "spec-delete", policy: ocicommon.DeletionPolicyDelete, expectDelete: true},
+		{name: "spec-orphan", policy: ocicommon.DeletionPolicyOrphan},
+		{name: "annotation-orphan", annotations: map[string]string{ocicommon.DeletionPolicyAnnotation: "Orphan"}},
+		{
+			name:         "annotation-overrides-spec",
+			annotations:  map[string]string{ocicommon.DeletionPolicyAnnotation: "Delete"},
+			policy:       ocicommon.DeletionPolicyOrphan,
+			expectDelete: true,
+		},
+		{name: "annotation-lowercase", annotations: map[string]string{ocicommon.DeletionPolicyAnnotation: "orphan"}, invalid: true},
+		{
+			name:        "annotation-typo-overrides-spec",
+			annotations: map[string]string{ocicommon.DeletionPolicyAnnotation: "Orphaned"},
+			policy:      ocicommon.DeletionPolicyOrphan,
+			invalid:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fakeclient.NewSimpleClientset()
+			vcnClient := fakeoci.NewVcnClient()
+			vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+
+			tags := resourcescommon.OwnershipTags(corev1alpha1.VirtualNetworkKind, &metav1.ObjectMeta{Name: "vcn.test1", Namespace: fakeNs})
+			tags["team"] = "db"
+			created, err := vcnClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+				CreateVcnDetails: ocicore.CreateVcnDetails{FreeformTags: tags},
+			})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			timeNow := metav1.Now()
+			vcn := corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "vcn.test1",
+					Namespace:         fakeNs,
+					Annotations:       tc.annotations,
+					DeletionTimestamp: &timeNow,
+					Finalizers:        []string{"ocimanager"},
+				},
+				Spec: corev1alpha1.VcnSpec{
+					CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+					ResourcePolicy: ocicommon.ResourcePolicy{DeletionPolicy: tc.policy},
+				},
+				Status: corev1alpha1.VcnStatus{
+					Resource: &corev1alpha1.VcnResource{Vcn: created.Vcn},
+				},
+			}
+			if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+			stopCh := make(chan struct{})
+			workQueues := make(map[string]workqueue.RateLimitingInterface)
+			workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+			controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+			controller.Run(1, stopCh)
+
+			time.Sleep(1 * time.Second)
+			close(stopCh)
+
+			realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+			if tc.invalid {
+				if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: created.Vcn.Id}); err != nil {
+					t.Errorf("Expected OCI vcn to be kept with an unknown deletion policy, got %v", err)
+				}
+				if len(realizedVcn.ObjectMeta.Finalizers) == 0 {
+					t.Errorf("Expected the finalizer to be kept with an unknown deletion policy")
+				}
+				if synced := realizedVcn.Status.GetCondition(ocicommon.ConditionSynced); synced == nil || synced.Reason != reasonInvalidDeletionPolicy {
+					t.Errorf("Expected Synced condition with reason %s, got %#v", reasonInvalidDeletionPolicy, synced)
+				}
+				return
+			}
+			if len(realizedVcn.ObjectMeta.Finalizers) > 0 {
+				t.Errorf("Finalizers should be removed")
+			}
+
+			orphaned, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: created.Vcn.Id})
+			if tc.expectDelete && err == nil {
+				t.Errorf("Expected OCI vcn to be deleted")
+			}
+			if !tc.expectDelete {
+				if err != nil {
+					t.Errorf("Expected OCI vcn to be orphaned, got %v", err)
+				}
+				if resourcescommon.IsOwned(orphaned.FreeformTags) || orphaned.FreeformTags["team"] != "db" {
+					t.Errorf("Expected orphaned vcn to keep only the user tags, got %v", orphaned.FreeformTags)
+				}
+				if realizedVcn.GetResourceID() != *created.Vcn.Id {
+					t.Errorf("Expected orphaned vcn to keep resource id %s, got %s", *created.Vcn.Id, realizedVcn.GetResourceID())
+				}
+			}
+		})
+	}
+}
+
+func TestControllerImport(t *testing.T) {
+
+	compartmentId := "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q"
+	otherCompartmentId := "ocid1.compartment.oc1..aaaaaaaaotherieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q"
+
+	testCases := []struct {
+		name           string
+		annotation     bool
+		ocid           string
+		compartmentRef string
+		displayName    string
+		driftPolicy    ocicommon.DriftPolicy
+		expectImport   bool
+	}{
+		{name: "spec", compartmentRef: compartmentId, expectImport: true},
+		{name: "annotation", annotation: true, compartmentRef: compartmentId, expectImport: true},
+		{name: "compartment-mismatch", compartmentRef: otherCompartmentId},
+		{name: "spec-drift", compartmentRef: compartmentId, displayName: "renamed"},
+		{name: "spec-drift-report", compartmentRef: compartmentId, displayName: "renamed", driftPolicy: ocicommon.DriftPolicyReport, expectImport: true},
+		{name: "unknown-ocid", ocid: "ocid1.vcn.oc1.phx.unknown", compartmentRef: compartmentId},
+		{name: "unknown-ocid-report", ocid: "ocid1.vcn.oc1.phx.unknown", compartmentRef: compartmentId, driftPolicy: ocicommon.DriftPolicyReport},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fakeclient.NewSimpleClientset()
+			vcnClient := fakeoci.NewVcnClient()
+			vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+
+			existing, err := vcnClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+				CreateVcnDetails: ocicore.CreateVcnDetails{
+					CompartmentId: &compartmentId,
+					CidrBlock:     ocisdkcommon.String("10.0.0.0/16"),
+					DisplayName:   ocisdkcommon.String("existing"),
+					DnsLabel:      ocisdkcommon.String("existing"),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			vcn := corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "vcn.test1",
+					Namespace:  fakeNs,
+					Finalizers: []string{"ocimanager"},
+				},
+				Spec: corev1alpha1.VcnSpec{
+					CompartmentRef: tc.compartmentRef,
+					CidrBlock:      "10.0.0.0/16",
+					DisplayName:    "existing",
+					DNSLabel:       "existing",
+				},
+			}
+			if tc.displayName != "" {
+				vcn.Spec.DisplayName = tc.displayName
+			}
+			if tc.driftPolicy != "" {
+				vcn.Spec.DriftPolicy = tc.driftPolicy
+			}
+			ocid := *existing.Vcn.Id
+			if tc.ocid != "" {
+				ocid = tc.ocid
+			}
+			if tc.annotation {
+				vcn.Annotations = map[string]string{ocicommon.ImportOcidAnnotation: ocid}
+			} else {
+				vcn.Spec.ImportOcid = ocid
+			}
+			if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+			stopCh := make(chan struct{})
+			workQueues := make(map[string]workqueue.RateLimitingInterface)
+			workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+			var adapter resourcescommon.ResourceTypeAdapter = vcnAdapter
+			if tc.ocid != "" {
+				adapter = &notFoundAsDeleted{vcnAdapter}
+			}
+			controller := New(adapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+			controller.Run(1, stopCh)
+
+			time.Sleep(1 * time.Second)
+			close(stopCh)
+
+			realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			if !tc.expectImport {
+				if realizedVcn.GetResourceID() != "" {
+					t.Errorf("Expected vcn not to be imported, got resource id %s", realizedVcn.GetResourceID())
+				}
+				if cond := realizedVcn.Status.GetCondition(ocicommon.ConditionSynced); cond == nil || cond.Reason != reasonImportFailed {
+					t.Errorf("Expected import to fail, got %v", realizedVcn.Status.Conditions)
+				} else if tc.displayName != "" && !strings.Contains(cond.Message, "displayName") {
+					t.Errorf("Expected the drifted fields in the condition, got %s", cond.Message)
+				} else if tc.ocid != "" && !strings.Contains(cond.Message, "not found") {
+					t.Errorf("Expected the OCID not to be found, got %s", cond.Message)
+				}
+				if found, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: existing.Vcn.Id}); err != nil || *found.DisplayName != "existing" {
+					t.Errorf("Expected the existing vcn to be left alone, got %v %v", found.Vcn, err)
+				}
+				return
+			}
+			if realizedVcn.GetResourceID() != *existing.Vcn.Id {
+				t.Errorf("Expected imported resource id %s, got %s", *existing.Vcn.Id, realizedVcn.GetResourceID())
+			}
+			if realizedVcn.Status.Resource.DisplayName == nil || *realizedVcn.Status.Resource.DisplayName != "existing" {
+				t.Errorf("Expected status to be populated from the existing vcn, got %v", realizedVcn.Status.Resource)
+			}
+			if !realizedVcn.Status.IsConditionTrue(ocicommon.ConditionSynced) {
+				t.Errorf("Expected imported vcn to be synced, got %v", realizedVcn.Status.Conditions)
+			}
+		})
+	}
+}
+
+// notFoundAsDeleted wraps an adapter to answer the Get of a missing resource like the
+// adapters handle the NotAuthorizedOrNotFound error of the OCI SDK, without an error
+type notFoundAsDeleted struct {
+	resourcescommon.ResourceTypeAdapter
+}
+
+func (a *notFoundAsDeleted) Get(obj runtime.Object) (runtime.Object, error) {
+	found, err := a.ResourceTypeAdapter.Get(obj)
+	if err != nil && strings.Contains(err.Error(), "NotAuthorizedOrNotFound") {
+		return found, nil
+	}
+	return found, err
+}
+
+// updateCounter wraps an adapter to count the OCI updates issued by the controller
+type updateCounter struct {
+	resourcescommon.ResourceTypeAdapter
+	lock    sync.Mutex
+	updates int
+}
+
+func (a *updateCounter) Update(obj runtime.Object) (runtime.Object, error) {
+	a.lock.Lock()
+	a.updates++
+	a.lock.Unlock()
+	return a.ResourceTypeAdapter.Update(obj)
+}
+
+func TestControllerDriftPolicy(t *testing.T) {
+
+	testCases := []struct {
+		policy        ocicommon.DriftPolicy
+		expectUpdate  bool
+		expectDrifted bool
+		expectInvalid bool
+	}{
+		{policy: ocicommon.DriftPolicyEnforce, expectUpdate: true},
+		{policy: ocicommon.DriftPolicyReport, expectDrifted: true},
+		{policy: ocicommon.DriftPolicyIgnore},
+		{policy: "Reprot", expectInvalid: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			clientset := fakeclient.NewSimpleClientset()
+			vcnClient := fakeoci.NewVcnClient()
+			adapter := &updateCounter{ResourceTypeAdapter: coreresources.NewVcnAdapterBasic(clientset, vcnClient)}
+
+			// the display name was changed outside of the operator
+			existing, err := vcnClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+				CreateVcnDetails: ocicore.CreateVcnDetails{
+					CidrBlock:    ocisdkcommon.String("10.0.0.0/16"),
+					DisplayName:  ocisdkcommon.String("console-edit"),
+					DnsLabel:     ocisdkcommon.String("vcn"),
+					FreeformTags: resourcescommon.OwnershipTags(corev1alpha1.VirtualNetworkKind, &metav1.ObjectMeta{Name: "vcn.test1", Namespace: fakeNs}),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			vcn := corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "vcn.test1",
+					Namespace:   fakeNs,
+					Finalizers:  []string{"ocimanager"},
+					Annotations: map[string]string{ocicommon.DriftPolicyAnnotation: string(tc.policy)},
+				},
+				Spec: corev1alpha1.VcnSpec{
+					CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+					CidrBlock:      "10.0.0.0/16",
+					DisplayName:    "testDisplay",
+					DNSLabel:       "vcn",
+				},
+				Status: corev1alpha1.VcnStatus{
+					Resource: &corev1alpha1.VcnResource{Vcn: existing.Vcn},
+				},
+			}
+			if _, err := adapter.CreateObject(&vcn); err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+			stopCh := make(chan struct{})
+			workQueues := make(map[string]workqueue.RateLimitingInterface)
+			workQueues[adapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+			controller := New(adapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+			controller.Run(1, stopCh)
+
+			time.Sleep(1 * time.Second)
+			close(stopCh)
+
+			realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			adapter.lock.Lock()
+			updates := adapter.updates
+			adapter.lock.Unlock()
+			if tc.expectUpdate && updates == 0 {
+				t.Errorf("Expected drift to be corrected with an update")
+			}
+			if !tc.expectUpdate && updates > 0 {
+				t.Errorf("Expected no update with drift policy %s, got %d", tc.policy, updates)
+			}
+
+			synced := realizedVcn.Status.GetCondition(ocicommon.ConditionSynced)
+			if tc.expectInvalid && (synced == nil || synced.Status != ocicommon.ConditionFalse || synced.Reason != reasonInvalidDriftPolicy) {
+				t.Errorf("Expected Synced False with reason %s, got %v", reasonInvalidDriftPolicy, realizedVcn.Status.Conditions)
+			}
+			if !tc.expectInvalid && (synced == nil || synced.Status != ocicommon.ConditionTrue) {
+				t.Errorf("Expected Synced True, got %v", realizedVcn.Status.Conditions)
+			}
+
+			drifted := realizedVcn.Status.IsConditionTrue(ocicommon.ConditionDrifted)
+			if drifted != tc.expectDrifted {
+				t.Errorf("Expected Drifted condition %v, got %v", tc.expectDrifted, realizedVcn.Status.Conditions)
+			}
+			if !tc.expectDrifted {
+				if len(realizedVcn.Status.Drift) > 0 {
+					t.Errorf("Expected no drift in status, got %v", realizedVcn.Status.Drift)
+				}
+				return
+			}
+			if len(realizedVcn.Status.Drift) != 1 || realizedVcn.Status.Drift[0].Field != "displayName" {
+				t.Fatalf("Expected displayName drift in status, got %v", realizedVcn.Status.Drift)
+			}
+			if realizedVcn.Status.Drift[0].Desired != `"testDisplay"` || realizedVcn.Status.Drift[0].Actual != `"console-edit"` {
+				t.Errorf("Unexpected drift values %v", realizedVcn.Status.Drift[0])
+			}
+		})
+	}
+}
+
+func TestControllerPaused(t *testing.T) {
+	testCases := []struct {
+		name              string
+		annotations       map[string]string
+		namespacePaused   bool
+		expectPauseReason string
+	}{
+		{name: "not paused"},
+		{name: "paused object", annotations: map[string]string{ocicommon.PausedAnnotation: "true"}, expectPauseReason: reasonPaused},
+		{name: "paused namespace", namespacePaused: true, expectPauseReason: reasonNamespacePaused},
+		{name: "annotation not true", annotations: map[string]string{ocicommon.PausedAnnotation: "false"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fakeclient.NewSimpleClientset()
+			vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())
+
+			vcn := corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "vcn.test1",
+					Namespace:   fakeNs,
+					Finalizers:  []string{"ocimanager"},
+					Annotations: tc.annotations,
+				},
+				Spec: corev1alpha1.VcnSpec{
+					CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+					CidrBlock:      "10.0.0.0/16",
+					DisplayName:    "testDisplay",
+					DNSLabel:       "testDNS",
+				},
+			}
+			if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}
+			if tc.namespacePaused {
+				namespace.Annotations = map[string]string{ocicommon.PausedAnnotation: "true"}
+			}
+			kubeclient := fake.NewSimpleClientset(namespace)
+
+			stopCh := make(chan struct{})
+			kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeclient, 30*time.Second)
+			namespaces := kubeInformerFactory.Core().V1().Namespaces()
+			namespaces.Informer()
+			kubeInformerFactory.Start(stopCh)
+			kubeInformerFactory.WaitForCacheSync(stopCh)
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+			workQueues := make(map[string]workqueue.RateLimitingInterface)
+			workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+			controller := New(vcnAdapter, kubeclient, informerFactory, namespaces, workQueues)
+			controller.Run(1, stopCh)
+
+			time.Sleep(1 * time.Second)
+			close(stopCh)
+
+			realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			condition := realizedVcn.Status.GetCondition(ocicommon.ConditionPaused)
+			if tc.expectPauseReason == "" {
+				if condition != nil {
+					t.Errorf("Expected no Paused condition, got %v", condition)
+				}
+				if realizedVcn.GetResourceID() == "" {
+					t.Errorf("Expected vcn to be created")
+				}
+				return
+			}
+			if realizedVcn.GetResourceID() != "" {
+				t.Errorf("Expected paused vcn not to be created, got resource id %s", realizedVcn.GetResourceID())
+			}
+			if condition == nil || condition.Status != ocicommon.ConditionTrue || condition.Reason != tc.expectPauseReason {
+				t.Errorf("Expected Paused condition with reason %s, got %v", tc.expectPauseReason, condition)
+			}
+		})
+	}
+}
+
+func TestControllerDryRun(t *testing.T) {
+	resourcescommon.SetDryRun(true)
+	defer resourcescommon.SetDryRun(false)
+
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := fakeoci.NewVcnClient()
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, resourcescommon.InstrumentVcnClient(vcnClient))
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vcn.test1",
+			Namespace:  fakeNs,
+			Finalizers: []string{"ocimanager"},
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			CidrBlock:      "10.0.0.0/16",
+			DisplayName:    "testDisplay",
+			DNSLabel:       "testDNS",
+		},
+	}
+	if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
+
+	time.Sleep(1 * time.Second)
+	close(stopCh)
+
+	realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if realizedVcn.GetResourceID() != "" {
+		t.Errorf("Expected no vcn to be created in dry-run mode, got resource id %s", realizedVcn.GetResourceID())
+	}
+	plan := realizedVcn.Status.Plan
+	if plan == nil || plan.Operation != "CreateVcn" || !strings.Contains(plan.Request, "testDisplay") {
+		t.Errorf("Expected a CreateVcn plan in the status, got %#v", plan)
+	}
+	if condition := realizedVcn.Status.GetCondition(ocicommon.ConditionSynced); condition == nil || condition.Reason != reasonDryRun {
+		t.Errorf("Expected Synced condition with reason %s, got %v", reasonDryRun, condition)
+	}
+}
+
+// failingVcnClient fails CreateVcn until fail is cleared
+type failingVcnClient struct {
+	*fakeoci.VcnClient
+	lock  sync.Mutex
+	fail  bool
+	calls int
+}
+
+func (c *failingVcnClient) CreateVcn(ctx context.Context, request ocicore.CreateVcnRequest) (ocicore.CreateVcnResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls++
+	if c.fail {
+		return ocicore.CreateVcnResponse{}, errors.New("vcn limit exceeded")
+	}
+	return c.VcnClient.CreateVcn(ctx, request)
+}
+
+func (c *failingVcnClient) setFail(fail bool) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.fail = fail
+	return c.calls
+}
+
+func TestControllerStalled(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := &failingVcnClient{VcnClient: fakeoci.NewVcnClient(), fail: true}
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vcn.test1",
+			Namespace:  fakeNs,
+			Finalizers: []string{"ocimanager"},
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			CidrBlock:      "10.0.0.0/16",
+			DisplayName:    "testDisplay",
+		},
+	}
+	if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	policy := resourcescommon.DefaultRetryPolicy().Merge(resourcescommon.RetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  metav1.Duration{Duration: 10 * time.Millisecond},
+		MaxDelay:   metav1.Duration{Duration: 50 * time.Millisecond},
+	})
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(policy.RateLimiter())
+
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.setRetryPolicy(policy)
+	controller.Run(1, stopCh)
+
+	time.Sleep(1 * time.Second)
+
+	stalled, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	condition := stalled.Status.GetCondition(ocicommon.ConditionStalled)
+	if condition == nil || condition.Status != ocicommon.ConditionTrue || condition.Reason != reasonRetriesExhausted || !strings.Contains(condition.Message, "vcn limit exceeded") {
+		t.Fatalf("Expected Stalled condition with the last error, got %#v", condition)
+	}
+
+	// a stalled object is left alone, even after the stall was reported
+	calls := vcnClient.setFail(false)
+	if calls != policy.MaxRetries+1 {
+		t.Errorf("Expected %d create attempts, got %d", policy.MaxRetries+1, calls)
+	}
+	time.Sleep(500 * time.Millisecond)
+	if retried := vcnClient.setFail(false); retried != calls {
+		t.Errorf("Expected no create attempts while stalled, got %d", retried-calls)
+	}
+
+	// bumping the retry annotation forces another attempt
+	stalled.Annotations = map[string]string{ocicommon.RetryAnnotation: "1"}
+	if _, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Update(stalled); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	time.Sleep(1 * time.Second)
+
+	realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if realizedVcn.GetResourceID() == "" {
+		t.Errorf("Expected vcn to be created after the forced retry")
+	}
+	if condition := realizedVcn.Status.GetCondition(ocicommon.ConditionStalled); condition != nil {
+		t.Errorf("Expected Stalled condition to be cleared, got %#v", condition)
+	}
+}
+
+// throttledVcnClient fails the first CreateVcn calls as throttled by OCI
+type throttledVcnClient struct {
+	*fakeoci.VcnClient
+	lock      sync.Mutex
+	throttles int
+	calls     int
+}
+
+func (c *throttledVcnClient) CreateVcn(ctx context.Context, request ocicore.CreateVcnRequest) (ocicore.CreateVcnResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.calls++
+	if c.calls <= c.throttles {
+		return ocicore.CreateVcnResponse{}, &resourcescommon.ThrottledError{
+			Call:       resourcescommon.OciCall{Service: "vcn", Operation: "CreateVcn"},
+			RetryAfter: 20 * time.Millisecond,
+			Err:        errors.New("too many requests"),
+		}
+	}
+	return c.VcnClient.CreateVcn(ctx, request)
+}
+
+func TestControllerThrottled(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := &throttledVcnClient{VcnClient: fakeoci.NewVcnClient(), throttles: 5}
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vcn.test1",
+			Namespace:  fakeNs,
+			Finalizers: []string{"ocimanager"},
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			CidrBlock:      "10.0.0.0/16",
+			DisplayName:    "testDisplay",
+		},
+	}
+	if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	// throttled calls do not count towards the retries
+	policy := resourcescommon.DefaultRetryPolicy().Merge(resourcescommon.RetryPolicy{
+		MaxRetries: 2,
+		BaseDelay:  metav1.Duration{Duration: time.Second},
+	})
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(policy.RateLimiter())
+
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.setRetryPolicy(policy)
+	controller.Run(1, stopCh)
+
+	time.Sleep(1 * time.Second)
+
+	realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if realizedVcn.GetResourceID() == "" {
+		t.Errorf("Expected vcn to be created once OCI stopped throttling")
+	}
+	if realizedVcn.Status.State == ocicommon.ResourceStateError {
+		t.Errorf("Expected throttling not to set the error state, got %q", realizedVcn.Status.Message)
+	}
+	if condition := realizedVcn.Status.GetCondition(ocicommon.ConditionStalled); condition != nil {
+		t.Errorf("Expected throttled vcn not to stall, got %#v", condition)
+	}
+	vcnClient.lock.Lock()
+	defer vcnClient.lock.Unlock()
+	if vcnClient.calls != vcnClient.throttles+1 {
+		t.Errorf("Expected %d create attempts, got %d", vcnClient.throttles+1, vcnClient.calls)
+	}
+}
+
+func TestControllerStatusSubresource(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset()
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vcn.test1",
+			Namespace: fakeNs,
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			CidrBlock:      "10.0.0.0/16",
+			DisplayName:    "testDisplay",
+		},
+	}
+	if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
+
+	time.Sleep(1 * time.Second)
+
+	realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if realizedVcn.GetResourceID() == "" || len(realizedVcn.Finalizers) == 0 {
+		t.Fatalf("Expected vcn to be created with a finalizer, got %#v", realizedVcn)
+	}
+
+	// only the finalizer is written with the object, the status goes through the status subresource
+	var objectUpdates, statusUpdates int
+	for _, action := range clientset.Actions() {
+		if !action.Matches("update", "vcns") {
+			continue
+		}
+		if action.GetSubresource() == "status" {
+			statusUpdates++
+		} else {
+			objectUpdates++
+		}
+	}
+	if objectUpdates != 1 {
+		t.Errorf("Expected the object to be updated once for the finalizer, got %d updates", objectUpdates)
+	}
+	if statusUpdates == 0 {
+		t.Errorf("Expected the status to be updated through the status subresource")
+	}
+}
+
+// blockingAdapter holds creates until released
+type blockingAdapter struct {
+	resourcescommon.ResourceTypeAdapter
+	started chan struct{}
+	release chan struct{}
+}
+
+func (a *blockingAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	a.started <- struct{}{}
+	<-a.release
+	return a.ResourceTypeAdapter.Create(obj)
+}
+
+func TestControllerDrain(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset()
+	adapter := &blockingAdapter{
+		ResourceTypeAdapter: coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient()),
+		started:             make(chan struct{}, 2),
+		release:             make(chan struct{}),
+	}
+
+	names := []string{"vcn.test1", "vcn.test2"}
+	for _, name := range names {
+		vcn := corev1alpha1.Vcn{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  fakeNs,
+				Finalizers: []string{"ocimanager"},
+			},
+			Spec: corev1alpha1.VcnSpec{
+				CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			},
+		}
+		if _, err := adapter.CreateObject(&vcn); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[adapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	controller := New(adapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
+
+	select {
+	case <-adapter.started:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for a create")
+	}
+	close(stopCh)
+
+	if controller.Drain(100 * time.Millisecond) {
+		t.Errorf("Expected drain to time out while the create is in flight")
+	}
+	close(adapter.release)
+	if !controller.Drain(5 * time.Second) {
+		t.Fatalf("Expected the create in flight to finish")
+	}
+
+	created := 0
+	for _, name := range names {
+		realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get(name, metav1.GetOptions{})
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		} else if realizedVcn.GetResourceID() != "" {
+			created++
+		}
+	}
+	if created != 1 {
+		t.Errorf("Expected only the vcn in flight to be created and written, got %d", created)
+	}
+	if len(adapter.started) != 0 {
+		t.Errorf("Expected no reconcile to start after the controller was stopped")
+	}
+}
+
+// failOnceAdapter fails the first create
+type failOnceAdapter struct {
+	resourcescommon.ResourceTypeAdapter
+	lock   sync.Mutex
+	failed bool
+}
+
+func (a *failOnceAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if !a.failed {
+		a.failed = true
+		return obj, errors.New("InternalError")
+	}
+	return a.ResourceTypeAdapter.Create(obj)
+}
+
+func TestControllerCreateFailedCondition(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset()
+	adapter := &failOnceAdapter{ResourceTypeAdapter: coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())}
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vcn.test1",
+			Namespace:  fakeNs,
+			Finalizers: []string{"ocimanager"},
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+		},
+	}
+	if _, err := adapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	workQueues := make(map[string]workqueue.RateLimitingInterface)
+	workQueues[adapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	controller := New(adapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	controller.Run(1, stopCh)
+
+	time.Sleep(1 * time.Second)
+
+	// the failure is written before the retry creates the vcn
+	var failed *ocicommon.ResourceCondition
+	for _, action := range clientset.Actions() {
+		if update, ok := action.(clienttesting.UpdateAction); ok && action.GetSubresource() == "status" {
+			if written, ok := update.GetObject().(*corev1alpha1.Vcn); ok {
+				if synced := written.Status.GetCondition(ocicommon.ConditionSynced); synced != nil && synced.Status == ocicommon.ConditionFalse {
+					failed = synced
+					break
+				}
+			}
+		}
+	}
+	if failed == nil || failed.Reason != reasonCreateFailed || !strings.Contains(failed.Message, "InternalError") {
+		t.Errorf("Expected Synced=False/%s to be written, got %#v", reasonCreateFailed, failed)
+	}
+
+	realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if realizedVcn.GetResourceID() == "" || !realizedVcn.Status.IsConditionTrue(ocicommon.ConditionSynced) {
+		t.Errorf("Expected the retry to create the vcn, got %#v", realizedVcn.Status)
+	}
+}
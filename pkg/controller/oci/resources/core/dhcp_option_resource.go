@@ -49,6 +49,7 @@ func init() {
 		ocicorev1alpha1.DhcpOptionResourcePlural,
 		ocicorev1alpha1.DhcpOptionControllerName,
 		&ocicorev1alpha1.DhcpOptionValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewDhcpOptionAdapter)
 }
 
@@ -71,13 +72,20 @@ func NewDhcpOptionAdapter(clientset versioned.Interface, kubeclient kubernetes.I
 		os.Exit(1)
 	}
 
-	iga.vcnClient = &vcnClient
+	iga.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	iga.clientset = clientset
 	iga.ctx = context.Background()
 
 	return &iga
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *DhcpOptionAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *DhcpOptionAdapter) Kind() string {
 	return ocicorev1alpha1.DhcpOptionKind
@@ -124,31 +132,35 @@ func (a *DhcpOptionAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *DhcpOptionAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *DhcpOptionAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	do := obj.(*ocicorev1alpha1.DhcpOption)
 
 	if do.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := do.Status.Resource
 
 	if resource.LifecycleState == ocicore.DhcpOptionsLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.DhcpOptionsLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.DhcpOptionsLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specName := resourcescommon.Display(do.Name, do.Spec.DisplayName)
 
-	if do.Status.Resource.DisplayName != specName {
-		return false
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specName, do.Status.Resource.DisplayName)
+	if !reflect.DeepEqual(do.Spec.Options, do.Status.Resource.Options) {
+		drift.Add("options", do.Spec.Options, do.Status.Resource.Options)
 	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), do, do.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return reflect.DeepEqual(do.Spec.Options, do.Status.Resource.Options)
+	return drift.Compliant(), drift
 
 }
 
@@ -175,11 +187,6 @@ func (a *DhcpOptionAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.D
 	return obj.(*ocicorev1alpha1.DhcpOption).Spec.DependsOn
 }
 
-// Dependents returns a map of dhcp options dependents (objects that depend on the dhcp options)
-func (a *DhcpOptionAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.DhcpOption).Status.Dependents
-}
-
 // CreateObject creates the dhcp options object
 func (a *DhcpOptionAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.DhcpOption)
@@ -192,6 +199,12 @@ func (a *DhcpOptionAdapter) UpdateObject(obj runtime.Object) (runtime.Object, er
 	return a.clientset.OcicoreV1alpha1().DhcpOptions(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the dhcp options object
+func (a *DhcpOptionAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.DhcpOption)
+	return a.clientset.OcicoreV1alpha1().DhcpOptions(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the dhcp options object
 func (a *DhcpOptionAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.DhcpOption)
@@ -254,6 +267,9 @@ func (a *DhcpOptionAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.VcnId = ocisdkcommon.String(vcnId)
 	request.DisplayName = resourcescommon.Display(do.Name, do.Spec.DisplayName)
 	request.Options = do.Spec.Options
+	tags := resourcescommon.OwnedTags(a.Kind(), do, do.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(do.UID))
 
@@ -313,9 +329,14 @@ func (a *DhcpOptionAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 func (a *DhcpOptionAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.DhcpOption)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	details := ocicore.UpdateDhcpDetails{
-		DisplayName: resourcescommon.Display(object.Name, object.Spec.DisplayName),
-		Options:     object.Spec.Options,
+		DisplayName:  resourcescommon.Display(object.Name, object.Spec.DisplayName),
+		Options:      object.Spec.Options,
+		FreeformTags: tags.FreeformTags,
+		DefinedTags:  tags.DefinedTags,
 	}
 
 	request := ocicore.UpdateDhcpOptionsRequest{
@@ -340,3 +361,54 @@ func (a *DhcpOptionAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *DhcpOptionAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the dhcp options in the vcns of the compartment tagged as managed by oci-manager
+func (a *DhcpOptionAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	vcnIds, err := resourcescommon.VcnIds(a.ctx, a.vcnClient, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var owned []resourcescommon.OwnedResource
+	for _, vcnId := range vcnIds {
+		request := ocicore.ListDhcpOptionsRequest{CompartmentId: &compartmentId, VcnId: ocisdkcommon.String(vcnId)}
+		for {
+			r, err := a.vcnClient.ListDhcpOptions(a.ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range r.Items {
+				if !resourcescommon.IsOwned(item.FreeformTags) ||
+					item.LifecycleState == ocicore.DhcpOptionsLifecycleStateTerminating || item.LifecycleState == ocicore.DhcpOptionsLifecycleStateTerminated {
+					continue
+				}
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = []string{vcnId}
+				owned = append(owned, resource)
+			}
+			if r.OpcNextPage == nil {
+				break
+			}
+			request.Page = r.OpcNextPage
+		}
+	}
+	return owned, nil
+}
+
+// DeleteOwned deletes the dhcp options with the id
+func (a *DhcpOptionAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteDhcpOptions(a.ctx, ocicore.DeleteDhcpOptionsRequest{DhcpId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the dhcp options with the id
+func (a *DhcpOptionAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetDhcpOptions(a.ctx, ocicore.GetDhcpOptionsRequest{DhcpId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateDhcpOptions(a.ctx, ocicore.UpdateDhcpOptionsRequest{
+		DhcpId:            &id,
+		UpdateDhcpDetails: ocicore.UpdateDhcpDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
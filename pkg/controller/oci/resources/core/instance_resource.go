@@ -48,6 +48,7 @@ func init() {
 		ocicorev1alpha1.InstanceResourcePlural,
 		ocicorev1alpha1.InstanceControllerName,
 		&ocicorev1alpha1.InstanceValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.AvailabilityDomainColumn, ocicommon.IPColumn(".status.primaryVnic.publicIp"), ocicommon.OcidColumn),
 		NewInstanceAdapter)
 }
 
@@ -86,14 +87,21 @@ func NewInstanceAdapter(clientset versioned.Interface, kubeclient kubernetes.Int
 		os.Exit(1)
 	}
 
-	ia.cClient = &cClient
-	ia.vcnClient = &vcnClient
-	ia.bsClient = &bsClient
+	ia.cClient = resourcescommon.InstrumentComputeClient(&cClient)
+	ia.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
+	ia.bsClient = resourcescommon.InstrumentBlockStorageClient(&bsClient)
 	ia.clientset = clientset
 	ia.ctx = context.Background()
 	return &ia
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *InstanceAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *InstanceAdapter) Kind() string {
 	return ocicorev1alpha1.InstanceKind
@@ -153,11 +161,11 @@ func (a *InstanceAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *InstanceAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *InstanceAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	instance := obj.(*ocicorev1alpha1.Instance)
 
 	if instance.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := instance.Status.Resource
@@ -165,30 +173,31 @@ func (a *InstanceAdapter) IsResourceCompliant(obj runtime.Object) bool {
 	if resource.LifecycleState == ocicore.InstanceLifecycleStateStopped ||
 		resource.LifecycleState == ocicore.InstanceLifecycleStateTerminating ||
 		resource.LifecycleState == ocicore.InstanceLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(instance.Name, instance.Spec.DisplayName)
 
-	if *resource.DisplayName != *specDisplayName ||
-		*resource.AvailabilityDomain != instance.Spec.AvailabilityDomain ||
-		*resource.Shape != instance.Spec.Shape {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, resource.DisplayName)
+	drift.Check("availabilityDomain", instance.Spec.AvailabilityDomain, resource.AvailabilityDomain)
+	drift.Check("shape", instance.Spec.Shape, resource.Shape)
 
-	if instance.Spec.IpxeScript != "" && instance.Spec.IpxeScript != *resource.IpxeScript {
-		return false
+	if instance.Spec.IpxeScript != "" {
+		drift.Check("ipxeScript", instance.Spec.IpxeScript, resource.IpxeScript)
 	}
 
-	if instance.Spec.Metadata != nil && !reflect.DeepEqual(instance.Spec.Metadata, resource.Metadata) {
-		return false
+	if instance.Spec.Metadata != nil {
+		drift.Check("metadata", instance.Spec.Metadata, resource.Metadata)
 	}
 
-	if instance.Spec.ExtendedMetadata != nil && !reflect.DeepEqual(instance.Spec.ExtendedMetadata, resource.ExtendedMetadata) {
-		return false
+	if instance.Spec.ExtendedMetadata != nil {
+		drift.Check("extendedMetadata", instance.Spec.ExtendedMetadata, resource.ExtendedMetadata)
 	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), instance, instance.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -224,11 +233,6 @@ func (a *InstanceAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Dep
 	return obj.(*ocicorev1alpha1.Instance).Spec.DependsOn
 }
 
-// Dependents returns a map of instance dependents (objects that depend on the instance)
-func (a *InstanceAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.Instance).Status.Dependents
-}
-
 // CreateObject creates the instance object
 func (a *InstanceAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.Instance)
@@ -241,6 +245,12 @@ func (a *InstanceAdapter) UpdateObject(obj runtime.Object) (runtime.Object, erro
 	return a.clientset.OcicoreV1alpha1().Instances(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the instance object
+func (a *InstanceAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.Instance)
+	return a.clientset.OcicoreV1alpha1().Instances(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the instance object
 func (a *InstanceAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.Instance)
@@ -388,6 +398,9 @@ func (a *InstanceAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.IpxeScript = resourcescommon.StrPtrOrNil(instance.Spec.IpxeScript)
 	request.Metadata = instance.Spec.Metadata
 	request.ExtendedMetadata = instance.Spec.ExtendedMetadata
+	tags := resourcescommon.OwnedTags(a.Kind(), instance, instance.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 	request.OpcRetryToken = opcRetryToken
 
 	r, err := a.cClient.LaunchInstance(a.ctx, request)
@@ -513,7 +526,16 @@ func (a *InstanceAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	}
 
-	r, e := a.cClient.UpdateInstance(a.ctx, ocicore.UpdateInstanceRequest{InstanceId: object.Status.Resource.Id})
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
+	r, e := a.cClient.UpdateInstance(a.ctx, ocicore.UpdateInstanceRequest{
+		InstanceId: object.Status.Resource.Id,
+		UpdateInstanceDetails: ocicore.UpdateInstanceDetails{
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
+	})
 
 	if e != nil {
 		return object, object.Status.HandleError(e)
@@ -528,3 +550,44 @@ func (a *InstanceAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *InstanceAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the instances in the compartment tagged as managed by oci-manager
+func (a *InstanceAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocicore.ListInstancesRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.cClient.ListInstances(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocicore.InstanceLifecycleStateTerminating && item.LifecycleState != ocicore.InstanceLifecycleStateTerminated {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the instance with the id
+func (a *InstanceAdapter) DeleteOwned(id string) error {
+	_, err := a.cClient.TerminateInstance(a.ctx, ocicore.TerminateInstanceRequest{InstanceId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the instance with the id
+func (a *InstanceAdapter) ReleaseOwned(id string) error {
+	r, err := a.cClient.GetInstance(a.ctx, ocicore.GetInstanceRequest{InstanceId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.cClient.UpdateInstance(a.ctx, ocicore.UpdateInstanceRequest{
+		InstanceId:            &id,
+		UpdateInstanceDetails: ocicore.UpdateInstanceDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
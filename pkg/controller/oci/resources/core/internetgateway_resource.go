@@ -49,6 +49,7 @@ func init() {
 		ocicorev1alpha1.InternetGatewayResourcePlural,
 		ocicorev1alpha1.InternetGatewayControllerName,
 		&ocicorev1alpha1.InternetGatewayValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewInternetGatewayAdapter)
 }
 
@@ -71,13 +72,20 @@ func NewInternetGatewayAdapter(clientset versioned.Interface, kubeclient kuberne
 		os.Exit(1)
 	}
 
-	iga.vcnClient = &vcnClient
+	iga.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	iga.clientset = clientset
 	iga.ctx = context.Background()
 
 	return &iga
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *InternetGatewayAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *InternetGatewayAdapter) Kind() string {
 	return ocicorev1alpha1.InternetGatewayKind
@@ -124,31 +132,32 @@ func (a *InternetGatewayAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *InternetGatewayAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *InternetGatewayAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	ig := obj.(*ocicorev1alpha1.InternetGateway)
 
 	if ig.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := ig.Status.Resource
 	if resource.LifecycleState == ocicore.InternetGatewayLifecycleStateTerminating ||
 		resource.LifecycleState == ocicore.InternetGatewayLifecycleStateProvisioning {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.InternetGatewayLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(ig.Name, ig.Spec.DisplayName)
 
-	if *ig.Status.Resource.DisplayName != *specDisplayName ||
-		*ig.Status.Resource.IsEnabled != ig.Spec.IsEnabled {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, ig.Status.Resource.DisplayName)
+	drift.Check("isEnabled", ig.Spec.IsEnabled, ig.Status.Resource.IsEnabled)
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), ig, ig.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 
 }
 
@@ -175,11 +184,6 @@ func (a *InternetGatewayAdapter) DependsOn(obj runtime.Object) map[string]ocicom
 	return obj.(*ocicorev1alpha1.InternetGateway).Spec.DependsOn
 }
 
-// Dependents returns a map of internet gateway dependents (objects that depend on the internet gateway)
-func (a *InternetGatewayAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.InternetGateway).Status.Dependents
-}
-
 // CreateObject creates the internet gateway object
 func (a *InternetGatewayAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.InternetGateway)
@@ -192,6 +196,12 @@ func (a *InternetGatewayAdapter) UpdateObject(obj runtime.Object) (runtime.Objec
 	return a.clientset.OcicoreV1alpha1().InternetGatewaies(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the internet gateway object
+func (a *InternetGatewayAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.InternetGateway)
+	return a.clientset.OcicoreV1alpha1().InternetGatewaies(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the internet gateway object
 func (a *InternetGatewayAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.InternetGateway)
@@ -253,6 +263,9 @@ func (a *InternetGatewayAdapter) Create(obj runtime.Object) (runtime.Object, err
 	request.VcnId = ocisdkcommon.String(virtualnetworkId)
 	request.DisplayName = resourcescommon.Display(ig.Name, ig.Spec.DisplayName)
 	request.IsEnabled = ocisdkcommon.Bool(ig.Spec.IsEnabled)
+	tags := resourcescommon.OwnedTags(a.Kind(), ig, ig.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(ig.UID))
 	glog.Infof("InternetGateway: %s OpcRetryToken: %s", ig.Name, string(ig.UID))
@@ -308,8 +321,15 @@ func (a *InternetGatewayAdapter) Get(obj runtime.Object) (runtime.Object, error)
 func (a *InternetGatewayAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.InternetGateway)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	request := ocicore.UpdateInternetGatewayRequest{
 		IgId: object.Status.Resource.Id,
+		UpdateInternetGatewayDetails: ocicore.UpdateInternetGatewayDetails{
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
 	}
 
 	if object.Status.Resource.LifecycleState != ocicore.InternetGatewayLifecycleStateAvailable {
@@ -329,3 +349,54 @@ func (a *InternetGatewayAdapter) Update(obj runtime.Object) (runtime.Object, err
 func (a *InternetGatewayAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the internet gateways in the vcns of the compartment tagged as managed by oci-manager
+func (a *InternetGatewayAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	vcnIds, err := resourcescommon.VcnIds(a.ctx, a.vcnClient, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var owned []resourcescommon.OwnedResource
+	for _, vcnId := range vcnIds {
+		request := ocicore.ListInternetGatewaysRequest{CompartmentId: &compartmentId, VcnId: ocisdkcommon.String(vcnId)}
+		for {
+			r, err := a.vcnClient.ListInternetGateways(a.ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range r.Items {
+				if !resourcescommon.IsOwned(item.FreeformTags) ||
+					item.LifecycleState == ocicore.InternetGatewayLifecycleStateTerminating || item.LifecycleState == ocicore.InternetGatewayLifecycleStateTerminated {
+					continue
+				}
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = []string{vcnId}
+				owned = append(owned, resource)
+			}
+			if r.OpcNextPage == nil {
+				break
+			}
+			request.Page = r.OpcNextPage
+		}
+	}
+	return owned, nil
+}
+
+// DeleteOwned deletes the internet gateway with the id
+func (a *InternetGatewayAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteInternetGateway(a.ctx, ocicore.DeleteInternetGatewayRequest{IgId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the internet gateway with the id
+func (a *InternetGatewayAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetInternetGateway(a.ctx, ocicore.GetInternetGatewayRequest{IgId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateInternetGateway(a.ctx, ocicore.UpdateInternetGatewayRequest{
+		IgId:                         &id,
+		UpdateInternetGatewayDetails: ocicore.UpdateInternetGatewayDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
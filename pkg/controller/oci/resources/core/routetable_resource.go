@@ -46,6 +46,7 @@ func init() {
 		ocicorev1alpha1.RouteTableResourcePlural,
 		ocicorev1alpha1.RouteTableControllerName,
 		&ocicorev1alpha1.RouteTableValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewRouteTableAdapter)
 }
 
@@ -67,13 +68,20 @@ func NewRouteTableAdapter(clientset versioned.Interface, kubeclient kubernetes.I
 		os.Exit(1)
 	}
 
-	rta.vcnClient = &vcnClient
+	rta.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	rta.clientset = clientset
 	rta.ctx = context.Background()
 
 	return &rta
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *RouteTableAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *RouteTableAdapter) Kind() string {
 	return ocicorev1alpha1.RouteTableKind
@@ -120,30 +128,29 @@ func (a *RouteTableAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *RouteTableAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *RouteTableAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 
 	routetable := obj.(*ocicorev1alpha1.RouteTable)
 
 	if routetable.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := routetable.Status.Resource
 
 	if resource.LifecycleState == ocicore.RouteTableLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.RouteTableLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.RouteTableLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(routetable.Name, routetable.Spec.DisplayName)
 
-	if *routetable.Status.Resource.DisplayName != *specDisplayName {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, routetable.Status.Resource.DisplayName)
 
 	specCidrBlocks := make(map[string]bool)
 	resourceCidrBlocks := make(map[string]bool)
@@ -156,7 +163,13 @@ func (a *RouteTableAdapter) IsResourceCompliant(obj runtime.Object) bool {
 		resourceCidrBlocks[*routeRule.CidrBlock] = true
 	}
 
-	return reflect.DeepEqual(specCidrBlocks, resourceCidrBlocks)
+	if !reflect.DeepEqual(specCidrBlocks, resourceCidrBlocks) {
+		drift.Add("routeRules", routetable.Spec.RouteRules, routetable.Status.Resource.RouteRules)
+	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), routetable, routetable.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+
+	return drift.Compliant(), drift
 
 }
 
@@ -183,11 +196,6 @@ func (a *RouteTableAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.D
 	return obj.(*ocicorev1alpha1.RouteTable).Spec.DependsOn
 }
 
-// Dependents returns a map of route table dependents (objects that depend on the route table)
-func (a *RouteTableAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.RouteTable).Status.Dependents
-}
-
 // CreateObject creates the route table object
 func (a *RouteTableAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.RouteTable)
@@ -200,6 +208,12 @@ func (a *RouteTableAdapter) UpdateObject(obj runtime.Object) (runtime.Object, er
 	return a.clientset.OcicoreV1alpha1().RouteTables(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the route table object
+func (a *RouteTableAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.RouteTable)
+	return a.clientset.OcicoreV1alpha1().RouteTables(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the route table object
 func (a *RouteTableAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.RouteTable)
@@ -291,6 +305,9 @@ func (a *RouteTableAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.VcnId = ocisdkcommon.String(virtualnetworkId)
 	request.RouteRules = routeRuleList
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(object.UID))
 	glog.Infof("RouteTable: %s OpcRetryToken: %s", object.Name, string(object.UID))
@@ -340,8 +357,15 @@ func (a *RouteTableAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 func (a *RouteTableAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.RouteTable)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	request := ocicore.UpdateRouteTableRequest{
 		RtId: object.Status.Resource.Id,
+		UpdateRouteTableDetails: ocicore.UpdateRouteTableDetails{
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
 	}
 
 	r, e := a.vcnClient.UpdateRouteTable(a.ctx, request)
@@ -357,3 +381,59 @@ func (a *RouteTableAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *RouteTableAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the route tables in the vcns of the compartment tagged as managed by oci-manager
+func (a *RouteTableAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	vcnIds, err := resourcescommon.VcnIds(a.ctx, a.vcnClient, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var owned []resourcescommon.OwnedResource
+	for _, vcnId := range vcnIds {
+		request := ocicore.ListRouteTablesRequest{CompartmentId: &compartmentId, VcnId: ocisdkcommon.String(vcnId)}
+		for {
+			r, err := a.vcnClient.ListRouteTables(a.ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range r.Items {
+				if !resourcescommon.IsOwned(item.FreeformTags) ||
+					item.LifecycleState == ocicore.RouteTableLifecycleStateTerminating || item.LifecycleState == ocicore.RouteTableLifecycleStateTerminated {
+					continue
+				}
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = []string{vcnId}
+				for _, rule := range item.RouteRules {
+					if rule.NetworkEntityId != nil {
+						resource.Parents = append(resource.Parents, *rule.NetworkEntityId)
+					}
+				}
+				owned = append(owned, resource)
+			}
+			if r.OpcNextPage == nil {
+				break
+			}
+			request.Page = r.OpcNextPage
+		}
+	}
+	return owned, nil
+}
+
+// DeleteOwned deletes the route table with the id
+func (a *RouteTableAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteRouteTable(a.ctx, ocicore.DeleteRouteTableRequest{RtId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the route table with the id
+func (a *RouteTableAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetRouteTable(a.ctx, ocicore.GetRouteTableRequest{RtId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateRouteTable(a.ctx, ocicore.UpdateRouteTableRequest{
+		RtId:                    &id,
+		UpdateRouteTableDetails: ocicore.UpdateRouteTableDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -49,6 +49,7 @@ func init() {
 		ocicorev1alpha1.SecurityRuleSetResourcePlural,
 		ocicorev1alpha1.SecurityRuleSetControllerName,
 		&ocicorev1alpha1.SecurityRuleSetValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewSecurityRuleSetAdapter)
 }
 
@@ -71,12 +72,19 @@ func NewSecurityRuleSetAdapter(clientset versioned.Interface, kubeclient kuberne
 		os.Exit(1)
 	}
 
-	sla.vcnClient = &vcnClient
+	sla.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	sla.clientset = clientset
 	sla.ctx = context.Background()
 	return &sla
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *SecurityRuleSetAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *SecurityRuleSetAdapter) Kind() string {
 	return ocicorev1alpha1.SecurityRuleSetKind
@@ -161,26 +169,29 @@ func (a *SecurityRuleSetAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *SecurityRuleSetAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *SecurityRuleSetAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 
 	securityruleset := obj.(*ocicorev1alpha1.SecurityRuleSet)
 
 	if securityruleset.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := securityruleset.Status.Resource
 
 	if resource.LifecycleState == ocicore.SecurityListLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.SecurityListLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.SecurityListLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
-	if len(securityruleset.Spec.EgressSecurityRules) == len(securityruleset.Status.Resource.EgressSecurityRules) {
+	var drift resourcescommon.Drift
+
+	egressCompliant := len(securityruleset.Spec.EgressSecurityRules) == len(securityruleset.Status.Resource.EgressSecurityRules)
+	if egressCompliant {
 		for i, specRule := range securityruleset.Spec.EgressSecurityRules {
 			statusRule := securityruleset.Status.Resource.EgressSecurityRules[i]
 			statusRule.DestinationType = ""
@@ -190,15 +201,19 @@ func (a *SecurityRuleSetAdapter) IsResourceCompliant(obj runtime.Object) bool {
 			}
 			if !reflect.DeepEqual(specRule, statusRule) {
 				glog.V(5).Infof("securityruleset incomplient due to egress !reflect.DeepEqual(%v, %v)", specRule, statusRule)
-				return false
+				egressCompliant = false
+				break
 			}
 		}
 	} else {
 		glog.V(5).Infof("securityruleset incomplient due to egress len")
-		return false
+	}
+	if !egressCompliant {
+		drift.Add("egressSecurityRules", securityruleset.Spec.EgressSecurityRules, securityruleset.Status.Resource.EgressSecurityRules)
 	}
 
-	if len(securityruleset.Spec.IngressSecurityRules) == len(securityruleset.Status.Resource.IngressSecurityRules) {
+	ingressCompliant := len(securityruleset.Spec.IngressSecurityRules) == len(securityruleset.Status.Resource.IngressSecurityRules)
+	if ingressCompliant {
 		for i, specRule := range securityruleset.Spec.IngressSecurityRules {
 			statusRule := securityruleset.Status.Resource.IngressSecurityRules[i]
 			statusRule.SourceType = ""
@@ -208,14 +223,20 @@ func (a *SecurityRuleSetAdapter) IsResourceCompliant(obj runtime.Object) bool {
 			}
 			if !reflect.DeepEqual(specRule, statusRule) {
 				glog.Infof("securityruleset incomplient due to ingress !reflect.DeepEqual(%v, %v)", specRule, statusRule)
-				return false
+				ingressCompliant = false
+				break
 			}
 		}
 	} else {
 		glog.Infof("securityruleset incomplient due to ingress len")
-		return false
 	}
-	return true
+	if !ingressCompliant {
+		drift.Add("ingressSecurityRules", securityruleset.Spec.IngressSecurityRules, securityruleset.Status.Resource.IngressSecurityRules)
+	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), securityruleset, securityruleset.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+
+	return drift.Compliant(), drift
 
 }
 
@@ -242,11 +263,6 @@ func (a *SecurityRuleSetAdapter) DependsOn(obj runtime.Object) map[string]ocicom
 	return obj.(*ocicorev1alpha1.SecurityRuleSet).Spec.DependsOn
 }
 
-// Dependents returns a map of security rule set dependents (objects that depend on the security rule set)
-func (a *SecurityRuleSetAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.SecurityRuleSet).Status.Dependents
-}
-
 // CreateObject creates the security rule set object
 func (a *SecurityRuleSetAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.SecurityRuleSet)
@@ -259,6 +275,12 @@ func (a *SecurityRuleSetAdapter) UpdateObject(obj runtime.Object) (runtime.Objec
 	return a.clientset.OcicoreV1alpha1().SecurityRuleSets(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the security rule set object
+func (a *SecurityRuleSetAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.SecurityRuleSet)
+	return a.clientset.OcicoreV1alpha1().SecurityRuleSets(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the security rule set object
 func (a *SecurityRuleSetAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.SecurityRuleSet)
@@ -322,6 +344,9 @@ func (a *SecurityRuleSetAdapter) Create(obj runtime.Object) (runtime.Object, err
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
 	request.EgressSecurityRules = object.Spec.EgressSecurityRules
 	request.IngressSecurityRules = object.Spec.IngressSecurityRules
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 	request.OpcRetryToken = ocisdkcommon.String(string(object.UID))
 
 	glog.Infof("SecurityList: %s OpcRetryToken: %s", object.Name, string(object.UID))
@@ -387,6 +412,11 @@ func (a *SecurityRuleSetAdapter) Update(obj runtime.Object) (runtime.Object, err
 
 	request.EgressSecurityRules = object.Spec.EgressSecurityRules
 	request.IngressSecurityRules = object.Spec.IngressSecurityRules
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	if object.Status.Resource.LifecycleState != ocicore.SecurityListLifecycleStateAvailable {
 		return object, errors.New(string(object.Status.Resource.LifecycleState))
@@ -406,3 +436,54 @@ func (a *SecurityRuleSetAdapter) Update(obj runtime.Object) (runtime.Object, err
 func (a *SecurityRuleSetAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the security lists in the vcns of the compartment tagged as managed by oci-manager
+func (a *SecurityRuleSetAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	vcnIds, err := resourcescommon.VcnIds(a.ctx, a.vcnClient, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var owned []resourcescommon.OwnedResource
+	for _, vcnId := range vcnIds {
+		request := ocicore.ListSecurityListsRequest{CompartmentId: &compartmentId, VcnId: ocisdkcommon.String(vcnId)}
+		for {
+			r, err := a.vcnClient.ListSecurityLists(a.ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range r.Items {
+				if !resourcescommon.IsOwned(item.FreeformTags) ||
+					item.LifecycleState == ocicore.SecurityListLifecycleStateTerminating || item.LifecycleState == ocicore.SecurityListLifecycleStateTerminated {
+					continue
+				}
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = []string{vcnId}
+				owned = append(owned, resource)
+			}
+			if r.OpcNextPage == nil {
+				break
+			}
+			request.Page = r.OpcNextPage
+		}
+	}
+	return owned, nil
+}
+
+// DeleteOwned deletes the security list with the id
+func (a *SecurityRuleSetAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteSecurityList(a.ctx, ocicore.DeleteSecurityListRequest{SecurityListId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the security list with the id
+func (a *SecurityRuleSetAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetSecurityList(a.ctx, ocicore.GetSecurityListRequest{SecurityListId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateSecurityList(a.ctx, ocicore.UpdateSecurityListRequest{
+		SecurityListId:            &id,
+		UpdateSecurityListDetails: ocicore.UpdateSecurityListDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -51,6 +51,7 @@ func init() {
 		ocicorev1alpha1.SubnetResourcePlural,
 		ocicorev1alpha1.SubnetControllerName,
 		&ocicorev1alpha1.SubnetValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.AvailabilityDomainColumn, ocicommon.OcidColumn),
 		NewSubnetAdapter)
 }
 
@@ -73,7 +74,7 @@ func NewSubnetAdapter(clientset versioned.Interface, kubeclient kubernetes.Inter
 		os.Exit(1)
 	}
 
-	sa.vcnClient = &vcnClient
+	sa.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	sa.clientset = clientset
 	sa.ctx = context.Background()
 
@@ -81,6 +82,22 @@ func NewSubnetAdapter(clientset versioned.Interface, kubeclient kubernetes.Inter
 
 }
 
+// NewSubnetAdapterBasic creates a new adapter for subnet resource with the provided vcn client
+func NewSubnetAdapterBasic(clientset versioned.Interface, vcnClient resourcescommon.VcnClientInterface) resourcescommon.ResourceTypeAdapter {
+	sa := SubnetAdapter{}
+	sa.vcnClient = vcnClient
+	sa.clientset = clientset
+	sa.ctx = context.Background()
+	return &sa
+}
+
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *SubnetAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *SubnetAdapter) Kind() string {
 	return ocicorev1alpha1.SubnetKind
@@ -127,35 +144,36 @@ func (a *SubnetAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *SubnetAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *SubnetAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	subnet := obj.(*ocicorev1alpha1.Subnet)
 
 	if subnet.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := subnet.Status.Resource
 
 	if resource.LifecycleState == ocicore.SubnetLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.SubnetLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.SubnetLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(subnet.Name, subnet.Spec.DisplayName)
 
-	if *resource.DisplayName != *specDisplayName ||
-		*resource.AvailabilityDomain != subnet.Spec.AvailabilityDomain ||
-		*resource.CidrBlock != subnet.Spec.CidrBlock ||
-		*resource.DnsLabel != subnet.Spec.DNSLabel ||
-		*resource.ProhibitPublicIpOnVnic != false {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, resource.DisplayName)
+	drift.Check("availabilityDomain", subnet.Spec.AvailabilityDomain, resource.AvailabilityDomain)
+	drift.Check("cidrBlock", subnet.Spec.CidrBlock, resource.CidrBlock)
+	drift.Check("dnsLabel", subnet.Spec.DNSLabel, resource.DnsLabel)
+	drift.Check("prohibitPublicIpOnVnic", false, resource.ProhibitPublicIpOnVnic)
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), subnet, subnet.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -181,11 +199,6 @@ func (a *SubnetAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Depen
 	return obj.(*ocicorev1alpha1.Subnet).Spec.DependsOn
 }
 
-// Dependents returns a map of subnet dependents (objects that depend on the subnet)
-func (a *SubnetAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.Subnet).Status.Dependents
-}
-
 // CreateObject creates the subnet object
 func (a *SubnetAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.Subnet)
@@ -198,6 +211,12 @@ func (a *SubnetAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error)
 	return a.clientset.OcicoreV1alpha1().Subnets(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the subnet object
+func (a *SubnetAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.Subnet)
+	return a.clientset.OcicoreV1alpha1().Subnets(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the subnet object
 func (a *SubnetAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.Subnet)
@@ -309,6 +328,9 @@ func (a *SubnetAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.ProhibitPublicIpOnVnic = ocisdkcommon.Bool(false)
 	request.RouteTableId = ocisdkcommon.String(routetableId)
 	request.SecurityListIds = securityrulesetList
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	// TODO: figure out why first successful create tx doesnt set status and subsequent fail due to duplicate create request w/ same opc token
 	// until then, have this workaround/handling of overlap response
@@ -387,8 +409,15 @@ func (a *SubnetAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 func (a *SubnetAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.Subnet)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	request := ocicore.UpdateSubnetRequest{
 		SubnetId: object.Status.Resource.Id,
+		UpdateSubnetDetails: ocicore.UpdateSubnetDetails{
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
 	}
 
 	if object.Status.Resource.LifecycleState != ocicore.SubnetLifecycleStateAvailable {
@@ -408,3 +437,60 @@ func (a *SubnetAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *SubnetAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the subnets in the vcns of the compartment tagged as managed by oci-manager
+func (a *SubnetAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	vcnIds, err := resourcescommon.VcnIds(a.ctx, a.vcnClient, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	var owned []resourcescommon.OwnedResource
+	for _, vcnId := range vcnIds {
+		request := ocicore.ListSubnetsRequest{CompartmentId: &compartmentId, VcnId: ocisdkcommon.String(vcnId)}
+		for {
+			r, err := a.vcnClient.ListSubnets(a.ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range r.Items {
+				if !resourcescommon.IsOwned(item.FreeformTags) ||
+					item.LifecycleState == ocicore.SubnetLifecycleStateTerminating || item.LifecycleState == ocicore.SubnetLifecycleStateTerminated {
+					continue
+				}
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = append([]string{vcnId}, item.SecurityListIds...)
+				if item.RouteTableId != nil {
+					resource.Parents = append(resource.Parents, *item.RouteTableId)
+				}
+				if item.DhcpOptionsId != nil {
+					resource.Parents = append(resource.Parents, *item.DhcpOptionsId)
+				}
+				owned = append(owned, resource)
+			}
+			if r.OpcNextPage == nil {
+				break
+			}
+			request.Page = r.OpcNextPage
+		}
+	}
+	return owned, nil
+}
+
+// DeleteOwned deletes the subnet with the id
+func (a *SubnetAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteSubnet(a.ctx, ocicore.DeleteSubnetRequest{SubnetId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the subnet with the id
+func (a *SubnetAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetSubnet(a.ctx, ocicore.GetSubnetRequest{SubnetId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateSubnet(a.ctx, ocicore.UpdateSubnetRequest{
+		SubnetId:            &id,
+		UpdateSubnetDetails: ocicore.UpdateSubnetDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -46,6 +46,7 @@ func init() {
 		ocicorev1alpha1.VirtualNetworkResourcePlural,
 		ocicorev1alpha1.VirtualNetworkControllerName,
 		&ocicorev1alpha1.VcnValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewVcnAdapter)
 }
 
@@ -68,7 +69,7 @@ func NewVcnAdapter(clientset versioned.Interface, kubeclient kubernetes.Interfac
 		os.Exit(1)
 	}
 
-	vna.vcnClient = &vcnClient
+	vna.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	vna.clientset = clientset
 	vna.ctx = context.Background()
 	return &vna
@@ -82,6 +83,13 @@ func NewVcnAdapterBasic(clientset versioned.Interface, vcnClient resourcescommon
 	return &vna
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *VcnAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *VcnAdapter) Kind() string {
 	return ocicorev1alpha1.VirtualNetworkKind
@@ -128,31 +136,32 @@ func (a *VcnAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant
-func (a *VcnAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *VcnAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	virtualnetwork := obj.(*ocicorev1alpha1.Vcn)
 	if virtualnetwork.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := virtualnetwork.Status.Resource
 
 	if resource.LifecycleState == ocicore.VcnLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.VcnLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.VcnLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	displayName := resourcescommon.Display(virtualnetwork.Name, virtualnetwork.Spec.DisplayName)
 
-	if *resource.CidrBlock != virtualnetwork.Spec.CidrBlock ||
-		*resource.DisplayName != *displayName ||
-		*resource.DnsLabel != virtualnetwork.Spec.DNSLabel {
-		return false
-	}
-	return true
+	var drift resourcescommon.Drift
+	drift.Check("cidrBlock", virtualnetwork.Spec.CidrBlock, resource.CidrBlock)
+	drift.Check("displayName", displayName, resource.DisplayName)
+	drift.Check("dnsLabel", virtualnetwork.Spec.DNSLabel, resource.DnsLabel)
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), virtualnetwork, virtualnetwork.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -182,11 +191,6 @@ func (a *VcnAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.DependsO
 	return obj.(*ocicorev1alpha1.Vcn).Spec.DependsOn
 }
 
-// Dependents returns a map of vcn dependents (objects that depend on the vcn)
-func (a *VcnAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.Vcn).Status.Dependents
-}
-
 // CreateObject creates the vcn object
 func (a *VcnAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.Vcn)
@@ -199,6 +203,12 @@ func (a *VcnAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error) {
 	return a.clientset.OcicoreV1alpha1().Vcns(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the vcn object
+func (a *VcnAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.Vcn)
+	return a.clientset.OcicoreV1alpha1().Vcns(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the vcn object
 func (a *VcnAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.Vcn)
@@ -243,6 +253,9 @@ func (a *VcnAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.CompartmentId = ocisdkcommon.String(compartmentId)
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
 	request.DnsLabel = ocisdkcommon.String(object.Spec.DNSLabel)
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(object.UID))
 
@@ -291,8 +304,16 @@ func (a *VcnAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 func (a *VcnAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	object := obj.(*ocicorev1alpha1.Vcn)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	request := ocicore.UpdateVcnRequest{
 		VcnId: object.Status.Resource.Id,
+		UpdateVcnDetails: ocicore.UpdateVcnDetails{
+			DisplayName:  resourcescommon.Display(object.Name, object.Spec.DisplayName),
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
 	}
 
 	r, e := a.vcnClient.UpdateVcn(a.ctx, request)
@@ -308,3 +329,44 @@ func (a *VcnAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *VcnAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the vcns in the compartment tagged as managed by oci-manager
+func (a *VcnAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocicore.ListVcnsRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.vcnClient.ListVcns(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocicore.VcnLifecycleStateTerminating && item.LifecycleState != ocicore.VcnLifecycleStateTerminated {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the vcn with the id
+func (a *VcnAdapter) DeleteOwned(id string) error {
+	_, err := a.vcnClient.DeleteVcn(a.ctx, ocicore.DeleteVcnRequest{VcnId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the vcn with the id
+func (a *VcnAdapter) ReleaseOwned(id string) error {
+	r, err := a.vcnClient.GetVcn(a.ctx, ocicore.GetVcnRequest{VcnId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.vcnClient.UpdateVcn(a.ctx, ocicore.UpdateVcnRequest{
+		VcnId:            &id,
+		UpdateVcnDetails: ocicore.UpdateVcnDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -125,6 +125,9 @@ func TestVcnResourceBasic(t *testing.T) {
 		t.Errorf("Got error %v", err)
 	}
 	t.Logf("Created Vcn resource %v", vcnWithResource)
+	if tags := vcnWithResource.(*corev1alpha1.Vcn).Status.Resource.FreeformTags; tags[resourcescommon.TagManagedBy] != resourcescommon.ManagedByValue || tags[resourcescommon.TagName] != vcntest1.Name {
+		t.Errorf("Expected the vcn to carry the ownership tags, got %v", tags)
+	}
 
 	vcnWithResource, err = vcnAdapter.Get(newVcn)
 	vcnWithResource, err = vcnAdapter.Update(newVcn)
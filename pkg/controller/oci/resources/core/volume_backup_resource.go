@@ -48,6 +48,7 @@ func init() {
 		ocicorev1alpha1.VolumeBackupResourcePlural,
 		ocicorev1alpha1.VolumeBackupControllerName,
 		&ocicorev1alpha1.VolumeBackupValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewVolumeBackupAdapter)
 }
 
@@ -70,12 +71,19 @@ func NewVolumeBackupAdapter(clientset versioned.Interface, kubeclient kubernetes
 		os.Exit(1)
 	}
 
-	va.bsClient = &bsClient
+	va.bsClient = resourcescommon.InstrumentBlockStorageClient(&bsClient)
 	va.clientset = clientset
 	va.ctx = context.Background()
 	return &va
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *VolumeBackupAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *VolumeBackupAdapter) Kind() string {
 	return ocicorev1alpha1.VolumeBackupKind
@@ -125,11 +133,11 @@ func (a *VolumeBackupAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *VolumeBackupAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *VolumeBackupAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	volumeBackup := obj.(*ocicorev1alpha1.VolumeBackup)
 
 	if volumeBackup.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(volumeBackup.Name, volumeBackup.Spec.DisplayName)
@@ -137,12 +145,13 @@ func (a *VolumeBackupAdapter) IsResourceCompliant(obj runtime.Object) bool {
 	resource := volumeBackup.Status.Resource
 	volumeType := ocicore.VolumeBackupTypeEnum(volumeBackup.Spec.VolumeBackupType)
 
-	if *resource.DisplayName != *specDisplayName ||
-		resource.Type != volumeType {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, resource.DisplayName)
+	drift.Check("volumeBackupType", volumeType, resource.Type)
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), volumeBackup, volumeBackup.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -168,11 +177,6 @@ func (a *VolumeBackupAdapter) DependsOn(obj runtime.Object) map[string]ocicommon
 	return obj.(*ocicorev1alpha1.VolumeBackup).Spec.DependsOn
 }
 
-// Dependents returns a map of volume backup dependents (objects that depend on the volume backup)
-func (a *VolumeBackupAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.VolumeBackup).Status.Dependents
-}
-
 // CreateObject creates the volume backup object
 func (a *VolumeBackupAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.VolumeBackup)
@@ -185,6 +189,12 @@ func (a *VolumeBackupAdapter) UpdateObject(obj runtime.Object) (runtime.Object,
 	return a.clientset.OcicoreV1alpha1().VolumeBackups(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the volume backup object
+func (a *VolumeBackupAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.VolumeBackup)
+	return a.clientset.OcicoreV1alpha1().VolumeBackups(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the volume backup object
 func (a *VolumeBackupAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.VolumeBackup)
@@ -232,6 +242,9 @@ func (a *VolumeBackupAdapter) Create(obj runtime.Object) (runtime.Object, error)
 	request.VolumeId = ocisdkcommon.String(volumeId)
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
 	request.Type = ocicore.CreateVolumeBackupDetailsTypeEnum(object.Spec.VolumeBackupType)
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 	request.OpcRetryToken = ocisdkcommon.String(string(object.UID))
 
 	r, err := a.bsClient.CreateVolumeBackup(a.ctx, request)
@@ -294,6 +307,11 @@ func (a *VolumeBackupAdapter) Update(obj runtime.Object) (runtime.Object, error)
 	request := ocicore.UpdateVolumeBackupRequest{}
 	request.VolumeBackupId = object.Status.Resource.Id
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	r, e := a.bsClient.UpdateVolumeBackup(a.ctx, request)
 
@@ -308,3 +326,44 @@ func (a *VolumeBackupAdapter) Update(obj runtime.Object) (runtime.Object, error)
 func (a *VolumeBackupAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the volume backups in the compartment tagged as managed by oci-manager
+func (a *VolumeBackupAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocicore.ListVolumeBackupsRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.bsClient.ListVolumeBackups(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocicore.VolumeBackupLifecycleStateTerminating && item.LifecycleState != ocicore.VolumeBackupLifecycleStateTerminated {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the volume backup with the id
+func (a *VolumeBackupAdapter) DeleteOwned(id string) error {
+	_, err := a.bsClient.DeleteVolumeBackup(a.ctx, ocicore.DeleteVolumeBackupRequest{VolumeBackupId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the volume backup with the id
+func (a *VolumeBackupAdapter) ReleaseOwned(id string) error {
+	r, err := a.bsClient.GetVolumeBackup(a.ctx, ocicore.GetVolumeBackupRequest{VolumeBackupId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.bsClient.UpdateVolumeBackup(a.ctx, ocicore.UpdateVolumeBackupRequest{
+		VolumeBackupId:            &id,
+		UpdateVolumeBackupDetails: ocicore.UpdateVolumeBackupDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -49,6 +49,7 @@ func init() {
 		ocicorev1alpha1.VolumeResourcePlural,
 		ocicorev1alpha1.VolumeControllerName,
 		&ocicorev1alpha1.VolumeValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.AvailabilityDomainColumn, ocicommon.OcidColumn),
 		NewVolumeAdapter)
 }
 
@@ -79,13 +80,20 @@ func NewVolumeAdapter(clientset versioned.Interface, kubeclient kubernetes.Inter
 		os.Exit(1)
 	}
 
-	va.cClient = &cClient
-	va.bsClient = &bsClient
+	va.cClient = resourcescommon.InstrumentComputeClient(&cClient)
+	va.bsClient = resourcescommon.InstrumentBlockStorageClient(&bsClient)
 	va.clientset = clientset
 	va.ctx = context.Background()
 	return &va
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *VolumeAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *VolumeAdapter) Kind() string {
 	return ocicorev1alpha1.VolumeKind
@@ -150,50 +158,50 @@ func (a *VolumeAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *VolumeAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *VolumeAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 
 	volume := obj.(*ocicorev1alpha1.Volume)
 
 	if volume.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := volume.Status.Resource
 
 	if resource.LifecycleState == ocicore.VolumeLifecycleStateProvisioning ||
 		resource.LifecycleState == ocicore.VolumeLifecycleStateTerminating {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocicore.VolumeLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(volume.Name, volume.Spec.DisplayName)
 
-	if *resource.DisplayName != *specDisplayName ||
-		*resource.AvailabilityDomain != volume.Spec.AvailabilityDomain ||
-		*resource.SizeInGBs != *ocisdkcommon.Int64(volume.Spec.SizeInGBs) {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("displayName", specDisplayName, resource.DisplayName)
+	drift.Check("availabilityDomain", volume.Spec.AvailabilityDomain, resource.AvailabilityDomain)
+	drift.Check("sizeInGBs", ocisdkcommon.Int64(volume.Spec.SizeInGBs), resource.SizeInGBs)
 
 	if volume.Spec.InstanceRef != "" {
 		if volume.Status.Attachment == nil {
-			return false
+			return false, nil
 		}
 
 		if volume.Status.Attachment.GetLifecycleState() == ocicore.VolumeAttachmentLifecycleStateDetached {
-			return false
+			return false, nil
 		}
 
 	}
 
-	if volume.Status.Attachment != nil &&
-		volume.Spec.AttachmentType != volume.Status.Attachment.AttachmentType {
-		return false
+	if volume.Status.Attachment != nil {
+		drift.Check("attachmentType", volume.Spec.AttachmentType, volume.Status.Attachment.AttachmentType)
 	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), volume, volume.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -227,11 +235,6 @@ func (a *VolumeAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Depen
 	return obj.(*ocicorev1alpha1.Volume).Spec.DependsOn
 }
 
-// Dependents returns a map of volume dependents (objects that depend on the volume)
-func (a *VolumeAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocicorev1alpha1.Volume).Status.Dependents
-}
-
 // CreateObject creates the volume object
 func (a *VolumeAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocicorev1alpha1.Volume)
@@ -244,6 +247,12 @@ func (a *VolumeAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error)
 	return a.clientset.OcicoreV1alpha1().Volumes(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the volume object
+func (a *VolumeAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocicorev1alpha1.Volume)
+	return a.clientset.OcicoreV1alpha1().Volumes(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the volume object
 func (a *VolumeAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocicorev1alpha1.Volume)
@@ -290,6 +299,9 @@ func (a *VolumeAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
 	request.AvailabilityDomain = ocisdkcommon.String(object.Spec.AvailabilityDomain)
 	request.SizeInGBs = ocisdkcommon.Int64(object.Spec.SizeInGBs)
+	tags := resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(object.UID))
 
@@ -408,6 +420,11 @@ func (a *VolumeAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	request := ocicore.UpdateVolumeRequest{}
 	request.VolumeId = object.Status.Resource.Id
 	request.DisplayName = resourcescommon.Display(object.Name, object.Spec.DisplayName)
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	r, e := a.bsClient.UpdateVolume(a.ctx, request)
 
@@ -487,3 +504,44 @@ func (a *VolumeAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *VolumeAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the volumes in the compartment tagged as managed by oci-manager
+func (a *VolumeAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocicore.ListVolumesRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.bsClient.ListVolumes(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocicore.VolumeLifecycleStateTerminating && item.LifecycleState != ocicore.VolumeLifecycleStateTerminated {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the volume with the id
+func (a *VolumeAdapter) DeleteOwned(id string) error {
+	_, err := a.bsClient.DeleteVolume(a.ctx, ocicore.DeleteVolumeRequest{VolumeId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the volume with the id
+func (a *VolumeAdapter) ReleaseOwned(id string) error {
+	r, err := a.bsClient.GetVolume(a.ctx, ocicore.GetVolumeRequest{VolumeId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.bsClient.UpdateVolume(a.ctx, ocicore.UpdateVolumeRequest{
+		VolumeId:            &id,
+		UpdateVolumeDetails: ocicore.UpdateVolumeDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	clientset "github.com/oracle/oci-manager/pkg/client/clientset/versioned"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Condition reasons of the credentials selected by the namespace
+const (
+	reasonCredentialsLoaded   = "CredentialsLoaded"
+	reasonCredentialsNotFound = "CredentialsNotFound"
+	reasonCredentialsInvalid  = "CredentialsInvalid"
+)
+
+// credentialsError is a failure to load the credentials of a namespace, the objects of the
+// namespace are reconciled again once the credentials or their secret change
+type credentialsError struct {
+	reason string
+	msg    string
+}
+
+func (e *credentialsError) Error() string {
+	return e.msg
+}
+
+// cachedProvider is a configuration provider loaded from a version of the credentials
+type cachedProvider struct {
+	version  string
+	provider ocisdkcommon.ConfigurationProvider
+	err      error
+}
+
+// Credentials looks up the OCI credentials a namespace selects with the credentials
+// annotation. The OciCredentials objects are cluster scoped, the secrets they refer to
+// are read from the namespace of oci-manager only.
+type Credentials struct {
+	namespaces  corelisters.NamespaceLister
+	credentials cache.SharedIndexInformer
+	secrets     cache.SharedIndexInformer
+	namespace   string
+
+	lock      sync.Mutex
+	providers map[string]cachedProvider
+	handlers  []func(namespace string)
+}
+
+// NewCredentialsInformer watches the OciCredentials objects, they have no generated client
+// since the generated resource name would not match the plural of the kind
+func NewCredentialsInformer(clientset clientset.Interface, resync time.Duration) cache.SharedIndexInformer {
+	lw := cache.NewListWatchFromClient(clientset.OciidentityV1alpha1().RESTClient(),
+		ociidentityv1alpha1.OciCredentialsResourcePlural, metav1.NamespaceAll, fields.Everything())
+	return cache.NewSharedIndexInformer(lw, &ociidentityv1alpha1.OciCredentials{}, resync, cache.Indexers{})
+}
+
+// NewCredentials returns the credentials of the namespaces, secrets must only list the
+// secrets of the oci-manager namespace
+func NewCredentials(namespace string, namespaces coreinformers.NamespaceInformer,
+	credentials cache.SharedIndexInformer, secrets coreinformers.SecretInformer) *Credentials {
+	c := &Credentials{
+		namespaces:  namespaces.Lister(),
+		credentials: credentials,
+		secrets:     secrets.Informer(),
+		namespace:   namespace,
+		providers:   make(map[string]cachedProvider),
+	}
+
+	namespaces.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur interface{}) {
+			oldns, curns := old.(*corev1.Namespace), cur.(*corev1.Namespace)
+			if oldns.Annotations[ocicommon.CredentialsAnnotation] != curns.Annotations[ocicommon.CredentialsAnnotation] {
+				c.notify(curns.Name)
+			}
+		},
+	})
+	credentials.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.changed(obj) },
+		UpdateFunc: func(old, cur interface{}) { c.changed(cur) },
+		DeleteFunc: func(obj interface{}) { c.changed(obj) },
+	})
+	c.secrets.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.secretChanged(obj) },
+		UpdateFunc: func(old, cur interface{}) { c.secretChanged(cur) },
+		DeleteFunc: func(obj interface{}) { c.secretChanged(obj) },
+	})
+	return c
+}
+
+// Run starts the informers of the credentials and their secrets
+func (c *Credentials) Run(stopCh <-chan struct{}) bool {
+	go c.credentials.Run(stopCh)
+	go c.secrets.Run(stopCh)
+	return cache.WaitForCacheSync(stopCh, c.credentials.HasSynced, c.secrets.HasSynced)
+}
+
+// HasSynced returns true once the informers of the credentials and their secrets have synced
+func (c *Credentials) HasSynced() bool {
+	return c.credentials.HasSynced() && c.secrets.HasSynced()
+}
+
+// AddHandler calls handler with the namespaces whose credentials changed
+func (c *Credentials) AddHandler(handler func(namespace string)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.handlers = append(c.handlers, handler)
+}
+
+func (c *Credentials) notify(namespace string) {
+	c.lock.Lock()
+	handlers := c.handlers
+	c.lock.Unlock()
+	for _, handler := range handlers {
+		handler(namespace)
+	}
+}
+
+// changed notifies the namespaces selecting the changed credentials
+func (c *Credentials) changed(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	credentials, ok := obj.(*ociidentityv1alpha1.OciCredentials)
+	if !ok {
+		return
+	}
+	namespaces, err := c.namespaces.List(labels.Everything())
+	if err != nil {
+		return
+	}
+	for _, ns := range namespaces {
+		if ns.Annotations[ocicommon.CredentialsAnnotation] == credentials.Name {
+			c.notify(ns.Name)
+		}
+	}
+}
+
+// secretChanged notifies the namespaces selecting credentials of the changed secret
+func (c *Credentials) secretChanged(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	for _, item := range c.credentials.GetStore().List() {
+		if credentials, ok := item.(*ociidentityv1alpha1.OciCredentials); ok && credentials.Spec.SecretName == secret.Name {
+			c.changed(credentials)
+		}
+	}
+}
+
+// Provider returns the configuration provider selected by the namespace, nil for the
+// default credentials. The version changes with the credentials and their secret.
+func (c *Credentials) Provider(namespace string) (name, version string, provider ocisdkcommon.ConfigurationProvider, err error) {
+	ns, err := c.namespaces.Get(namespace)
+	if apierrors.IsNotFound(err) {
+		return "", "", nil, nil
+	} else if err != nil {
+		return "", "", nil, err
+	}
+	name = ns.Annotations[ocicommon.CredentialsAnnotation]
+	if name == "" {
+		return "", "", nil, nil
+	}
+
+	obj, exists, err := c.credentials.GetStore().GetByKey(name)
+	if err != nil {
+		return name, "", nil, err
+	} else if !exists {
+		return name, "", nil, &credentialsError{reasonCredentialsNotFound, fmt.Sprintf("OciCredentials %s not found", name)}
+	}
+	credentials := obj.(*ociidentityv1alpha1.OciCredentials)
+
+	obj, exists, err = c.secrets.GetStore().GetByKey(c.namespace + "/" + credentials.Spec.SecretName)
+	if err != nil {
+		return name, "", nil, err
+	} else if !exists {
+		return name, "", nil, &credentialsError{reasonCredentialsNotFound,
+			fmt.Sprintf("Secret %s/%s of OciCredentials %s not found", c.namespace, credentials.Spec.SecretName, name)}
+	}
+	secret := obj.(*corev1.Secret)
+
+	version = credentials.ResourceVersion + "/" + secret.ResourceVersion
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if cached, ok := c.providers[name]; ok && cached.version == version {
+		return name, version, cached.provider, cached.err
+	}
+	provider, err = credentialsProvider(credentials, secret)
+	c.providers[name] = cachedProvider{version: version, provider: provider, err: err}
+	return name, version, provider, err
+}
+
+// credentialsProvider loads the API key of the secret, the key is parsed once here
+// instead of failing every OCI call
+func credentialsProvider(credentials *ociidentityv1alpha1.OciCredentials, secret *corev1.Secret) (ocisdkcommon.ConfigurationProvider, error) {
+	value := func(key string) string {
+		return strings.TrimSpace(string(secret.Data[key]))
+	}
+	var missing []string
+	for _, key := range []string{
+		ociidentityv1alpha1.CredentialsTenancyKey,
+		ociidentityv1alpha1.CredentialsUserKey,
+		ociidentityv1alpha1.CredentialsFingerprintKey,
+		ociidentityv1alpha1.CredentialsPrivateKeyKey,
+	} {
+		if value(key) == "" {
+			missing = append(missing, key)
+		}
+	}
+	region := credentials.Spec.Region
+	if region == "" {
+		region = value(ociidentityv1alpha1.CredentialsRegionKey)
+	}
+	if region == "" {
+		missing = append(missing, ociidentityv1alpha1.CredentialsRegionKey)
+	}
+	if len(missing) > 0 {
+		return nil, &credentialsError{reasonCredentialsInvalid,
+			fmt.Sprintf("Secret %s of OciCredentials %s is missing %s", secret.Name, credentials.Name, strings.Join(missing, ", "))}
+	}
+
+	var passphrase *string
+	if p, ok := secret.Data[ociidentityv1alpha1.CredentialsPassphraseKey]; ok {
+		s := string(p)
+		passphrase = &s
+	}
+	provider := ocisdkcommon.NewRawConfigurationProvider(
+		value(ociidentityv1alpha1.CredentialsTenancyKey),
+		value(ociidentityv1alpha1.CredentialsUserKey),
+		region,
+		value(ociidentityv1alpha1.CredentialsFingerprintKey),
+		string(secret.Data[ociidentityv1alpha1.CredentialsPrivateKeyKey]),
+		passphrase)
+	if ok, err := ocisdkcommon.IsConfigurationProviderValid(provider); !ok {
+		return nil, &credentialsError{reasonCredentialsInvalid,
+			fmt.Sprintf("Secret %s of OciCredentials %s is invalid: %v", secret.Name, credentials.Name, err)}
+	}
+	return provider, nil
+}
+
+// credentialsAdapter is the adapter of a controller built for a version of the credentials
+type credentialsAdapter struct {
+	version string
+	adapter resourcescommon.ResourceTypeAdapter
+}
+
+// setAdapterFactory lets the controller build adapters for other credentials and regions
+// than the ones of its default adapter, which was built with provider. It must be called
+// before the controller runs.
+func (c *Controller) setAdapterFactory(provider ocisdkcommon.ConfigurationProvider, newAdapter func(ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter) {
+	c.provider = provider
+	c.newAdapter = newAdapter
+	c.adapters = make(map[string]credentialsAdapter)
+}
+
+// setCredentials makes the controller use the credentials selected by the namespace of an
+// object. It must be called before the controller runs.
+func (c *Controller) setCredentials(credentials *Credentials) {
+	c.credentials = credentials
+	credentials.AddHandler(c.enqueueNamespace)
+}
+
+// namespaceProvider returns the configuration provider selected by a namespace, the name
+// and version of its credentials are empty for the default credentials
+func (c *Controller) namespaceProvider(namespace string) (name, version string, provider ocisdkcommon.ConfigurationProvider, err error) {
+	if c.credentials != nil && namespace != "" {
+		name, version, provider, err = c.credentials.Provider(namespace)
+		if err != nil || provider != nil {
+			return name, version, provider, err
+		}
+	}
+	return "", "", c.provider, nil
+}
+
+// ociAdapter returns the adapter making the OCI calls of an object in region and the name
+// of the credentials selected by its namespace, empty for the default credentials. An empty
+// region is the region of the credentials. The writes of the tenancy-wide kinds are made in
+// the region of the credentials whatever the region of the object.
+func (c *Controller) ociAdapter(objectmeta metav1.Object, region string) (resourcescommon.ResourceTypeAdapter, string, error) {
+	adapter, name, err := c.regionAdapter(objectmeta, region)
+	if err != nil || region == "" || !tenancyWideKinds[c.adapter.Kind()] {
+		return adapter, name, err
+	}
+	home, _, err := c.regionAdapter(objectmeta, "")
+	if err != nil {
+		return nil, name, err
+	}
+	return newHomeRegionAdapter(adapter, home), name, nil
+}
+
+// regionAdapter returns the cached adapter of the credentials of an object for region
+func (c *Controller) regionAdapter(objectmeta metav1.Object, region string) (resourcescommon.ResourceTypeAdapter, string, error) {
+	name, version, provider, err := c.namespaceProvider(objectmeta.GetNamespace())
+	if err != nil {
+		return nil, name, err
+	}
+	if (name == "" && region == "") || c.newAdapter == nil {
+		return c.adapter, name, nil
+	}
+	if region != "" {
+		provider = resourcescommon.WithRegion(provider, region)
+	}
+
+	key := name + "/" + region
+	c.adaptersLock.Lock()
+	defer c.adaptersLock.Unlock()
+	if cached, ok := c.adapters[key]; ok && cached.version == version {
+		return cached.adapter, name, nil
+	}
+	glog.V(2).Infof("Creating %s adapter for credentials %q in region %q", c.adapter.Kind(), name, region)
+	adapter := c.newAdapter(provider)
+	c.adapters[key] = credentialsAdapter{version: version, adapter: adapter}
+	return adapter, name, nil
+}
+
+// auditedAdapter returns the adapter with the object of its OCI calls in the context, so that
+// the calls of the reconcile are audited with the object
+func (c *Controller) auditedAdapter(oci resourcescommon.ResourceTypeAdapter, object runtime.Object, objectmeta metav1.Object) resourcescommon.ResourceTypeAdapter {
+	adapter, ok := oci.(resourcescommon.ContextAdapter)
+	if !ok {
+		return oci
+	}
+	return adapter.WithContext(resourcescommon.WithAuditObject(context.Background(), resourcescommon.AuditObject{
+		Kind:      c.adapter.Kind(),
+		Namespace: objectmeta.GetNamespace(),
+		Name:      objectmeta.GetName(),
+		UID:       string(objectmeta.GetUID()),
+		OCID:      c.adapter.Id(object),
+	}))
+}
+
+// setCredentialsReady reports the credentials selected by the namespace of the object
+func setCredentialsReady(object runtime.Object, name string, generation int64) {
+	if name == "" {
+		if s := resourceStatus(object); s != nil {
+			s.RemoveCondition(ocicommon.ConditionCredentialsReady)
+		}
+		return
+	}
+	setCondition(object, ocicommon.ConditionCredentialsReady, ocicommon.ConditionTrue, reasonCredentialsLoaded,
+		fmt.Sprintf("Using OciCredentials %s", name), generation)
+}
+
+// refuseCredentials records why the credentials of the namespace could not be loaded, nothing
+// is retried until the credentials or their secret change
+func (c *Controller) refuseCredentials(kind, key string, source, object runtime.Object, err error, generation int64) (runtime.Object, error, bool) {
+	failed, ok := err.(*credentialsError)
+	if !ok {
+		return nil, err, false
+	}
+	glog.Errorf("Credentials of resource %s  %s are not usable: %s", kind, key, failed.msg)
+	setCondition(object, ocicommon.ConditionCredentialsReady, ocicommon.ConditionFalse, failed.reason, failed.msg, generation)
+	setCondition(object, ocicommon.ConditionSynced, ocicommon.ConditionFalse, failed.reason, failed.msg, generation)
+	if conditionsChanged(source, object) {
+		c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, failed.msg)
+		return object, nil, false
+	}
+	return nil, nil, false
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const managerNs = "oci-system"
+
+func credentialsInformer(items ...ociidentityv1alpha1.OciCredentials) cache.SharedIndexInformer {
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return &ociidentityv1alpha1.OciCredentialsList{Items: items}, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}
+	return cache.NewSharedIndexInformer(lw, &ociidentityv1alpha1.OciCredentials{}, 0, cache.Indexers{})
+}
+
+func credentialsSecret(t *testing.T, data map[string]string) *corev1.Secret {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-key", Namespace: managerNs, ResourceVersion: "1"},
+		Data: map[string][]byte{
+			ociidentityv1alpha1.CredentialsTenancyKey:     []byte("ocid1.tenancy.oc1..team"),
+			ociidentityv1alpha1.CredentialsUserKey:        []byte("ocid1.user.oc1..team"),
+			ociidentityv1alpha1.CredentialsFingerprintKey: []byte("aa:bb:cc"),
+			ociidentityv1alpha1.CredentialsRegionKey:      []byte("us-phoenix-1"),
+			ociidentityv1alpha1.CredentialsPrivateKeyKey: pem.EncodeToMemory(&pem.Block{
+				Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		},
+	}
+	for k, v := range data {
+		if v == "" {
+			delete(secret.Data, k)
+		} else {
+			secret.Data[k] = []byte(v)
+		}
+	}
+	return secret
+}
+
+func startCredentials(t *testing.T, namespace *corev1.Namespace, secret *corev1.Secret, items []ociidentityv1alpha1.OciCredentials, stopCh chan struct{}) (*Credentials, kubeinformers.SharedInformerFactory) {
+	objects := []runtime.Object{namespace}
+	if secret != nil {
+		objects = append(objects, secret)
+	}
+	kubeclient := fake.NewSimpleClientset(objects...)
+	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(kubeclient, 30*time.Second)
+	namespaces := kubeInformerFactory.Core().V1().Namespaces()
+	namespaces.Informer()
+	kubeInformerFactory.Start(stopCh)
+	kubeInformerFactory.WaitForCacheSync(stopCh)
+
+	secretInformerFactory := kubeinformers.NewFilteredSharedInformerFactory(kubeclient, 30*time.Second, managerNs, nil)
+	credentials := NewCredentials(managerNs, namespaces, credentialsInformer(items...), secretInformerFactory.Core().V1().Secrets())
+	if !credentials.Run(stopCh) {
+		t.Fatalf("Credentials caches did not sync")
+	}
+	return credentials, kubeInformerFactory
+}
+
+func teamCredentials(region string) []ociidentityv1alpha1.OciCredentials {
+	return []ociidentityv1alpha1.OciCredentials{{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", ResourceVersion: "1"},
+		Spec:       ociidentityv1alpha1.OciCredentialsSpec{SecretName: "team-key", Region: region},
+	}}
+}
+
+func TestCredentialsProvider(t *testing.T) {
+	annotated := map[string]string{ocicommon.CredentialsAnnotation: "team"}
+	testCases := []struct {
+		name         string
+		annotations  map[string]string
+		credentials  []ociidentityv1alpha1.OciCredentials
+		secretData   map[string]string
+		noSecret     bool
+		expectReason string
+		expectRegion string
+	}{
+		{name: "default credentials"},
+		{name: "credentials not found", annotations: annotated, expectReason: reasonCredentialsNotFound},
+		{name: "secret not found", annotations: annotated, credentials: teamCredentials(""), noSecret: true, expectReason: reasonCredentialsNotFound},
+		{name: "missing key", annotations: annotated, credentials: teamCredentials(""),
+			secretData: map[string]string{ociidentityv1alpha1.CredentialsUserKey: ""}, expectReason: reasonCredentialsInvalid},
+		{name: "invalid private key", annotations: annotated, credentials: teamCredentials(""),
+			secretData: map[string]string{ociidentityv1alpha1.CredentialsPrivateKeyKey: "not a key"}, expectReason: reasonCredentialsInvalid},
+		{name: "credentials", annotations: annotated, credentials: teamCredentials(""), expectRegion: "us-phoenix-1"},
+		{name: "region override", annotations: annotated, credentials: teamCredentials("us-ashburn-1"), expectRegion: "us-ashburn-1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+
+			var secret *corev1.Secret
+			if !tc.noSecret {
+				secret = credentialsSecret(t, tc.secretData)
+			}
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs, Annotations: tc.annotations}}
+			credentials, _ := startCredentials(t, namespace, secret, tc.credentials, stopCh)
+
+			name, version, provider, err := credentials.Provider(fakeNs)
+			if tc.expectReason != "" {
+				failed, ok := err.(*credentialsError)
+				if !ok || failed.reason != tc.expectReason {
+					t.Fatalf("Expected credentials error with reason %s, got %v", tc.expectReason, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+			if tc.expectRegion == "" {
+				if provider != nil || name != "" {
+					t.Errorf("Expected the default credentials, got %s", name)
+				}
+				return
+			}
+			if name != "team" || version != "1/1" {
+				t.Errorf("Expected version 1/1 of credentials team, got %s %s", name, version)
+			}
+			if region, _ := provider.Region(); region != tc.expectRegion {
+				t.Errorf("Expected region %s, got %s", tc.expectRegion, region)
+			}
+		})
+	}
+}
+
+func TestControllerCredentials(t *testing.T) {
+	testCases := []struct {
+		name          string
+		annotated     bool
+		credentials   []ociidentityv1alpha1.OciCredentials
+		expectCreated bool
+		expectStatus  ocicommon.ConditionStatus
+		expectBuilt   int
+	}{
+		{name: "default credentials", expectCreated: true},
+		{name: "credentials not found", annotated: true, expectStatus: ocicommon.ConditionFalse},
+		{name: "credentials", annotated: true, credentials: teamCredentials(""), expectCreated: true,
+			expectStatus: ocicommon.ConditionTrue, expectBuilt: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientset := fakeclient.NewSimpleClientset()
+			defaultClient := &failingVcnClient{VcnClient: fakeoci.NewVcnClient()}
+			vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, defaultClient)
+
+			vcn := corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "vcn.test1",
+					Namespace:  fakeNs,
+					Finalizers: []string{"ocimanager"},
+				},
+				Spec: corev1alpha1.VcnSpec{
+					CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+					CidrBlock:      "10.0.0.0/16",
+					DisplayName:    "testDisplay",
+				},
+			}
+			if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}
+			if tc.annotated {
+				namespace.Annotations = map[string]string{ocicommon.CredentialsAnnotation: "team"}
+			}
+			stopCh := make(chan struct{})
+			credentials, kubeInformerFactory := startCredentials(t, namespace, credentialsSecret(t, nil), tc.credentials, stopCh)
+
+			informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+			workQueues := make(map[string]workqueue.RateLimitingInterface)
+			workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+			built := 0
+			controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, kubeInformerFactory.Core().V1().Namespaces(), workQueues)
+			controller.setAdapterFactory(nil, func(provider ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter {
+				built++
+				return coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())
+			})
+			controller.setCredentials(credentials)
+			controller.Run(1, stopCh)
+
+			time.Sleep(1 * time.Second)
+			close(stopCh)
+
+			realizedVcn, err := clientset.OcicoreV1alpha1().Vcns(fakeNs).Get("vcn.test1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Got error %v", err)
+			}
+			if created := realizedVcn.GetResourceID() != ""; created != tc.expectCreated {
+				t.Errorf("Expected vcn created %v, got resource id %q", tc.expectCreated, realizedVcn.GetResourceID())
+			}
+			if built != tc.expectBuilt {
+				t.Errorf("Expected %d credentials adapters, got %d", tc.expectBuilt, built)
+			}
+			if calls := defaultClient.setFail(false); (calls > 0) != (!tc.annotated) {
+				t.Errorf("Expected the default credentials to be used only without annotation, got %d calls", calls)
+			}
+
+			condition := realizedVcn.Status.GetCondition(ocicommon.ConditionCredentialsReady)
+			if tc.expectStatus == "" {
+				if condition != nil {
+					t.Errorf("Expected no CredentialsReady condition, got %v", condition)
+				}
+				return
+			}
+			if condition == nil || condition.Status != tc.expectStatus {
+				t.Errorf("Expected CredentialsReady condition %s, got %v", tc.expectStatus, condition)
+			}
+		})
+	}
+}
@@ -55,6 +55,7 @@ func init() {
 		ocidbv1alpha1.AutonomousDatabaseResourcePlural,
 		ocidbv1alpha1.AutonomousDatabaseControllerName,
 		&ocidbv1alpha1.AutonomousDatabaseValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewAutonomousDatabaseAdapter)
 }
 
@@ -83,10 +84,17 @@ func NewAutonomousDatabaseAdapter(clientset versioned.Interface, kubeclient kube
 		glog.Errorf("Error creating oci db client: %v", err)
 		os.Exit(1)
 	}
-	ada.dbClient = &dbClient
+	ada.dbClient = resourcescommon.InstrumentDatabaseClient(&dbClient)
 	return &ada
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *AutonomousDatabaseAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *AutonomousDatabaseAdapter) Kind() string {
 	return ocidbv1alpha1.AutonomousDatabaseKind
@@ -125,10 +133,10 @@ func (a *AutonomousDatabaseAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant
-func (a *AutonomousDatabaseAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *AutonomousDatabaseAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	adb := obj.(*ocidbv1alpha1.AutonomousDatabase)
 	if adb.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := adb.Status.Resource
@@ -138,24 +146,29 @@ func (a *AutonomousDatabaseAdapter) IsResourceCompliant(obj runtime.Object) bool
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateRestoreInProgress ||
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateProvisioning ||
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateStopping {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateStopped ||
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateUnavailable ||
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateRestoreFailed ||
 		resource.LifecycleState == ocidb.AutonomousDatabaseLifecycleStateTerminated {
-		return false
+		return false, nil
 	}
 
 	specDisplayName := resourcescommon.Display(adb.Name, adb.Spec.DisplayName)
 
-	if *adb.Status.Resource.CpuCoreCount != *adb.Spec.CpuCoreCount ||
-		*adb.Status.Resource.DisplayName != *specDisplayName ||
-		*adb.Status.Resource.DataStorageSizeInTBs != *adb.Spec.DataStorageSizeInTBs {
-		return false
-	}
-	return true
+	var drift resourcescommon.Drift
+	drift.Check("cpuCoreCount", adb.Spec.CpuCoreCount, adb.Status.Resource.CpuCoreCount)
+	drift.Check("displayName", specDisplayName, adb.Status.Resource.DisplayName)
+	drift.Check("dataStorageSizeInTBs", adb.Spec.DataStorageSizeInTBs, adb.Status.Resource.DataStorageSizeInTBs)
+	drift.CheckTags(a.ownedTags(adb), ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+	return drift.Compliant(), drift
+}
+
+// ownedTags returns the tags from the spec stamped with the ownership tags of the object
+func (a *AutonomousDatabaseAdapter) ownedTags(adb *ocidbv1alpha1.AutonomousDatabase) ocicommon.ResourceTags {
+	return resourcescommon.OwnedTags(a.Kind(), adb, ocicommon.ResourceTags{FreeformTags: adb.Spec.FreeformTags, DefinedTags: adb.Spec.DefinedTags})
 }
 
 // IsResourceStatusChanged checks if two autonomousdatabase objects are the same
@@ -185,11 +198,6 @@ func (a *AutonomousDatabaseAdapter) DependsOn(obj runtime.Object) map[string]oci
 	return obj.(*ocidbv1alpha1.AutonomousDatabase).Spec.DependsOn
 }
 
-// Dependents returns a map of autonomousdatabase dependents (objects that depend on the autonomousdatabase)
-func (a *AutonomousDatabaseAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocidbv1alpha1.AutonomousDatabase).Status.Dependents
-}
-
 // CreateObject creates the autonomousdatabase object
 func (a *AutonomousDatabaseAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocidbv1alpha1.AutonomousDatabase)
@@ -202,6 +210,12 @@ func (a *AutonomousDatabaseAdapter) UpdateObject(obj runtime.Object) (runtime.Ob
 	return a.clientset.OcidbV1alpha1().AutonomousDatabases(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the autonomousdatabase object
+func (a *AutonomousDatabaseAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocidbv1alpha1.AutonomousDatabase)
+	return a.clientset.OcidbV1alpha1().AutonomousDatabases(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the autonomousdatabase object
 func (a *AutonomousDatabaseAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocidbv1alpha1.AutonomousDatabase)
@@ -291,6 +305,9 @@ func (a *AutonomousDatabaseAdapter) Create(obj runtime.Object) (runtime.Object,
 	request.DbName = &db.Name
 	request.DisplayName = resourcescommon.Display(db.Name, db.Spec.DisplayName)
 	request.OpcRetryToken = ocisdkcommon.String(string(db.UID))
+	tags := a.ownedTags(db)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 	glog.Infof("AutonomousDatabase: %s OpcRetryToken: %s", db.Name, *request.OpcRetryToken)
 
 	r, err := a.dbClient.CreateAutonomousDatabase(a.ctx, request)
@@ -523,22 +540,31 @@ func (a *AutonomousDatabaseAdapter) Update(obj runtime.Object) (runtime.Object,
 	}
 
 	c, e := a.dbClient.GetAutonomousDatabase(a.ctx, current)
+	actualTags := ocicommon.ResourceTags{FreeformTags: db.Status.Resource.FreeformTags, DefinedTags: db.Status.Resource.DefinedTags}
 	if e == nil {
+		actualTags = ocicommon.ResourceTags{FreeformTags: c.AutonomousDatabase.FreeformTags, DefinedTags: c.AutonomousDatabase.DefinedTags}
+		var tagDrift resourcescommon.Drift
+		tagDrift.CheckTags(a.ownedTags(db), actualTags)
 		if *c.AutonomousDatabase.CpuCoreCount == *db.Spec.CpuCoreCount &&
-			*c.AutonomousDatabase.DataStorageSizeInTBs == *db.Spec.DataStorageSizeInTBs {
-			glog.V(4).Infof("skipping database update because scaling parameters did not change")
+			*c.AutonomousDatabase.DataStorageSizeInTBs == *db.Spec.DataStorageSizeInTBs &&
+			tagDrift.Compliant() {
+			glog.V(4).Infof("skipping database update because scaling parameters and tags did not change")
 			return db.SetResource(&c.AutonomousDatabase), db.Status.HandleError(e)
 		}
 	} else {
 		glog.V(4).Infof("skipping database update due to inability to verify current scaling parameters")
 	}
 
+	tags := resourcescommon.MergeTags(actualTags, a.ownedTags(db))
+
 	request := ocidb.UpdateAutonomousDatabaseRequest{
 		AutonomousDatabaseId: db.Status.Resource.Id,
 		UpdateAutonomousDatabaseDetails: ocidb.UpdateAutonomousDatabaseDetails{
 			DisplayName:          resourcescommon.Display(db.Name, db.Spec.DisplayName),
 			DataStorageSizeInTBs: db.Spec.DataStorageSizeInTBs,
 			CpuCoreCount:         db.Spec.CpuCoreCount,
+			FreeformTags:         tags.FreeformTags,
+			DefinedTags:          tags.DefinedTags,
 		},
 	}
 
@@ -555,3 +581,44 @@ func (a *AutonomousDatabaseAdapter) Update(obj runtime.Object) (runtime.Object,
 func (a *AutonomousDatabaseAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the autonomous databases in the compartment tagged as managed by oci-manager
+func (a *AutonomousDatabaseAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocidb.ListAutonomousDatabasesRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.dbClient.ListAutonomousDatabases(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocidb.AutonomousDatabaseSummaryLifecycleStateTerminating && item.LifecycleState != ocidb.AutonomousDatabaseSummaryLifecycleStateTerminated {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the autonomous database with the id
+func (a *AutonomousDatabaseAdapter) DeleteOwned(id string) error {
+	_, err := a.dbClient.DeleteAutonomousDatabase(a.ctx, ocidb.DeleteAutonomousDatabaseRequest{AutonomousDatabaseId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the autonomous database with the id
+func (a *AutonomousDatabaseAdapter) ReleaseOwned(id string) error {
+	r, err := a.dbClient.GetAutonomousDatabase(a.ctx, ocidb.GetAutonomousDatabaseRequest{AutonomousDatabaseId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.dbClient.UpdateAutonomousDatabase(a.ctx, ocidb.UpdateAutonomousDatabaseRequest{
+		AutonomousDatabaseId:            &id,
+		UpdateAutonomousDatabaseDetails: ocidb.UpdateAutonomousDatabaseDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
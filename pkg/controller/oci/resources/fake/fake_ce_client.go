@@ -98,6 +98,11 @@ func (cec *ContainerEngineClient) GetWorkRequest(ctx context.Context, request oc
 	return response, nil // servicefailure{Message: "Not found", Code: "NotAuthorizedOrNotFound"}
 }
 
+// ListWorkRequestErrors returns a fake response without errors
+func (cec *ContainerEngineClient) ListWorkRequestErrors(ctx context.Context, request ocice.ListWorkRequestErrorsRequest) (response ocice.ListWorkRequestErrorsResponse, err error) {
+	return ocice.ListWorkRequestErrorsResponse{}, nil
+}
+
 // GetCluster returns a fake response
 func (cec *ContainerEngineClient) GetCluster(ctx context.Context, request ocice.GetClusterRequest) (response ocice.GetClusterResponse, err error) {
 	response = ocice.GetClusterResponse{
@@ -51,6 +51,8 @@ func (dbc *DatabaseClient) CreateAutonomousDatabase(ctx context.Context, request
 
 	db := ocidb.AutonomousDatabase{}
 	db.DbName = request.DbName
+	db.FreeformTags = request.FreeformTags
+	db.DefinedTags = request.DefinedTags
 
 	dbc.autonomousdatabases[fakeDbID] = db
 
@@ -74,6 +76,10 @@ func (dbc *DatabaseClient) GetAutonomousDatabase(ctx context.Context, request oc
 			CpuCoreCount:         &one,
 		},
 	}
+	if db, ok := dbc.autonomousdatabases[fakeDbID]; ok {
+		response.AutonomousDatabase.FreeformTags = db.FreeformTags
+		response.AutonomousDatabase.DefinedTags = db.DefinedTags
+	}
 
 	return response, nil
 }
@@ -102,6 +108,11 @@ func (dbc *DatabaseClient) StopAutonomousDatabase(ctx context.Context, request o
 func (dbc *DatabaseClient) UpdateAutonomousDatabase(ctx context.Context, request ocidb.UpdateAutonomousDatabaseRequest) (response ocidb.UpdateAutonomousDatabaseResponse, err error) {
 
 	response = ocidb.UpdateAutonomousDatabaseResponse{}
+	if db, ok := dbc.autonomousdatabases[fakeDbID]; ok {
+		db.FreeformTags = request.FreeformTags
+		db.DefinedTags = request.DefinedTags
+		dbc.autonomousdatabases[fakeDbID] = db
+	}
 
 	return response, nil
 }
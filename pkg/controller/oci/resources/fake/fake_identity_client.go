@@ -56,6 +56,23 @@ func (cc *IdentityClient) GetCompartment(ctx context.Context, request ociid.GetC
 	return response, nil
 }
 
+// UpdateCompartment returns a fake response for UpdateCompartment
+func (cc *IdentityClient) UpdateCompartment(ctx context.Context, request ociid.UpdateCompartmentRequest) (response ociid.UpdateCompartmentResponse, err error) {
+	response = ociid.UpdateCompartmentResponse{}
+	response.Compartment = ociid.Compartment{
+		Id:           request.CompartmentId,
+		FreeformTags: request.FreeformTags,
+		DefinedTags:  request.DefinedTags,
+	}
+	return response, nil
+}
+
+// DeleteCompartment returns a fake response for DeleteCompartment
+func (cc *IdentityClient) DeleteCompartment(ctx context.Context, request ociid.DeleteCompartmentRequest) (response ociid.DeleteCompartmentResponse, err error) {
+	delete(cc.compartments, *request.CompartmentId)
+	return ociid.DeleteCompartmentResponse{}, nil
+}
+
 // ListCompartments returns a fake response for ListCompartments
 func (cc *IdentityClient) ListCompartments(ctx context.Context, request ociid.ListCompartmentsRequest) (response ociid.ListCompartmentsResponse, err error) {
 	response = ociid.ListCompartmentsResponse{}
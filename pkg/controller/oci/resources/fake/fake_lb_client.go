@@ -146,9 +146,12 @@ func (lbc *LoadBalancerClient) CreateLoadBalancer(ctx context.Context, request o
 	wrID := FakeWorkRequestID
 	response.OpcWorkRequestId = &wrID
 
+	// the work request of the fake client always completes with fakeLoadBalancerID
 	lb := ocilb.LoadBalancer{}
-	lbID := string(uuid.NewUUID())
-	lb.Id = &lbID
+	lb.Id = &fakeLoadBalancerID
+	lb.FreeformTags = request.FreeformTags
+	lb.DefinedTags = request.DefinedTags
+	lbc.loadBalancers[fakeLoadBalancerID] = lb
 
 	return response, nil
 }
@@ -256,8 +259,11 @@ func (lbc *LoadBalancerClient) GetBackendSet(ctx context.Context, request ocilb.
 
 // GetLoadBalancer returns a fake response for GetLoadBalancer
 func (lbc *LoadBalancerClient) GetLoadBalancer(ctx context.Context, request ocilb.GetLoadBalancerRequest) (response ocilb.GetLoadBalancerResponse, err error) {
-	lb := ocilb.LoadBalancer{
-		Id: &fakeLoadBalancerID,
+	lb, ok := lbc.loadBalancers[fakeLoadBalancerID]
+	if !ok {
+		lb = ocilb.LoadBalancer{
+			Id: &fakeLoadBalancerID,
+		}
 	}
 	response = ocilb.GetLoadBalancerResponse{}
 	response.LoadBalancer = lb
@@ -313,7 +319,14 @@ func (lbc *LoadBalancerClient) UpdateListener(ctx context.Context, request ocilb
 func (lbc *LoadBalancerClient) UpdateLoadBalancer(ctx context.Context, request ocilb.UpdateLoadBalancerRequest) (response ocilb.UpdateLoadBalancerResponse, err error) {
 	lbID := *request.LoadBalancerId
 
-	if _, ok := lbc.loadBalancers[lbID]; ok {
+	if lb, ok := lbc.loadBalancers[lbID]; ok {
+		if request.FreeformTags != nil {
+			lb.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			lb.DefinedTags = request.DefinedTags
+		}
+		lbc.loadBalancers[lbID] = lb
 		response = ocilb.UpdateLoadBalancerResponse{}
 		workRequestID := FakeWorkRequestID
 		response.OpcWorkRequestId = &workRequestID
@@ -92,6 +92,11 @@ func (vcnc *VcnClient) CreateInternetGateway(ctx context.Context, request ocicor
 	ig := ocicore.InternetGateway{}
 	ocid := string(uuid.NewUUID())
 	ig.Id = &ocid
+	ig.CompartmentId = request.CompartmentId
+	ig.VcnId = request.VcnId
+	ig.DisplayName = request.DisplayName
+	ig.FreeformTags = request.FreeformTags
+	ig.DefinedTags = request.DefinedTags
 	vcnc.internetGateways[ocid] = ig
 
 	response.InternetGateway = ig
@@ -103,6 +108,13 @@ func (vcnc *VcnClient) UpdateInternetGateway(ctx context.Context, request ocicor
 	response = ocicore.UpdateInternetGatewayResponse{}
 
 	if ig, ok := vcnc.internetGateways[*request.IgId]; ok {
+		if request.FreeformTags != nil {
+			ig.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			ig.DefinedTags = request.DefinedTags
+		}
+		vcnc.internetGateways[*request.IgId] = ig
 		response.InternetGateway = ig
 		return response, nil
 	}
@@ -136,6 +148,13 @@ func (vcnc *VcnClient) CreateSubnet(ctx context.Context, request ocicore.CreateS
 	subnet := ocicore.Subnet{}
 	ocid := string(uuid.NewUUID())
 	subnet.Id = &ocid
+	subnet.CompartmentId = request.CompartmentId
+	subnet.VcnId = request.VcnId
+	subnet.DisplayName = request.DisplayName
+	subnet.RouteTableId = request.RouteTableId
+	subnet.SecurityListIds = request.SecurityListIds
+	subnet.FreeformTags = request.FreeformTags
+	subnet.DefinedTags = request.DefinedTags
 	vcnc.subnets[ocid] = subnet
 
 	response = ocicore.CreateSubnetResponse{}
@@ -156,6 +175,13 @@ func (vcnc *VcnClient) DeleteSubnet(ctx context.Context, request ocicore.DeleteS
 func (vcnc *VcnClient) UpdateSubnet(ctx context.Context, request ocicore.UpdateSubnetRequest) (response ocicore.UpdateSubnetResponse, err error) {
 
 	if subnet, ok := vcnc.subnets[*request.SubnetId]; ok {
+		if request.FreeformTags != nil {
+			subnet.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			subnet.DefinedTags = request.DefinedTags
+		}
+		vcnc.subnets[*request.SubnetId] = subnet
 		response = ocicore.UpdateSubnetResponse{}
 		response.Subnet = subnet
 		return response, nil
@@ -181,6 +207,11 @@ func (vcnc *VcnClient) CreateSecurityList(ctx context.Context, request ocicore.C
 	sl := ocicore.SecurityList{}
 	ocid := string(uuid.NewUUID())
 	sl.Id = &ocid
+	sl.CompartmentId = request.CompartmentId
+	sl.VcnId = request.VcnId
+	sl.DisplayName = request.DisplayName
+	sl.FreeformTags = request.FreeformTags
+	sl.DefinedTags = request.DefinedTags
 	vcnc.securityLists[ocid] = sl
 
 	response = ocicore.CreateSecurityListResponse{}
@@ -192,6 +223,13 @@ func (vcnc *VcnClient) CreateSecurityList(ctx context.Context, request ocicore.C
 func (vcnc *VcnClient) UpdateSecurityList(ctx context.Context, request ocicore.UpdateSecurityListRequest) (response ocicore.UpdateSecurityListResponse, err error) {
 
 	if sl, ok := vcnc.securityLists[*request.SecurityListId]; ok {
+		if request.FreeformTags != nil {
+			sl.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			sl.DefinedTags = request.DefinedTags
+		}
+		vcnc.securityLists[*request.SecurityListId] = sl
 		response = ocicore.UpdateSecurityListResponse{}
 		response.SecurityList = sl
 		return response, nil
@@ -217,6 +255,12 @@ func (vcnc *VcnClient) CreateRouteTable(ctx context.Context, request ocicore.Cre
 	rt := ocicore.RouteTable{}
 	ocid := string(uuid.NewUUID())
 	rt.Id = &ocid
+	rt.CompartmentId = request.CompartmentId
+	rt.VcnId = request.VcnId
+	rt.DisplayName = request.DisplayName
+	rt.RouteRules = request.RouteRules
+	rt.FreeformTags = request.FreeformTags
+	rt.DefinedTags = request.DefinedTags
 	vcnc.routeTables[ocid] = rt
 
 	response = ocicore.CreateRouteTableResponse{}
@@ -228,6 +272,13 @@ func (vcnc *VcnClient) CreateRouteTable(ctx context.Context, request ocicore.Cre
 func (vcnc *VcnClient) UpdateRouteTable(ctx context.Context, request ocicore.UpdateRouteTableRequest) (response ocicore.UpdateRouteTableResponse, err error) {
 
 	if rt, ok := vcnc.routeTables[*request.RtId]; ok {
+		if request.FreeformTags != nil {
+			rt.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			rt.DefinedTags = request.DefinedTags
+		}
+		vcnc.routeTables[*request.RtId] = rt
 		response = ocicore.UpdateRouteTableResponse{}
 		response.RouteTable = rt
 		return response, nil
@@ -262,9 +313,12 @@ func (vcnc *VcnClient) CreateVcn(ctx context.Context, request ocicore.CreateVcnR
 	vcn := ocicore.Vcn{}
 	ocid := string(uuid.NewUUID())
 	vcn.Id = &ocid
+	vcn.CompartmentId = request.CompartmentId
 	vcn.DisplayName = request.DisplayName
 	vcn.CidrBlock = request.CidrBlock
 	vcn.DnsLabel = request.DnsLabel
+	vcn.FreeformTags = request.FreeformTags
+	vcn.DefinedTags = request.DefinedTags
 
 	vcnc.vcns[ocid] = vcn
 
@@ -292,9 +346,89 @@ func (vcnc *VcnClient) GetVcn(ctx context.Context, request ocicore.GetVcnRequest
 	return response, servicefailure{Message: "Not found", Code: "NotAuthorizedOrNotFound"}
 }
 
+// ListVcns returns a fake response for ListVcns with the vcns of the compartment
+func (vcnc *VcnClient) ListVcns(ctx context.Context, request ocicore.ListVcnsRequest) (response ocicore.ListVcnsResponse, err error) {
+	response = ocicore.ListVcnsResponse{}
+	for _, vcn := range vcnc.vcns {
+		if vcn.CompartmentId != nil && *vcn.CompartmentId == *request.CompartmentId {
+			response.Items = append(response.Items, vcn)
+		}
+	}
+	return response, nil
+}
+
+// ListSubnets returns a fake response for ListSubnets with the subnets of the compartment and vcn
+func (vcnc *VcnClient) ListSubnets(ctx context.Context, request ocicore.ListSubnetsRequest) (response ocicore.ListSubnetsResponse, err error) {
+	response = ocicore.ListSubnetsResponse{}
+	for _, subnet := range vcnc.subnets {
+		if inVcn(subnet.CompartmentId, subnet.VcnId, request.CompartmentId, request.VcnId) {
+			response.Items = append(response.Items, subnet)
+		}
+	}
+	return response, nil
+}
+
+// ListRouteTables returns a fake response for ListRouteTables with the route tables of the compartment and vcn
+func (vcnc *VcnClient) ListRouteTables(ctx context.Context, request ocicore.ListRouteTablesRequest) (response ocicore.ListRouteTablesResponse, err error) {
+	response = ocicore.ListRouteTablesResponse{}
+	for _, rt := range vcnc.routeTables {
+		if inVcn(rt.CompartmentId, rt.VcnId, request.CompartmentId, request.VcnId) {
+			response.Items = append(response.Items, rt)
+		}
+	}
+	return response, nil
+}
+
+// ListSecurityLists returns a fake response for ListSecurityLists with the security lists of the compartment and vcn
+func (vcnc *VcnClient) ListSecurityLists(ctx context.Context, request ocicore.ListSecurityListsRequest) (response ocicore.ListSecurityListsResponse, err error) {
+	response = ocicore.ListSecurityListsResponse{}
+	for _, sl := range vcnc.securityLists {
+		if inVcn(sl.CompartmentId, sl.VcnId, request.CompartmentId, request.VcnId) {
+			response.Items = append(response.Items, sl)
+		}
+	}
+	return response, nil
+}
+
+// ListInternetGateways returns a fake response for ListInternetGateways with the internet gateways of the compartment and vcn
+func (vcnc *VcnClient) ListInternetGateways(ctx context.Context, request ocicore.ListInternetGatewaysRequest) (response ocicore.ListInternetGatewaysResponse, err error) {
+	response = ocicore.ListInternetGatewaysResponse{}
+	for _, ig := range vcnc.internetGateways {
+		if inVcn(ig.CompartmentId, ig.VcnId, request.CompartmentId, request.VcnId) {
+			response.Items = append(response.Items, ig)
+		}
+	}
+	return response, nil
+}
+
+// ListDhcpOptions returns a fake response for ListDhcpOptions with the dhcp options of the compartment and vcn
+func (vcnc *VcnClient) ListDhcpOptions(ctx context.Context, request ocicore.ListDhcpOptionsRequest) (response ocicore.ListDhcpOptionsResponse, err error) {
+	response = ocicore.ListDhcpOptionsResponse{}
+	for _, dhcp := range vcnc.dhcpOptions {
+		if inVcn(dhcp.CompartmentId, dhcp.VcnId, request.CompartmentId, request.VcnId) {
+			response.Items = append(response.Items, dhcp)
+		}
+	}
+	return response, nil
+}
+
+func inVcn(compartmentId, vcnId, requestCompartmentId, requestVcnId *string) bool {
+	return compartmentId != nil && vcnId != nil && *compartmentId == *requestCompartmentId && *vcnId == *requestVcnId
+}
+
 // UpdateVcn returns a fake response for UpdateVcn
 func (vcnc *VcnClient) UpdateVcn(ctx context.Context, request ocicore.UpdateVcnRequest) (response ocicore.UpdateVcnResponse, err error) {
 	if vcn, ok := vcnc.vcns[*request.VcnId]; ok {
+		if request.DisplayName != nil {
+			vcn.DisplayName = request.DisplayName
+		}
+		if request.FreeformTags != nil {
+			vcn.FreeformTags = request.FreeformTags
+		}
+		if request.DefinedTags != nil {
+			vcn.DefinedTags = request.DefinedTags
+		}
+		vcnc.vcns[*request.VcnId] = vcn
 		response = ocicore.UpdateVcnResponse{}
 		response.Vcn = vcn
 		return response, nil
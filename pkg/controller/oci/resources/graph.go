@@ -0,0 +1,424 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Sources of a dependency edge
+const (
+	// EdgeDependsOn is an explicit DependsOn selector of the dependent
+	EdgeDependsOn = "dependsOn"
+	// EdgeRef is a *Ref field of the dependent
+	EdgeRef = "ref"
+)
+
+// DependencyGraph is the dependency graph of the managed resources. An edge points
+// from a dependent to the resource it depends on, nodes are named Kind/namespace/name.
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+	// Cycles lists the nodes of every dependency cycle
+	Cycles [][]string `json:"cycles,omitempty"`
+	// Dangling lists the references to objects that do not exist
+	Dangling []DanglingRef `json:"dangling,omitempty"`
+}
+
+// GraphNode is a resource object of the dependency graph
+type GraphNode struct {
+	ID         string `json:"id"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	ResourceID string `json:"resourceId,omitempty"`
+	State      string `json:"state,omitempty"`
+	Deleting   bool   `json:"deleting,omitempty"`
+	// Error is set if the dependencies of the object could not be resolved
+	Error string `json:"error,omitempty"`
+}
+
+// GraphEdge is a dependency of From on To
+type GraphEdge struct {
+	From    string   `json:"from"`
+	To      string   `json:"to"`
+	Sources []string `json:"sources"`
+}
+
+// DanglingRef is a reference of Dependent to a Parent that does not exist
+type DanglingRef struct {
+	Parent    string `json:"parent"`
+	Dependent string `json:"dependent"`
+}
+
+func nodeID(kind, key string) string {
+	return kind + "/" + key
+}
+
+// BuildDependencyGraph builds the dependency graph of the objects in the informer
+// caches of the controllers, keyed by kind
+func BuildDependencyGraph(controllers map[string]*Controller) *DependencyGraph {
+	g := &DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	kinds, refKinds := kindsByPlural(), registeredKinds()
+
+	var objects []runtime.Object
+	var owners []*Controller
+	nodes := map[string]int{}
+	for _, kind := range sortedKinds(controllers) {
+		c := controllers[kind]
+		for _, item := range c.informer.GetStore().List() {
+			obj, ok := item.(runtime.Object)
+			if !ok {
+				continue
+			}
+			objectmeta := c.adapter.ObjectMeta(obj)
+			node := GraphNode{
+				ID:         nodeID(kind, objectmeta.GetNamespace()+"/"+objectmeta.GetName()),
+				Kind:       kind,
+				Namespace:  objectmeta.GetNamespace(),
+				Name:       objectmeta.GetName(),
+				ResourceID: c.adapter.Id(obj),
+				Deleting:   objectmeta.GetDeletionTimestamp() != nil,
+			}
+			if status := resourceStatus(obj); status != nil {
+				node.State = string(status.State)
+			}
+			nodes[node.ID] = len(g.Nodes)
+			g.Nodes = append(g.Nodes, node)
+			objects = append(objects, obj)
+			owners = append(owners, c)
+		}
+	}
+
+	edges := map[[2]string]int{}
+	addEdge := func(from, to, source string) {
+		i, ok := edges[[2]string{from, to}]
+		if !ok {
+			i = len(g.Edges)
+			edges[[2]string{from, to}] = i
+			g.Edges = append(g.Edges, GraphEdge{From: from, To: to})
+		}
+		for _, s := range g.Edges[i].Sources {
+			if s == source {
+				return
+			}
+		}
+		g.Edges[i].Sources = append(g.Edges[i].Sources, source)
+	}
+
+	for i, obj := range objects {
+		c, node := owners[i], &g.Nodes[i]
+		var errs []string
+
+		dependsOn := c.adapter.DependsOn(obj)
+		for _, resource := range sortedResources(dependsOn) {
+			deps, err := c.listDependsOn(resource, dependsOn[resource])
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("dependson %s: %v", resource, err))
+				continue
+			}
+			for _, dep := range deps {
+				if id, ok := objectID(dep, kinds); ok {
+					addEdge(node.ID, id, EdgeDependsOn)
+				}
+			}
+		}
+
+		node.Error = strings.Join(errs, "; ")
+
+		for _, id := range specRefs(obj, refKinds) {
+			if _, ok := nodes[id]; ok {
+				addEdge(node.ID, id, EdgeRef)
+			} else if _, ok := controllers[strings.SplitN(id, "/", 2)[0]]; ok {
+				g.Dangling = append(g.Dangling, DanglingRef{Dependent: node.ID, Parent: id})
+			}
+		}
+	}
+
+	for _, e := range g.Edges {
+		sort.Strings(e.Sources)
+	}
+	g.Cycles = findCycles(g.Edges)
+	return g
+}
+
+// objectID returns the node of an object returned by an adapter, these do not
+// necessarily have their type meta set so the kind is looked up by resource
+func objectID(obj runtime.Object, kinds map[string]string) (string, bool) {
+	resource, ok := obj.(ocicommon.ObjectInterface)
+	if !ok {
+		return "", false
+	}
+	kind, ok := kinds[resource.GetResourcePlural()]
+	if !ok {
+		return "", false
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return "", false
+	}
+	return nodeID(kind, key), true
+}
+
+// kindsByPlural maps the resource plural of the registered resource types to their kind
+func kindsByPlural() map[string]string {
+	kinds := map[string]string{}
+	for kind, resourceType := range resourcescommon.ResourceTypes() {
+		kinds[resourceType.ResourcePlural] = kind
+	}
+	return kinds
+}
+
+// findCycles returns the strongly connected components of the graph that form a cycle
+func findCycles(edges []GraphEdge) [][]string {
+	adjacent := map[string][]string{}
+	for _, e := range edges {
+		adjacent[e.From] = append(adjacent[e.From], e.To)
+	}
+	for _, to := range adjacent {
+		sort.Strings(to)
+	}
+	from := make([]string, 0, len(adjacent))
+	for id := range adjacent {
+		from = append(from, id)
+	}
+	sort.Strings(from)
+
+	// Tarjan's strongly connected components
+	index, lowlink := map[string]int{}, map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var cycles [][]string
+	var connect func(v string)
+	connect = func(v string) {
+		index[v], lowlink[v] = len(index), len(index)
+		stack = append(stack, v)
+		onStack[v] = true
+		selfLoop := false
+		for _, w := range adjacent[v] {
+			if w == v {
+				selfLoop = true
+			}
+			if _, visited := index[w]; !visited {
+				connect(w)
+				if lowlink[w] < lowlink[v] {
+					lowlink[v] = lowlink[w]
+				}
+			} else if onStack[w] && index[w] < lowlink[v] {
+				lowlink[v] = index[w]
+			}
+		}
+		if lowlink[v] != index[v] {
+			return
+		}
+		var component []string
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			sort.Strings(component)
+			cycles = append(cycles, component)
+		}
+	}
+	for _, v := range from {
+		if _, visited := index[v]; !visited {
+			connect(v)
+		}
+	}
+	return cycles
+}
+
+// WriteDot writes the graph in Graphviz DOT format, nodes and edges of cycles are
+// drawn red and dangling references dashed
+func (g *DependencyGraph) WriteDot(w io.Writer) error {
+	inCycle := map[string]bool{}
+	for _, cycle := range g.Cycles {
+		for _, id := range cycle {
+			inCycle[id] = true
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("\trankdir=LR;\n\tnode [shape=box];\n")
+	for _, n := range g.Nodes {
+		attrs := []string{fmt.Sprintf("label=%q", n.ID+"\\n"+n.State)}
+		if inCycle[n.ID] {
+			attrs = append(attrs, "color=red")
+		}
+		if n.Error != "" || n.Deleting {
+			attrs = append(attrs, "style=dashed")
+		}
+		fmt.Fprintf(&b, "\t%q [%s];\n", n.ID, strings.Join(attrs, ", "))
+	}
+	for _, e := range g.Edges {
+		attrs := []string{fmt.Sprintf("label=%q", strings.Join(e.Sources, ","))}
+		if inCycle[e.From] && inCycle[e.To] {
+			attrs = append(attrs, "color=red")
+		}
+		fmt.Fprintf(&b, "\t%q -> %q [%s];\n", e.From, e.To, strings.Join(attrs, ", "))
+	}
+	for _, d := range g.Dangling {
+		fmt.Fprintf(&b, "\t%q [style=dashed, color=red, label=%q];\n", d.Parent, d.Parent+"\\nmissing")
+		fmt.Fprintf(&b, "\t%q -> %q [style=dashed, color=red, label=%q];\n", d.Dependent, d.Parent, EdgeRef)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// GraphHandler serves the dependency graph of the registered controllers as JSON,
+// or as Graphviz DOT with ?format=dot
+type GraphHandler struct {
+	lock        sync.RWMutex
+	controllers map[string]*Controller
+}
+
+// NewGraphHandler returns a graph handler without controllers
+func NewGraphHandler() *GraphHandler {
+	return &GraphHandler{controllers: make(map[string]*Controller)}
+}
+
+// Register adds the objects of a controller to the graph
+func (h *GraphHandler) Register(c *Controller) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.controllers[c.adapter.Kind()] = c
+}
+
+func (h *GraphHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.lock.RLock()
+	g := BuildDependencyGraph(h.controllers)
+	h.lock.RUnlock()
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		g.WriteDot(w)
+	case "", "json":
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(g)
+	default:
+		http.Error(w, fmt.Sprintf("unknown format %q, expected json or dot", format), http.StatusBadRequest)
+	}
+}
+
+// dependencyCycleError is returned when registering a dependent would close a cycle
+type dependencyCycleError struct {
+	cycle []string
+}
+
+func (e *dependencyCycleError) Error() string {
+	return "dependency cycle: " + strings.Join(e.cycle, " -> ")
+}
+
+// dependencyCycle returns the cycle that obj depending on parent would close, or nil.
+// The dependents of obj are followed through the dependency index, if parent is among
+// them it already depends on obj.
+func (c *Controller) dependencyCycle(obj, parent runtime.Object) []string {
+	parentID, ok := objectID(parent, kindsByPlural())
+	if !ok {
+		return nil
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return nil
+	}
+	start := nodeID(c.adapter.Kind(), key)
+	if start == parentID {
+		return []string{start, start}
+	}
+
+	// breadth first through the dependents, next points towards obj
+	next := map[string]string{start: ""}
+	queue := []string{start}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		var dependents map[string][]string
+		if id == start {
+			dependents = c.dependents(obj)
+		} else {
+			parts := strings.SplitN(id, "/", 2)
+			dependents = c.dependentsOf(parts[0], parts[1], nil)
+		}
+		for _, kind := range sortedKeys(dependents) {
+			for _, depKey := range dependents[kind] {
+				dep := nodeID(kind, depKey)
+				if _, seen := next[dep]; seen {
+					continue
+				}
+				next[dep] = id
+				if dep == parentID {
+					cycle := []string{start}
+					for n := dep; n != ""; n = next[n] {
+						cycle = append(cycle, n)
+					}
+					return cycle
+				}
+				queue = append(queue, dep)
+			}
+		}
+	}
+	return nil
+}
+
+func sortedKinds(controllers map[string]*Controller) []string {
+	kinds := make([]string, 0, len(controllers))
+	for kind := range controllers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+func sortedResources(dependsOn map[string]ocicommon.DependsOn) []string {
+	resources := make([]string, 0, len(dependsOn))
+	for resource := range dependsOn {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func testGraphVcn(name string, labels map[string]string, dependsOn map[string]string) *corev1alpha1.Vcn {
+	vcn := &corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  fakeNs,
+			Labels:     labels,
+			Finalizers: []string{OciGroupName},
+		},
+		Spec: corev1alpha1.VcnSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+		},
+	}
+	if dependsOn != nil {
+		vcn.Spec.DependsOn = map[string]ocicommon.DependsOn{
+			corev1alpha1.VirtualNetworkResourcePlural: {LabelSelector: dependsOn},
+		}
+	}
+	return vcn
+}
+
+func testGraphSubnet(name, vcnRef string) *corev1alpha1.Subnet {
+	return &corev1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  fakeNs,
+			Finalizers: []string{OciGroupName},
+		},
+		Spec: corev1alpha1.SubnetSpec{
+			CompartmentRef: "ocid1.compartment.oc1..aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q",
+			VcnRef:         vcnRef,
+		},
+	}
+}
+
+// newGraphControllers starts vcn and subnet controllers without workers sharing one informer factory
+func newGraphControllers(objects ...runtime.Object) (map[string]*Controller, chan struct{}) {
+	clientset := fakeclient.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	workQueues := map[string]workqueue.RateLimitingInterface{}
+	adapters := []resourcescommon.ResourceTypeAdapter{
+		coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient()),
+		&coreresources.SubnetAdapter{},
+	}
+	for _, adapter := range adapters {
+		workQueues[adapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	}
+	controllers := map[string]*Controller{}
+	for _, adapter := range adapters {
+		controllers[adapter.Kind()] = New(adapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	}
+
+	stopCh := make(chan struct{})
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	return controllers, stopCh
+}
+
+func vcnID(name string) string {
+	return nodeID(corev1alpha1.VirtualNetworkKind, fakeNs+"/"+name)
+}
+
+func TestBuildDependencyGraph(t *testing.T) {
+	controllers, stopCh := newGraphControllers(
+		testGraphVcn("vcn1", map[string]string{"tier": "core"}, nil),
+		testGraphVcn("vcn2", map[string]string{"tier": "app"}, map[string]string{"tier": "core"}),
+		testGraphVcn("vcn3", map[string]string{"tier": "core"}, map[string]string{"tier": "app"}),
+		testGraphSubnet("subnet1", "vcn1"),
+		testGraphSubnet("subnet2", "gone"),
+	)
+	defer close(stopCh)
+
+	g := BuildDependencyGraph(controllers)
+
+	if len(g.Nodes) != 5 {
+		t.Errorf("Expected 5 nodes, got %v", g.Nodes)
+	}
+	subnet1 := nodeID(corev1alpha1.SubnetKind, fakeNs+"/subnet1")
+	expectedEdges := map[[2]string][]string{
+		{vcnID("vcn2"), vcnID("vcn1")}: {EdgeDependsOn},
+		{vcnID("vcn2"), vcnID("vcn3")}: {EdgeDependsOn},
+		{vcnID("vcn3"), vcnID("vcn2")}: {EdgeDependsOn},
+		{subnet1, vcnID("vcn1")}:       {EdgeRef},
+	}
+	edges := map[[2]string][]string{}
+	for _, e := range g.Edges {
+		edges[[2]string{e.From, e.To}] = e.Sources
+	}
+	if !reflect.DeepEqual(edges, expectedEdges) {
+		t.Errorf("Expected edges %v, got %v", expectedEdges, edges)
+	}
+	expectedCycles := [][]string{{vcnID("vcn2"), vcnID("vcn3")}}
+	if !reflect.DeepEqual(g.Cycles, expectedCycles) {
+		t.Errorf("Expected cycles %v, got %v", expectedCycles, g.Cycles)
+	}
+	expectedDangling := []DanglingRef{{Dependent: nodeID(corev1alpha1.SubnetKind, fakeNs+"/subnet2"), Parent: vcnID("gone")}}
+	if !reflect.DeepEqual(g.Dangling, expectedDangling) {
+		t.Errorf("Expected dangling %v, got %v", expectedDangling, g.Dangling)
+	}
+
+	handler := NewGraphHandler()
+	for _, controller := range controllers {
+		handler.Register(controller)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/dependencies", nil))
+	served := DependencyGraph{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &served); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if !reflect.DeepEqual(served.Cycles, expectedCycles) {
+		t.Errorf("Expected served cycles %v, got %v", expectedCycles, served.Cycles)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/dependencies?format=dot", nil))
+	dot := recorder.Body.String()
+	if !strings.HasPrefix(dot, "digraph dependencies {") ||
+		!strings.Contains(dot, fmt.Sprintf(`%q -> %q [label="dependsOn", color=red];`, vcnID("vcn2"), vcnID("vcn3"))) {
+		t.Errorf("Unexpected DOT output:\n%s", dot)
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/dependencies?format=xml", nil))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("Expected bad request for unknown format, got %d", recorder.Code)
+	}
+}
+
+func TestFindCycles(t *testing.T) {
+	edges := []GraphEdge{
+		{From: "a", To: "b"}, {From: "b", To: "c"}, {From: "c", To: "a"},
+		{From: "c", To: "d"}, {From: "d", To: "e"},
+		{From: "f", To: "f"},
+	}
+	expected := [][]string{{"a", "b", "c"}, {"f"}}
+	if cycles := findCycles(edges); !reflect.DeepEqual(cycles, expected) {
+		t.Errorf("Expected cycles %v, got %v", expected, cycles)
+	}
+	if cycles := findCycles(edges[3:5]); cycles != nil {
+		t.Errorf("Expected no cycles, got %v", cycles)
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	vcn1 := testGraphVcn("vcn1", nil, map[string]string{"tier": "b"})
+	vcn2 := testGraphVcn("vcn2", map[string]string{"tier": "b"}, map[string]string{"tier": "c"})
+	vcn3 := testGraphVcn("vcn3", map[string]string{"tier": "c"}, nil)
+	vcn4 := testGraphVcn("vcn4", map[string]string{"tier": "d"}, map[string]string{"tier": "e"})
+	vcn5 := testGraphVcn("vcn5", map[string]string{"tier": "e"}, map[string]string{"tier": "d"})
+	controllers, stopCh := newGraphControllers(vcn1, vcn2, vcn3, vcn4, vcn5)
+	defer close(stopCh)
+	controller := controllers[corev1alpha1.VirtualNetworkKind]
+
+	// vcn1 depends on vcn2 which depends on vcn3
+	expected := []string{vcnID("vcn3"), vcnID("vcn1"), vcnID("vcn2"), vcnID("vcn3")}
+	if cycle := controller.dependencyCycle(vcn3, vcn1); !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("Expected cycle %v, got %v", expected, cycle)
+	}
+	if cycle := controller.dependencyCycle(vcn1, vcn1); len(cycle) != 2 {
+		t.Errorf("Expected a self dependency to be a cycle, got %v", cycle)
+	}
+	if cycle := controller.dependencyCycle(vcn1, vcn3); cycle != nil {
+		t.Errorf("Expected no cycle, got %v", cycle)
+	}
+
+	// vcn4 and vcn5 select each other
+	reconciled, err, retry := controller.reconcile(fakeNs + "/vcn4")
+	if err != nil || retry {
+		t.Fatalf("Expected the cycle to be recorded without retry, got %v %v", err, retry)
+	}
+	status := reconciled.(*corev1alpha1.Vcn).Status
+	condition := status.GetCondition(ocicommon.ConditionDependenciesReady)
+	if condition == nil || condition.Reason != reasonDependencyCycle || !strings.Contains(condition.Message, vcnID("vcn5")) {
+		t.Errorf("Expected DependencyCycle condition, got %#v", condition)
+	}
+}
@@ -47,11 +47,13 @@ const (
 
 // Adapter functions
 func init() {
-	resourcescommon.RegisterResourceType(
+	resourcescommon.RegisterResourceTypeWithValidation(
 		identitygroup.GroupName,
 		ociidentityv1alpha1.CompartmentKind,
 		ociidentityv1alpha1.CompartmentResourcePlural,
 		ociidentityv1alpha1.CompartmentControllerName,
+		&ociidentityv1alpha1.CompartmentValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewCompartmentAdapter)
 }
 
@@ -83,14 +85,21 @@ func NewCompartmentAdapter(clientset versioned.Interface, kubeclient kubernetes.
 		os.Exit(1)
 	}
 
-	ca.ociIdClient = &idClient
-	ca.ociCoreComputeClient = &computeClient
+	ca.ociIdClient = resourcescommon.InstrumentIdentityClient(&idClient)
+	ca.ociCoreComputeClient = resourcescommon.InstrumentComputeClient(&computeClient)
 	ca.clientset = clientset
 	ca.tenancyId, _ = ociconfig.TenancyOCID()
 	ca.ctx = context.Background()
 	return &ca
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *CompartmentAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *CompartmentAdapter) Kind() string {
 	return ociidentityv1alpha1.CompartmentKind
@@ -144,28 +153,29 @@ func (a *CompartmentAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *CompartmentAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *CompartmentAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	compartment := obj.(*ociidentityv1alpha1.Compartment)
 	if compartment.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := compartment.Status.Resource
 	if resource.LifecycleState == ociidentity.CompartmentLifecycleStateCreating ||
 		resource.LifecycleState == ociidentity.CompartmentLifecycleStateDeleting {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ociidentity.CompartmentLifecycleStateDeleted ||
 		resource.LifecycleState == ociidentity.CompartmentLifecycleStateInactive {
-		return false
+		return false, nil
 	}
 
-	if *compartment.Status.Resource.Name != compartment.Name {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("name", compartment.Name, compartment.Status.Resource.Name)
+	drift.CheckTags(a.compartmentTags(compartment),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -203,11 +213,6 @@ func (a *CompartmentAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.
 	return obj.(*ociidentityv1alpha1.Compartment).Spec.DependsOn
 }
 
-// Dependents returns a map of compartment dependents (objects that depend on the compartment)
-func (a *CompartmentAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ociidentityv1alpha1.Compartment).Status.Dependents
-}
-
 // CreateObject creates the compartment object
 func (a *CompartmentAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ociidentityv1alpha1.Compartment)
@@ -220,6 +225,12 @@ func (a *CompartmentAdapter) UpdateObject(obj runtime.Object) (runtime.Object, e
 	return a.clientset.OciidentityV1alpha1().Compartments(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the compartment object
+func (a *CompartmentAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ociidentityv1alpha1.Compartment)
+	return a.clientset.OciidentityV1alpha1().Compartments(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the compartment object
 func (a *CompartmentAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ociidentityv1alpha1.Compartment)
@@ -330,6 +341,10 @@ func (a *CompartmentAdapter) Create(obj runtime.Object) (runtime.Object, error)
 			CompartmentId: &a.tenancyId,
 		},
 	}
+	tags := resourcescommon.OwnedTags(a.Kind(), compartment,
+		ocicommon.ResourceTags{FreeformTags: compartment.Spec.FreeformTags, DefinedTags: compartment.Spec.DefinedTags})
+	createRequest.FreeformTags = tags.FreeformTags
+	createRequest.DefinedTags = tags.DefinedTags
 	resp, e := a.ociIdClient.CreateCompartment(a.ctx, createRequest)
 	if e != nil {
 		return compartment, e
@@ -386,14 +401,81 @@ func (a *CompartmentAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 	return object.SetResource(&r.Compartment), object.Status.HandleError(e)
 }
 
-// Update updates the compartment resource in oci - NOT IMPLEMENTED!
+// Update updates the tags of the compartment resource in oci, the other fields are not updated
 func (a *CompartmentAdapter) Update(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ociidentityv1alpha1.Compartment)
+
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		a.compartmentTags(object))
+	request := ociidentity.UpdateCompartmentRequest{
+		CompartmentId: object.Status.Resource.Id,
+		UpdateCompartmentDetails: ociidentity.UpdateCompartmentDetails{
+			FreeformTags: tags.FreeformTags,
+			DefinedTags:  tags.DefinedTags,
+		},
+	}
+	if _, e := a.ociIdClient.UpdateCompartment(a.ctx, request); e != nil {
+		return object, object.Status.HandleError(e)
+	}
 
-	// Update compartment not implemented
 	return a.Get(obj)
 }
 
+// compartmentTags returns the tags of the spec, with the ownership tags for a compartment
+// created by the controller. A compartment found by name is not owned by the controller.
+func (a *CompartmentAdapter) compartmentTags(compartment *ociidentityv1alpha1.Compartment) ocicommon.ResourceTags {
+	tags := ocicommon.ResourceTags{FreeformTags: compartment.Spec.FreeformTags, DefinedTags: compartment.Spec.DefinedTags}
+	if compartment.Labels[FullDeleteLabel] != "true" {
+		return tags
+	}
+	return resourcescommon.OwnedTags(a.Kind(), compartment, tags)
+}
+
 // UpdateForResource calls a common UpdateForResource method to update the compartment resource in the compartment object
 func (a *CompartmentAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the compartments in the compartment tagged as managed by oci-manager.
+// Compartments are created in the tenancy, so they are only swept with the tenancy.
+func (a *CompartmentAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ociidentity.ListCompartmentsRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.ociIdClient.ListCompartments(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ociidentity.CompartmentLifecycleStateDeleting && item.LifecycleState != ociidentity.CompartmentLifecycleStateDeleted {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.Name, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the compartment with the id, OCI refuses to delete a compartment
+// that still holds resources
+func (a *CompartmentAdapter) DeleteOwned(id string) error {
+	_, err := a.ociIdClient.DeleteCompartment(a.ctx, ociidentity.DeleteCompartmentRequest{CompartmentId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the compartment with the id
+func (a *CompartmentAdapter) ReleaseOwned(id string) error {
+	r, err := a.ociIdClient.GetCompartment(a.ctx, ociidentity.GetCompartmentRequest{CompartmentId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.ociIdClient.UpdateCompartment(a.ctx, ociidentity.UpdateCompartmentRequest{
+		CompartmentId:            &id,
+		UpdateCompartmentDetails: ociidentity.UpdateCompartmentDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
@@ -52,6 +52,7 @@ func init() {
 		ociidentityv1alpha1.PolicyResourcePlural,
 		ociidentityv1alpha1.PolicyControllerName,
 		&ociidentityv1alpha1.PolicyValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.OcidColumn),
 		NewPolicyAdapter)
 }
 
@@ -74,13 +75,20 @@ func NewPolicyAdapter(clientset versioned.Interface, kubeclient kubernetes.Inter
 		os.Exit(1)
 	}
 
-	pa.idClient = &idClient
+	pa.idClient = resourcescommon.InstrumentIdentityClient(&idClient)
 	pa.clientset = clientset
 	pa.ctx = context.Background()
 
 	return &pa
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *PolicyAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *PolicyAdapter) Kind() string {
 	return ociidentityv1alpha1.PolicyKind
@@ -127,29 +135,28 @@ func (a *PolicyAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *PolicyAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *PolicyAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	policy := obj.(*ociidentityv1alpha1.Policy)
 
 	if policy.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := policy.Status.Resource
 
 	if resource.LifecycleState == ociidentity.PolicyLifecycleStateCreating ||
 		resource.LifecycleState == ociidentity.PolicyLifecycleStateDeleting {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ociidentity.PolicyLifecycleStateDeleted ||
 		resource.LifecycleState == ociidentity.PolicyLifecycleStateInactive {
-		return false
+		return false, nil
 	}
 
-	if *resource.Name != policy.Name ||
-		resource.Description != policy.Spec.Description {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("name", policy.Name, resource.Name)
+	drift.Check("description", policy.Spec.Description, resource.Description)
 
 	specStatements := make(map[string]bool)
 	resourceStatements := make(map[string]bool)
@@ -161,7 +168,13 @@ func (a *PolicyAdapter) IsResourceCompliant(obj runtime.Object) bool {
 		resourceStatements[statement] = true
 	}
 
-	return reflect.DeepEqual(specStatements, resourceStatements)
+	if !reflect.DeepEqual(specStatements, resourceStatements) {
+		drift.Add("statements", policy.Spec.Statements, resource.Statements)
+	}
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), policy, policy.Spec.ResourceTags),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+
+	return drift.Compliant(), drift
 
 }
 
@@ -188,11 +201,6 @@ func (a *PolicyAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Depen
 	return obj.(*ociidentityv1alpha1.Policy).Spec.DependsOn
 }
 
-// Dependents returns a map of policy dependents (objects that depend on the policy)
-func (a *PolicyAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ociidentityv1alpha1.Policy).Status.Dependents
-}
-
 // CreateObject creates the policy object
 func (a *PolicyAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ociidentityv1alpha1.Policy)
@@ -205,6 +213,12 @@ func (a *PolicyAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error)
 	return a.clientset.OciidentityV1alpha1().Policies(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the policy object
+func (a *PolicyAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ociidentityv1alpha1.Policy)
+	return a.clientset.OciidentityV1alpha1().Policies(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the policy object
 func (a *PolicyAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ociidentityv1alpha1.Policy)
@@ -249,6 +263,9 @@ func (a *PolicyAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 	request.Description = policy.Spec.Description
 	request.Name = &policy.Name
 	request.Statements = policy.Spec.Statements
+	tags := resourcescommon.OwnedTags(a.Kind(), policy, policy.Spec.ResourceTags)
+	request.FreeformTags = tags.FreeformTags
+	request.DefinedTags = tags.DefinedTags
 
 	request.OpcRetryToken = ocisdkcommon.String(string(policy.UID))
 
@@ -304,9 +321,14 @@ func (a *PolicyAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 func (a *PolicyAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ociidentityv1alpha1.Policy)
 
+	tags := resourcescommon.MergeTags(
+		ocicommon.ResourceTags{FreeformTags: object.Status.Resource.FreeformTags, DefinedTags: object.Status.Resource.DefinedTags},
+		resourcescommon.OwnedTags(a.Kind(), object, object.Spec.ResourceTags))
 	details := ociidentity.UpdatePolicyDetails{
-		Description: object.Spec.Description,
-		Statements:  object.Spec.Statements,
+		Description:  object.Spec.Description,
+		Statements:   object.Spec.Statements,
+		FreeformTags: tags.FreeformTags,
+		DefinedTags:  tags.DefinedTags,
 	}
 	request := ociidentity.UpdatePolicyRequest{
 		PolicyId:            object.Status.Resource.Id,
@@ -330,3 +352,44 @@ func (a *PolicyAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 func (a *PolicyAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the policies in the compartment tagged as managed by oci-manager
+func (a *PolicyAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ociidentity.ListPoliciesRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.idClient.ListPolicies(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ociidentity.PolicyLifecycleStateDeleting && item.LifecycleState != ociidentity.PolicyLifecycleStateDeleted {
+				owned = append(owned, resourcescommon.NewOwnedResource(item.Id, item.Name, item.TimeCreated, item.FreeformTags))
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the policy with the id
+func (a *PolicyAdapter) DeleteOwned(id string) error {
+	_, err := a.idClient.DeletePolicy(a.ctx, ociidentity.DeletePolicyRequest{PolicyId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the policy with the id
+func (a *PolicyAdapter) ReleaseOwned(id string) error {
+	r, err := a.idClient.GetPolicy(a.ctx, ociidentity.GetPolicyRequest{PolicyId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.idClient.UpdatePolicy(a.ctx, ociidentity.UpdatePolicyRequest{
+		PolicyId:            &id,
+		UpdatePolicyDetails: ociidentity.UpdatePolicyDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
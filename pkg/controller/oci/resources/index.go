@@ -0,0 +1,323 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+)
+
+// dependencyIndex indexes the objects of every resource informer by what they depend on.
+// A *Ref field is indexed by the id of the referenced object. A DependsOn selector is
+// indexed by the resource it selects, whether it matches depends on the selected objects.
+// Index funcs only look at the object itself since they run with the store locked.
+const dependencyIndex = "oci.oracle.com/dependencies"
+
+func refIndexKey(id string) string {
+	return "ref:" + id
+}
+
+func selectorIndexKey(resource string) string {
+	return "dependson:" + resource
+}
+
+// indexDependencies is the index func of the dependency index
+func (c *Controller) indexDependencies(obj interface{}) ([]string, error) {
+	object, ok := obj.(runtime.Object)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, id := range specRefs(object, registeredKinds()) {
+		keys = append(keys, refIndexKey(id))
+	}
+	for resource := range c.adapter.DependsOn(object) {
+		keys = append(keys, selectorIndexKey(resource))
+	}
+	return keys, nil
+}
+
+// dependencies returns the ids of the objects obj depends on through its *Ref fields
+// and its DependsOn selectors
+func (c *Controller) dependencies(obj runtime.Object) []string {
+	ids := specRefs(obj, registeredKinds())
+	kinds := kindsByPlural()
+	dependsOn := c.adapter.DependsOn(obj)
+	for _, resource := range sortedResources(dependsOn) {
+		deps, err := c.listDependsOn(resource, dependsOn[resource])
+		if err != nil {
+			continue
+		}
+		for _, dep := range deps {
+			if id, ok := objectID(dep, kinds); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// enqueueDependencies signals the objects obj depends on about a change of obj,
+// i.e. a parent waiting for its dependents to be deleted
+func (c *Controller) enqueueDependencies(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	object, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+	for _, id := range c.dependencies(object) {
+		parts := strings.SplitN(id, "/", 2)
+		if queue, ok := c.queueMap[parts[0]]; ok {
+			queue.Add(parts[1])
+		}
+	}
+}
+
+// dependents returns the keys of the objects depending on obj by kind
+func (c *Controller) dependents(obj runtime.Object) map[string][]string {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return nil
+	}
+	return c.dependentsOf(c.adapter.Kind(), key, obj)
+}
+
+// dependentsOf looks up the objects depending on the object of kind with key in the
+// dependency index of every resource informer. The object is read from the informer
+// cache if parent is nil, without it only the references by name are found.
+func (c *Controller) dependentsOf(kind, key string, parent runtime.Object) map[string][]string {
+	resourceTypes := resourcescommon.ResourceTypes()
+	parentType, ok := resourceTypes[kind]
+	if !ok {
+		return nil
+	}
+	if parent == nil {
+		if informer := c.resourceInformer(parentType); informer != nil {
+			if item, exists, err := informer.GetStore().GetByKey(key); err == nil && exists {
+				parent = item.(runtime.Object)
+			}
+		}
+	}
+
+	dependents := map[string][]string{}
+	for depKind, depType := range resourceTypes {
+		informer := c.resourceInformer(depType)
+		if informer == nil {
+			continue
+		}
+		indexer := informer.GetIndexer()
+		if _, ok := indexer.GetIndexers()[dependencyIndex]; !ok {
+			continue
+		}
+
+		found := map[string]bool{}
+		refs, _ := indexer.ByIndex(dependencyIndex, refIndexKey(nodeID(kind, key)))
+		for _, ref := range refs {
+			if depKey, err := cache.MetaNamespaceKeyFunc(ref); err == nil {
+				found[depKey] = true
+			}
+		}
+		if parent != nil {
+			candidates, _ := indexer.ByIndex(dependencyIndex, selectorIndexKey(parentType.ResourcePlural))
+			for _, candidate := range candidates {
+				depKey, err := cache.MetaNamespaceKeyFunc(candidate)
+				if err != nil || found[depKey] {
+					continue
+				}
+				if selects(specDependsOn(candidate)[parentType.ResourcePlural], parent) {
+					found[depKey] = true
+				}
+			}
+		}
+
+		if depKind == kind {
+			// an object selecting its own kind does not depend on itself
+			delete(found, key)
+		}
+		if len(found) == 0 {
+			continue
+		}
+		for depKey := range found {
+			dependents[depKind] = append(dependents[depKind], depKey)
+		}
+		sort.Strings(dependents[depKind])
+	}
+	return dependents
+}
+
+// selects reports if a DependsOn relation selects obj
+func selects(dependsOn ocicommon.DependsOn, obj runtime.Object) bool {
+	objectmeta, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	selector, err := dependsOnSelector(dependsOn)
+	if err != nil || !selector.Matches(labels.Set(objectmeta.GetLabels())) {
+		return false
+	}
+	if len(dependsOn.FieldSelector) == 0 {
+		return true
+	}
+	match, err := matchesFields(obj, dependsOn.FieldSelector)
+	return err == nil && match
+}
+
+// resourceInformer returns the informer of a resource type from the shared factory
+func (c *Controller) resourceInformer(resourceType resourcescommon.ResourceType) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{
+		Group:    resourceType.GroupName,
+		Version:  c.adapter.GroupVersionWithResource().Version,
+		Resource: resourceType.ResourcePlural,
+	}
+	if gvr == c.adapter.GroupVersionWithResource() {
+		return c.informer
+	}
+	informer, err := c.factory.ForResource(gvr)
+	if err != nil {
+		return nil
+	}
+	return informer.Informer()
+}
+
+// registeredKinds returns the kinds of the registered resource types
+func registeredKinds() []string {
+	var kinds []string
+	for kind := range resourcescommon.ResourceTypes() {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+// specDependsOn returns the DependsOn relations of any resource object, it is read
+// from the spec since the adapter of the kind is not at hand
+func specDependsOn(obj interface{}) map[string]ocicommon.DependsOn {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	spec := v.FieldByName("Spec")
+	if !spec.IsValid() || spec.Kind() != reflect.Struct {
+		return nil
+	}
+	field := spec.FieldByName("DependsOn")
+	if !field.IsValid() {
+		return nil
+	}
+	dependsOn, _ := field.Interface().(map[string]ocicommon.DependsOn)
+	return dependsOn
+}
+
+// specRefs returns the ids of the objects named by the *Ref and *Refs fields of the
+// spec. The referenced kind is the longest kind the field name ends with, e.g.
+// ServiceLbSubnetRefs references subnets. A ref names an object in the namespace of
+// obj, OCIDs refer to resources outside the cluster and are skipped.
+func specRefs(obj runtime.Object, kinds []string) []string {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	spec := v.FieldByName("Spec")
+	objectmeta, err := meta.Accessor(obj)
+	if !spec.IsValid() || err != nil {
+		return nil
+	}
+
+	var ids []string
+	seen := map[string]bool{}
+	add := func(kind, name string) {
+		if name == "" || resourcescommon.IsOcid(name) {
+			return
+		}
+		id := nodeID(kind, objectmeta.GetNamespace()+"/"+name)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Ptr:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Slice:
+			if elem := v.Type().Elem().Kind(); elem == reflect.Struct || elem == reflect.Ptr {
+				for i := 0; i < v.Len(); i++ {
+					walk(v.Index(i))
+				}
+			}
+		case reflect.Struct:
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				field, value := t.Field(i), v.Field(i)
+				if field.PkgPath != "" {
+					continue
+				}
+				kind := refKind(field.Name, kinds)
+				if kind == "" {
+					walk(value)
+					continue
+				}
+				switch {
+				case value.Kind() == reflect.String:
+					add(kind, value.String())
+				case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.String:
+					for j := 0; j < value.Len(); j++ {
+						add(kind, value.Index(j).String())
+					}
+				}
+			}
+		}
+	}
+	walk(spec)
+	return ids
+}
+
+// refKind returns the kind referenced by a *Ref or *Refs field, or empty if the
+// field is no reference
+func refKind(field string, kinds []string) string {
+	var base string
+	switch {
+	case strings.HasSuffix(field, "Refs"):
+		base = strings.TrimSuffix(field, "Refs")
+	case strings.HasSuffix(field, "Ref"):
+		base = strings.TrimSuffix(field, "Ref")
+	default:
+		return ""
+	}
+	base = strings.ToLower(base)
+	match := ""
+	for _, kind := range kinds {
+		if strings.HasSuffix(base, strings.ToLower(kind)) && len(kind) > len(match) {
+			match = kind
+		}
+	}
+	return match
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+)
+
+func TestRefKind(t *testing.T) {
+	kinds := []string{"Vcn", "Subnet", "Backend", "BackendSet", "RouteTable"}
+	tests := map[string]string{
+		"VcnRef":              "Vcn",
+		"SubnetRefs":          "Subnet",
+		"ServiceLbSubnetRefs": "Subnet",
+		"BackendSetRef":       "BackendSet",
+		"RouteTableRef":       "RouteTable",
+		"InstanceRef":         "",
+		"Subnet":              "",
+	}
+	for field, expected := range tests {
+		if kind := refKind(field, kinds); kind != expected {
+			t.Errorf("Field %s expected kind %q got %q", field, expected, kind)
+		}
+	}
+}
+
+func TestSpecRefs(t *testing.T) {
+	subnet := testGraphSubnet("subnet1", "vcn1")
+	subnet.Spec.RouteTableRef = "rt1"
+	subnet.Spec.SecurityRuleSetRefs = []string{"sl1", "ocid1.securitylist.oc1.phx.aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q", "sl1"}
+
+	kinds := []string{corev1alpha1.VirtualNetworkKind, corev1alpha1.RouteTableKind, corev1alpha1.SecurityRuleSetKind}
+	expected := []string{
+		nodeID(corev1alpha1.VirtualNetworkKind, fakeNs+"/vcn1"),
+		nodeID(corev1alpha1.RouteTableKind, fakeNs+"/rt1"),
+		nodeID(corev1alpha1.SecurityRuleSetKind, fakeNs+"/sl1"),
+	}
+	if refs := specRefs(subnet, kinds); !reflect.DeepEqual(refs, expected) {
+		t.Errorf("Expected refs %v, got %v", expected, refs)
+	}
+}
+
+func TestDependents(t *testing.T) {
+	vcn1 := testGraphVcn("vcn1", map[string]string{"tier": "core"}, nil)
+	controllers, stopCh := newGraphControllers(
+		vcn1,
+		testGraphVcn("vcn2", map[string]string{"tier": "core"}, map[string]string{"tier": "core"}),
+		testGraphVcn("vcn3", nil, nil),
+		testGraphSubnet("subnet1", "vcn1"),
+		testGraphSubnet("subnet2", "vcn3"),
+	)
+	defer close(stopCh)
+	vcnController := controllers[corev1alpha1.VirtualNetworkKind]
+	subnetController := controllers[corev1alpha1.SubnetKind]
+
+	// vcn2 selects itself and vcn1 but only depends on vcn1
+	expected := map[string][]string{
+		corev1alpha1.VirtualNetworkKind: {fakeNs + "/vcn2"},
+		corev1alpha1.SubnetKind:         {fakeNs + "/subnet1"},
+	}
+	if dependents := vcnController.dependents(vcn1); !reflect.DeepEqual(dependents, expected) {
+		t.Errorf("Expected dependents %v, got %v", expected, dependents)
+	}
+	if dependents := vcnController.dependentsOf(corev1alpha1.VirtualNetworkKind, fakeNs+"/vcn2", nil); len(dependents) != 0 {
+		t.Errorf("Expected no dependents of vcn2, got %v", dependents)
+	}
+	if !vcnController.haveDeps(vcn1) {
+		t.Errorf("Expected vcn1 to have dependents")
+	}
+
+	// a change of subnet2 signals vcn3, of vcn2 signals vcn1
+	if ids := subnetController.dependencies(testGraphSubnet("subnet2", "vcn3")); !reflect.DeepEqual(ids, []string{vcnID("vcn3")}) {
+		t.Errorf("Expected subnet2 to depend on vcn3, got %v", ids)
+	}
+	vcn2 := testGraphVcn("vcn2", map[string]string{"tier": "core"}, map[string]string{"tier": "core"})
+	ids := vcnController.dependencies(vcn2)
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{vcnID("vcn1"), vcnID("vcn2")}) {
+		t.Errorf("Expected vcn2 to select vcn1 and itself, got %v", ids)
+	}
+}
@@ -18,7 +18,6 @@ package lb
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/glog"
 	"k8s.io/client-go/kubernetes"
 	"os"
@@ -49,6 +48,7 @@ func init() {
 		ocilbv1alpha1.BackendResourcePlural,
 		ocilbv1alpha1.BackendControllerName,
 		&ocilbv1alpha1.BackendValidation,
+		ocicommon.PrinterColumns(ocicommon.IPColumn(".status.resource.ipAddress")),
 		NewBackendAdapter)
 }
 
@@ -73,24 +73,31 @@ func NewBackendAdapter(clientset versioned.Interface, kubeclient kubernetes.Inte
 		glog.Errorf("Error creating oci LoadBalancer client: %v", err)
 		os.Exit(1)
 	}
-	ba.lbClient = &lbClient
+	ba.lbClient = resourcescommon.InstrumentLoadBalancerClient(&lbClient)
 
 	cClient, err := ocisdkcore.NewComputeClientWithConfigurationProvider(ociconfig)
 	if err != nil {
 		glog.Errorf("Error creating oci Compute client: %v", err)
 		os.Exit(1)
 	}
-	ba.cClient = &cClient
+	ba.cClient = resourcescommon.InstrumentComputeClient(&cClient)
 
 	vcnClient, err := ocisdkcore.NewVirtualNetworkClientWithConfigurationProvider(ociconfig)
 	if err != nil {
 		glog.Errorf("Error creating oci VCN client: %v", err)
 		os.Exit(1)
 	}
-	ba.vcnClient = &vcnClient
+	ba.vcnClient = resourcescommon.InstrumentVcnClient(&vcnClient)
 	return &ba
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *BackendAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *BackendAdapter) Kind() string {
 	return ocilbv1alpha1.BackendKind
@@ -135,19 +142,18 @@ func (a *BackendAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *BackendAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *BackendAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	backend := obj.(*ocilbv1alpha1.Backend)
 
 	if backend.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
-	if backend.Spec.Weight != *backend.Status.Resource.Weight ||
-		backend.Spec.Port != *backend.Status.Resource.Port {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("weight", backend.Spec.Weight, backend.Status.Resource.Weight)
+	drift.Check("port", backend.Spec.Port, backend.Status.Resource.Port)
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -170,11 +176,6 @@ func (a *BackendAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Depe
 	return obj.(*ocilbv1alpha1.Backend).Spec.DependsOn
 }
 
-// Dependents returns a map of backend dependents (objects that depend on the backend)
-func (a *BackendAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocilbv1alpha1.Backend).Status.Dependents
-}
-
 // CreateObject creates the backend object
 func (a *BackendAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocilbv1alpha1.Backend)
@@ -187,6 +188,12 @@ func (a *BackendAdapter) UpdateObject(obj runtime.Object) (runtime.Object, error
 	return a.clientset.OcilbV1alpha1().Backends(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the backend object
+func (a *BackendAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocilbv1alpha1.Backend)
+	return a.clientset.OcilbV1alpha1().Backends(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the backend object
 func (a *BackendAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var be = obj.(*ocilbv1alpha1.Backend)
@@ -234,30 +241,23 @@ func (a *BackendAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 	if backend.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: backend.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("CreateBackend GetWorkRequest error: %v", e)
-			return backend, backend.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &backend.Status.ResourceStatus, backend.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			backend.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("CreateBackend workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if backend.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *backend.Status.WorkRequestStatus {
-				backend.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("CreateBackend work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				backend.Status.WorkRequestId = nil
+				backend.Status.WorkRequestStatus = nil
 				return backend, nil
-			} else {
-				return nil, nil
 			}
+			return backend, backend.Status.HandleError(e)
 		}
+		glog.Infof("CreateBackend workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			backend.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *backend.Status.WorkRequestId)
-			return backend, backend.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return backend, nil
 		}
 
 		backend.Status.WorkRequestId = nil
@@ -329,6 +329,7 @@ func (a *BackendAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 		glog.Infof("CreateBackend workRequestId: %s", *createResponse.OpcWorkRequestId)
 		backend.Status.WorkRequestId = createResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&backend.Status.ResourceStatus, "loadbalancer", "CreateBackend", createResponse.OpcWorkRequestId)
 		return backend, backend.Status.HandleError(e)
 	}
 
@@ -380,30 +381,23 @@ func (a *BackendAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	if backend.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: backend.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("UpdateBackend GetWorkRequest error: %v", e)
-			return backend, backend.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &backend.Status.ResourceStatus, backend.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			backend.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("UpdateBackend workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if backend.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *backend.Status.WorkRequestStatus {
-				backend.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("UpdateBackend work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				backend.Status.WorkRequestId = nil
+				backend.Status.WorkRequestStatus = nil
 				return backend, nil
-			} else {
-				return nil, nil
 			}
+			return backend, backend.Status.HandleError(e)
 		}
+		glog.Infof("UpdateBackend workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			backend.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *backend.Status.WorkRequestId)
-			return backend, backend.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return backend, nil
 		}
 
 		backend.Status.WorkRequestId = nil
@@ -431,6 +425,7 @@ func (a *BackendAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 		}
 		glog.Infof("UpdateBackend workRequestId: %s", *updateResponse.OpcWorkRequestId)
 		backend.Status.WorkRequestId = updateResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&backend.Status.ResourceStatus, "loadbalancer", "UpdateBackend", updateResponse.OpcWorkRequestId)
 		return backend, backend.Status.HandleError(e)
 	}
 
@@ -18,7 +18,6 @@ package lb
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/glog"
 	"k8s.io/client-go/kubernetes"
 	"os"
@@ -45,6 +44,7 @@ func init() {
 		ocilbv1alpha1.BackendSetResourcePlural,
 		ocilbv1alpha1.BackendSetControllerName,
 		&ocilbv1alpha1.BackendSetValidation,
+		ocicommon.PrinterColumns(),
 		NewBackendSetAdapter)
 }
 
@@ -67,10 +67,17 @@ func NewBackendSetAdapter(clientset versioned.Interface, kubeclient kubernetes.I
 		glog.Errorf("Error creating oci Compute client: %v", err)
 		os.Exit(1)
 	}
-	bsa.lbClient = &lbClient
+	bsa.lbClient = resourcescommon.InstrumentLoadBalancerClient(&lbClient)
 	return &bsa
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *BackendSetAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *BackendSetAdapter) Kind() string {
 	return ocilbv1alpha1.BackendSetKind
@@ -113,13 +120,13 @@ func (a *BackendSetAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *BackendSetAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *BackendSetAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	backendSet := obj.(*ocilbv1alpha1.BackendSet)
 	if backendSet.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
-	return true
+	return true, nil
 }
 
 // IsResourceStatusChanged checks if two objects are the same
@@ -142,11 +149,6 @@ func (a *BackendSetAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.D
 	return obj.(*ocilbv1alpha1.BackendSet).Spec.DependsOn
 }
 
-// Dependents returns a map of backend set dependents (objects that depend on the backend set)
-func (a *BackendSetAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocilbv1alpha1.BackendSet).Status.Dependents
-}
-
 // CreateObject creates the backend set object
 func (a *BackendSetAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocilbv1alpha1.BackendSet)
@@ -159,6 +161,12 @@ func (a *BackendSetAdapter) UpdateObject(obj runtime.Object) (runtime.Object, er
 	return a.clientset.OcilbV1alpha1().BackendSets(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the backend set object
+func (a *BackendSetAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocilbv1alpha1.BackendSet)
+	return a.clientset.OcilbV1alpha1().BackendSets(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the backend set object
 func (a *BackendSetAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocilbv1alpha1.BackendSet)
@@ -186,30 +194,23 @@ func (a *BackendSetAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 	if backendSet.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: backendSet.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("CreateBackendSet GetWorkRequest error: %v", e)
-			return backendSet, backendSet.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &backendSet.Status.ResourceStatus, backendSet.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("CreateBackendSet workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if backendSet.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *backendSet.Status.WorkRequestStatus {
-				backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("CreateBackendSet work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				backendSet.Status.WorkRequestId = nil
+				backendSet.Status.WorkRequestStatus = nil
 				return backendSet, nil
-			} else {
-				return nil, nil
 			}
+			return backendSet, backendSet.Status.HandleError(e)
 		}
+		glog.Infof("CreateBackendSet workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *backendSet.Status.WorkRequestId)
-			return backendSet, backendSet.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return backendSet, nil
 		}
 
 		backendSet.Status.WorkRequestId = nil
@@ -292,6 +293,7 @@ func (a *BackendSetAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 		}
 		glog.Infof("CreateBackendSet workRequestId: %s", *createBackendSetResponse.OpcWorkRequestId)
 		backendSet.Status.WorkRequestId = createBackendSetResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&backendSet.Status.ResourceStatus, "loadbalancer", "CreateBackendSet", createBackendSetResponse.OpcWorkRequestId)
 		return backendSet, backendSet.Status.HandleError(e)
 	}
 
@@ -338,30 +340,23 @@ func (a *BackendSetAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	if backendSet.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: backendSet.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("UpdateBackendSet GetWorkRequest error: %v", e)
-			return backendSet, backendSet.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &backendSet.Status.ResourceStatus, backendSet.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("UpdateBackendSet workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if backendSet.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *backendSet.Status.WorkRequestStatus {
-				backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("UpdateBackendSet work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				backendSet.Status.WorkRequestId = nil
+				backendSet.Status.WorkRequestStatus = nil
 				return backendSet, nil
-			} else {
-				return nil, nil
 			}
+			return backendSet, backendSet.Status.HandleError(e)
 		}
+		glog.Infof("UpdateBackendSet workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			backendSet.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *backendSet.Status.WorkRequestId)
-			return backendSet, backendSet.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return backendSet, nil
 		}
 
 		backendSet.Status.WorkRequestId = nil
@@ -424,6 +419,7 @@ func (a *BackendSetAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 		}
 		glog.Infof("UpdateBackendSet workRequestId: %s", *updateBackendSetResponse.OpcWorkRequestId)
 		backendSet.Status.WorkRequestId = updateBackendSetResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&backendSet.Status.ResourceStatus, "loadbalancer", "UpdateBackendSet", updateBackendSetResponse.OpcWorkRequestId)
 		return backendSet, backendSet.Status.HandleError(e)
 	}
 
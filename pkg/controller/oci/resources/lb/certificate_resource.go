@@ -18,7 +18,6 @@ package lb
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/glog"
 	"k8s.io/client-go/kubernetes"
 	"os"
@@ -44,6 +43,7 @@ func init() {
 		ocilbv1alpha1.CertificateResourcePlural,
 		ocilbv1alpha1.CertificateControllerName,
 		&ocilbv1alpha1.CertificateValidation,
+		ocicommon.PrinterColumns(),
 		NewCertificateAdapter)
 }
 
@@ -66,10 +66,17 @@ func NewCertificateAdapter(clientset versioned.Interface, kubeclient kubernetes.
 		glog.Errorf("Error creating oci LoadBalancer client: %v", err)
 		os.Exit(1)
 	}
-	ba.lbClient = &lbClient
+	ba.lbClient = resourcescommon.InstrumentLoadBalancerClient(&lbClient)
 	return &ba
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *CertificateAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *CertificateAdapter) Kind() string {
 	return ocilbv1alpha1.CertificateKind
@@ -110,18 +117,17 @@ func (a *CertificateAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *CertificateAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *CertificateAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	cert := obj.(*ocilbv1alpha1.Certificate)
 	if cert.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
-	if cert.Spec.CACertificate != *cert.Status.Resource.CaCertificate ||
-		cert.Spec.PublicCertificate != *cert.Status.Resource.PublicCertificate {
-		return false
-	}
+	var drift resourcescommon.Drift
+	drift.Check("caCertificate", cert.Spec.CACertificate, cert.Status.Resource.CaCertificate)
+	drift.Check("publicCertificate", cert.Spec.PublicCertificate, cert.Status.Resource.PublicCertificate)
 
-	return true
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two objects are the same
@@ -144,11 +150,6 @@ func (a *CertificateAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.
 	return obj.(*ocilbv1alpha1.Certificate).Spec.DependsOn
 }
 
-// Dependents returns a map of certificate dependents (objects that depend on the certificate)
-func (a *CertificateAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocilbv1alpha1.Certificate).Status.Dependents
-}
-
 // CreateObject creates the certificate object
 func (a *CertificateAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocilbv1alpha1.Certificate)
@@ -161,6 +162,12 @@ func (a *CertificateAdapter) UpdateObject(obj runtime.Object) (runtime.Object, e
 	return a.clientset.OcilbV1alpha1().Certificates(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the certificate object
+func (a *CertificateAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocilbv1alpha1.Certificate)
+	return a.clientset.OcilbV1alpha1().Certificates(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the certificate object
 func (a *CertificateAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocilbv1alpha1.Certificate)
@@ -189,30 +196,23 @@ func (a *CertificateAdapter) Create(obj runtime.Object) (runtime.Object, error)
 
 	if certificate.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: certificate.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("CreateCertificate GetWorkRequest error: %v", e)
-			return certificate, certificate.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &certificate.Status.ResourceStatus, certificate.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			certificate.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.V(4).Infof("CreateCertificate workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if certificate.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *certificate.Status.WorkRequestStatus {
-				certificate.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("CreateCertificate work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				certificate.Status.WorkRequestId = nil
+				certificate.Status.WorkRequestStatus = nil
 				return certificate, nil
-			} else {
-				return nil, nil
 			}
+			return certificate, certificate.Status.HandleError(e)
 		}
+		glog.V(4).Infof("CreateCertificate workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			certificate.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *certificate.Status.WorkRequestId)
-			return certificate, certificate.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return certificate, nil
 		}
 
 		certificate.Status.WorkRequestId = nil
@@ -265,6 +265,7 @@ func (a *CertificateAdapter) Create(obj runtime.Object) (runtime.Object, error)
 		}
 		glog.Infof("CreateCertificate workRequestId: %s", *workResp.OpcWorkRequestId)
 		certificate.Status.WorkRequestId = workResp.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&certificate.Status.ResourceStatus, "loadbalancer", "CreateCertificate", workResp.OpcWorkRequestId)
 		return certificate, certificate.Status.HandleError(e)
 	}
 
@@ -17,12 +17,16 @@ limitations under the License.
 package lb
 
 import (
+	"context"
 	"testing"
 
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocisdklb "github.com/oracle/oci-go-sdk/loadbalancer"
 	"github.com/oracle/oci-manager/pkg/client/clientset/versioned"
 	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
 	lbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
 	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
 	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
@@ -94,3 +98,39 @@ func NewFakeCertificateAdapter(clientset versioned.Interface) resourcescommon.Re
 	certificateAdapter.lbClient = certificateClient
 	return &certificateAdapter
 }
+
+type failedWorkRequestLbClient struct {
+	*fakeoci.LoadBalancerClient
+}
+
+func (c *failedWorkRequestLbClient) GetWorkRequest(ctx context.Context, request ocisdklb.GetWorkRequestRequest) (ocisdklb.GetWorkRequestResponse, error) {
+	return ocisdklb.GetWorkRequestResponse{WorkRequest: ocisdklb.WorkRequest{
+		Id:             request.WorkRequestId,
+		LifecycleState: ocisdklb.WorkRequestLifecycleStateFailed,
+	}}, nil
+}
+
+func TestCertificateFailedWorkRequest(t *testing.T) {
+	certificateAdapter := CertificateAdapter{}
+	certificateAdapter.clientset = fakeclient.NewSimpleClientset()
+	certificateAdapter.lbClient = &failedWorkRequestLbClient{fakeoci.NewLoadBalancerClient()}
+
+	certificate := certificatetest1.DeepCopy()
+	certificate.Status.WorkRequestId = ocisdkcommon.String("wr1")
+	resourcescommon.StartWorkRequest(&certificate.Status.ResourceStatus, "loadbalancer", "CreateCertificate", certificate.Status.WorkRequestId)
+
+	obj, err := certificateAdapter.Create(certificate)
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	certificate = obj.(*lbv1alpha1.Certificate)
+	if certificate.Status.WorkRequestId != nil || certificate.Status.WorkRequestStatus != nil {
+		t.Errorf("Expected failed work request to be forgotten, got %v", certificate.Status.WorkRequestId)
+	}
+	if wr := certificate.Status.WorkRequest; wr == nil || wr.Id != "wr1" || !wr.Failed() {
+		t.Errorf("Expected failed work request in status, got %#v", wr)
+	}
+	if certificate.Status.State == ocicommon.ResourceStateError {
+		t.Errorf("Expected failed work request to be left to the controller")
+	}
+}
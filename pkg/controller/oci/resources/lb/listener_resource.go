@@ -18,7 +18,6 @@ package lb
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/glog"
 	"k8s.io/client-go/kubernetes"
 	"os"
@@ -47,6 +46,7 @@ func init() {
 		ocilbv1alpha1.ListenerResourcePlural,
 		ocilbv1alpha1.ListenerControllerName,
 		&ocilbv1alpha1.ListenerValidation,
+		ocicommon.PrinterColumns(),
 		NewListenerAdapter)
 }
 
@@ -69,10 +69,17 @@ func NewListenerAdapter(clientset versioned.Interface, kubeclient kubernetes.Int
 		glog.Errorf("Error creating oci LoadBalancer client: %v", err)
 		os.Exit(1)
 	}
-	la.lbClient = &lbClient
+	la.lbClient = resourcescommon.InstrumentLoadBalancerClient(&lbClient)
 	return &la
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *ListenerAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *ListenerAdapter) Kind() string {
 	return ocilbv1alpha1.ListenerKind
@@ -122,23 +129,23 @@ func (a *ListenerAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *ListenerAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *ListenerAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	listener := obj.(*ocilbv1alpha1.Listener)
 
 	if listener.Status.WorkRequestId != nil {
-		return false
+		return false, nil
 	}
 
 	if listener.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	if listener.Spec.Port != *listener.Status.Resource.Port ||
 		listener.Spec.Protocol != *listener.Status.Resource.Protocol {
-		return true
+		return true, nil
 	}
 
-	return true
+	return true, nil
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -161,11 +168,6 @@ func (a *ListenerAdapter) DependsOn(obj runtime.Object) map[string]ocicommon.Dep
 	return obj.(*ocilbv1alpha1.Listener).Spec.DependsOn
 }
 
-// Dependents returns a map of listener dependents (objects that depend on the listener)
-func (a *ListenerAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocilbv1alpha1.Listener).Status.Dependents
-}
-
 // CreateObject creates the listener object
 func (a *ListenerAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocilbv1alpha1.Listener)
@@ -178,6 +180,12 @@ func (a *ListenerAdapter) UpdateObject(obj runtime.Object) (runtime.Object, erro
 	return a.clientset.OcilbV1alpha1().Listeners(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the listener object
+func (a *ListenerAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocilbv1alpha1.Listener)
+	return a.clientset.OcilbV1alpha1().Listeners(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the listener object
 func (a *ListenerAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocilbv1alpha1.Listener)
@@ -206,30 +214,23 @@ func (a *ListenerAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 	if listener.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: listener.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("CreateListener GetWorkRequest error: %v", e)
-			return listener, listener.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &listener.Status.ResourceStatus, listener.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			listener.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("CreateListener workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if listener.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *listener.Status.WorkRequestStatus {
-				listener.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("CreateListener work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				listener.Status.WorkRequestId = nil
+				listener.Status.WorkRequestStatus = nil
 				return listener, nil
-			} else {
-				return nil, nil
 			}
+			return listener, listener.Status.HandleError(e)
 		}
+		glog.Infof("CreateListener workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			listener.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *listener.Status.WorkRequestId)
-			return listener, listener.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return listener, nil
 		}
 
 		listener.Status.WorkRequestId = nil
@@ -307,6 +308,7 @@ func (a *ListenerAdapter) Create(obj runtime.Object) (runtime.Object, error) {
 
 		glog.Infof("CreateListener workRequestId: %s", *createListenerResp.OpcWorkRequestId)
 		listener.Status.WorkRequestId = createListenerResp.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&listener.Status.ResourceStatus, "loadbalancer", "CreateListener", createListenerResp.OpcWorkRequestId)
 		return listener, listener.Status.HandleError(e)
 	}
 
@@ -352,30 +354,23 @@ func (a *ListenerAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 	if listener.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: listener.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("UpdateListener GetWorkRequest error: %v", e)
-			return listener, listener.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &listener.Status.ResourceStatus, listener.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			listener.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("UpdateListener workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if listener.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *listener.Status.WorkRequestStatus {
-				listener.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("UpdateListener work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				listener.Status.WorkRequestId = nil
+				listener.Status.WorkRequestStatus = nil
 				return listener, nil
-			} else {
-				return nil, nil
 			}
+			return listener, listener.Status.HandleError(e)
 		}
+		glog.Infof("UpdateListener workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			listener.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *listener.Status.WorkRequestId)
-			return listener, listener.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return listener, nil
 		}
 
 		listener.Status.WorkRequestId = nil
@@ -420,6 +415,7 @@ func (a *ListenerAdapter) Update(obj runtime.Object) (runtime.Object, error) {
 
 		glog.Infof("UpdateListener workRequestId: %s", *updateListenerResp.OpcWorkRequestId)
 		listener.Status.WorkRequestId = updateListenerResp.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&listener.Status.ResourceStatus, "loadbalancer", "UpdateListener", updateListenerResp.OpcWorkRequestId)
 		return listener, listener.Status.HandleError(e)
 	}
 
@@ -18,7 +18,6 @@ package lb
 
 import (
 	"context"
-	"fmt"
 	"github.com/golang/glog"
 	"k8s.io/client-go/kubernetes"
 	"os"
@@ -44,6 +43,7 @@ func init() {
 		ocilbv1alpha1.LoadBalancerResourcePlural,
 		ocilbv1alpha1.LoadBalancerControllerName,
 		&ocilbv1alpha1.LoadBalancerValidation,
+		ocicommon.PrinterColumns(ocicommon.LifecycleColumn, ocicommon.IPColumn(".status.resource.ipAddresses[0].ipAddress"), ocicommon.OcidColumn),
 		NewLoadBalancerAdapter)
 }
 
@@ -66,10 +66,17 @@ func NewLoadBalancerAdapter(clientset versioned.Interface, kubeclient kubernetes
 		glog.Errorf("Error creating oci lb client: %v", err)
 		os.Exit(1)
 	}
-	lba.lbClient = &lbClient
+	lba.lbClient = resourcescommon.InstrumentLoadBalancerClient(&lbClient)
 	return &lba
 }
 
+// WithContext returns a copy of the adapter making its OCI calls with ctx
+func (a *LoadBalancerAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	adapter := *a
+	adapter.ctx = ctx
+	return &adapter
+}
+
 // Kind returns the resource kind string
 func (a *LoadBalancerAdapter) Kind() string {
 	return ocilbv1alpha1.LoadBalancerKind
@@ -116,29 +123,35 @@ func (a *LoadBalancerAdapter) Equivalent(obj1, obj2 runtime.Object) bool {
 }
 
 // IsResourceCompliant checks if resource config is complient with CRD spec
-func (a *LoadBalancerAdapter) IsResourceCompliant(obj runtime.Object) bool {
+func (a *LoadBalancerAdapter) IsResourceCompliant(obj runtime.Object) (bool, resourcescommon.Drift) {
 	lb := obj.(*ocilbv1alpha1.LoadBalancer)
 	if lb.Status.Resource == nil {
-		return false
+		return false, nil
 	}
 
 	resource := lb.Status.Resource
 
 	if resource.LifecycleState == ocisdklb.LoadBalancerLifecycleStateCreating ||
 		resource.LifecycleState == ocisdklb.LoadBalancerLifecycleStateDeleting {
-		return true
+		return true, nil
 	}
 
 	if resource.LifecycleState == ocisdklb.LoadBalancerLifecycleStateDeleted ||
 		resource.LifecycleState == ocisdklb.LoadBalancerLifecycleStateFailed {
-		return false
+		return false, nil
 	}
 
-	if lb.Spec.Shape != *resource.ShapeName ||
-		lb.Spec.IsPrivate != *resource.IsPrivate {
-		return false
+	// an update of the tags is still running
+	if lb.Status.WorkRequestId != nil {
+		return false, nil
 	}
-	return true
+
+	var drift resourcescommon.Drift
+	drift.Check("shapeName", lb.Spec.Shape, resource.ShapeName)
+	drift.Check("isPrivate", lb.Spec.IsPrivate, resource.IsPrivate)
+	drift.CheckTags(resourcescommon.OwnedTags(a.Kind(), lb, ocicommon.ResourceTags{FreeformTags: lb.Spec.FreeformTags, DefinedTags: lb.Spec.DefinedTags}),
+		ocicommon.ResourceTags{FreeformTags: resource.FreeformTags, DefinedTags: resource.DefinedTags})
+	return drift.Compliant(), drift
 }
 
 // IsResourceStatusChanged checks if two vcn objects are the same
@@ -164,11 +177,6 @@ func (a *LoadBalancerAdapter) DependsOn(obj runtime.Object) map[string]ocicommon
 	return obj.(*ocilbv1alpha1.LoadBalancer).Spec.DependsOn
 }
 
-// Dependents returns a map of load balancer dependents (objects that depend on the load balancer)
-func (a *LoadBalancerAdapter) Dependents(obj runtime.Object) map[string][]string {
-	return obj.(*ocilbv1alpha1.LoadBalancer).Status.Dependents
-}
-
 // CreateObject creates the load balancer object
 func (a *LoadBalancerAdapter) CreateObject(obj runtime.Object) (runtime.Object, error) {
 	var object = obj.(*ocilbv1alpha1.LoadBalancer)
@@ -181,6 +189,12 @@ func (a *LoadBalancerAdapter) UpdateObject(obj runtime.Object) (runtime.Object,
 	return a.clientset.OcilbV1alpha1().LoadBalancers(object.ObjectMeta.Namespace).Update(object)
 }
 
+// UpdateObjectStatus updates the status of the load balancer object
+func (a *LoadBalancerAdapter) UpdateObjectStatus(obj runtime.Object) (runtime.Object, error) {
+	var object = obj.(*ocilbv1alpha1.LoadBalancer)
+	return a.clientset.OcilbV1alpha1().LoadBalancers(object.ObjectMeta.Namespace).UpdateStatus(object)
+}
+
 // DeleteObject deletes the load balancer object
 func (a *LoadBalancerAdapter) DeleteObject(obj runtime.Object, options *metav1.DeleteOptions) error {
 	var object = obj.(*ocilbv1alpha1.LoadBalancer)
@@ -225,30 +239,23 @@ func (a *LoadBalancerAdapter) Create(obj runtime.Object) (runtime.Object, error)
 
 	if lb.Status.WorkRequestId != nil {
 
-		workRequest := ocisdklb.GetWorkRequestRequest{WorkRequestId: lb.Status.WorkRequestId}
-		workResp, e := a.lbClient.GetWorkRequest(a.ctx, workRequest)
-		if e != nil {
-			glog.Errorf("CreateLoadBalancer GetWorkRequest error: %v", e)
-			return lb, lb.Status.HandleError(e)
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &lb.Status.ResourceStatus, lb.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			lb.Status.WorkRequestStatus = &workResp.LifecycleState
 		}
-		glog.Infof("CreateLoadBalancer workResp state: %s", workResp.LifecycleState)
-
-		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded &&
-			workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateFailed {
-
-			if lb.Status.WorkRequestStatus == nil ||
-				workResp.LifecycleState != *lb.Status.WorkRequestStatus {
-				lb.Status.WorkRequestStatus = &workResp.LifecycleState
+		if e != nil {
+			glog.Errorf("CreateLoadBalancer work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				lb.Status.WorkRequestId = nil
+				lb.Status.WorkRequestStatus = nil
 				return lb, nil
-			} else {
-				return nil, nil
 			}
+			return lb, lb.Status.HandleError(e)
 		}
+		glog.Infof("CreateLoadBalancer workResp state: %s", workResp.LifecycleState)
 
-		if workResp.LifecycleState == ocisdklb.WorkRequestLifecycleStateFailed {
-			lb.Status.WorkRequestStatus = &workResp.LifecycleState
-			err := fmt.Errorf("WorkRequest %s is in failed state", *lb.Status.WorkRequestId)
-			return lb, lb.Status.HandleError(err)
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return lb, nil
 		}
 
 		lb.Status.Resource = &ocilbv1alpha1.LoadBalancerResource{
@@ -284,12 +291,15 @@ func (a *LoadBalancerAdapter) Create(obj runtime.Object) (runtime.Object, error)
 		}
 		glog.Infof("CreateLoadBalancer subnets: %s", subnets)
 
+		tags := resourcescommon.OwnedTags(a.Kind(), lb, ocicommon.ResourceTags{FreeformTags: lb.Spec.FreeformTags, DefinedTags: lb.Spec.DefinedTags})
 		createDetails := ocisdklb.CreateLoadBalancerDetails{
 			CompartmentId: ocisdkcommon.String(compartmentId),
 			DisplayName:   &lb.Name,
 			IsPrivate:     &lb.Spec.IsPrivate,
 			ShapeName:     &lb.Spec.Shape,
 			SubnetIds:     subnets,
+			FreeformTags:  tags.FreeformTags,
+			DefinedTags:   tags.DefinedTags,
 		}
 		createRequest := ocisdklb.CreateLoadBalancerRequest{
 			CreateLoadBalancerDetails: createDetails,
@@ -303,6 +313,7 @@ func (a *LoadBalancerAdapter) Create(obj runtime.Object) (runtime.Object, error)
 		}
 		glog.Infof("CreateLoadBalancer workRequestId: %s", *createResponse.OpcWorkRequestId)
 		lb.Status.WorkRequestId = createResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&lb.Status.ResourceStatus, "loadbalancer", "CreateLoadBalancer", createResponse.OpcWorkRequestId)
 		return lb, lb.Status.HandleError(e)
 	}
 
@@ -355,11 +366,105 @@ func (a *LoadBalancerAdapter) Get(obj runtime.Object) (runtime.Object, error) {
 
 // Update updates the load balancer resource in oci
 func (a *LoadBalancerAdapter) Update(obj runtime.Object) (runtime.Object, error) {
-	// only attr updateable is displayName which is resource name
-	return a.Get(obj)
+	var lb = obj.(*ocilbv1alpha1.LoadBalancer)
+
+	// only attrs updateable are the tags and displayName which is resource name
+	if lb.Status.WorkRequestId != nil {
+
+		workResp, e := resourcescommon.PollLoadBalancerWorkRequest(a.ctx, a.lbClient, &lb.Status.ResourceStatus, lb.Status.WorkRequestId)
+		if workResp.LifecycleState != "" {
+			lb.Status.WorkRequestStatus = &workResp.LifecycleState
+		}
+		if e != nil {
+			glog.Errorf("UpdateLoadBalancer work request error: %v", e)
+			if resourcescommon.IsWorkRequestFailed(e) {
+				lb.Status.WorkRequestId = nil
+				lb.Status.WorkRequestStatus = nil
+				return lb, nil
+			}
+			return lb, lb.Status.HandleError(e)
+		}
+		glog.Infof("UpdateLoadBalancer workResp state: %s", workResp.LifecycleState)
+
+		if workResp.LifecycleState != ocisdklb.WorkRequestLifecycleStateSucceeded {
+			return lb, nil
+		}
+
+		lb.Status.WorkRequestId = nil
+		lb.Status.WorkRequestStatus = nil
+
+	} else {
+
+		tags := resourcescommon.MergeTags(
+			ocicommon.ResourceTags{FreeformTags: lb.Status.Resource.FreeformTags, DefinedTags: lb.Status.Resource.DefinedTags},
+			resourcescommon.OwnedTags(a.Kind(), lb, ocicommon.ResourceTags{FreeformTags: lb.Spec.FreeformTags, DefinedTags: lb.Spec.DefinedTags}))
+		updateRequest := ocisdklb.UpdateLoadBalancerRequest{
+			LoadBalancerId: lb.Status.Resource.Id,
+			UpdateLoadBalancerDetails: ocisdklb.UpdateLoadBalancerDetails{
+				DisplayName:  &lb.Name,
+				FreeformTags: tags.FreeformTags,
+				DefinedTags:  tags.DefinedTags,
+			},
+		}
+
+		updateResponse, e := a.lbClient.UpdateLoadBalancer(a.ctx, updateRequest)
+		if e != nil {
+			glog.Errorf("UpdateLoadBalancer error: %v", e)
+			return lb, lb.Status.HandleError(e)
+		}
+		glog.Infof("UpdateLoadBalancer workRequestId: %s", *updateResponse.OpcWorkRequestId)
+		lb.Status.WorkRequestId = updateResponse.OpcWorkRequestId
+		resourcescommon.StartWorkRequest(&lb.Status.ResourceStatus, "loadbalancer", "UpdateLoadBalancer", updateResponse.OpcWorkRequestId)
+		return lb, lb.Status.HandleError(e)
+	}
+
+	return a.Get(lb)
 }
 
 // UpdateForResource calls a common UpdateForResource method to update the load balancer resource in the load balancer object
 func (a *LoadBalancerAdapter) UpdateForResource(resource schema.GroupVersionResource, obj runtime.Object) (runtime.Object, error) {
 	return resourcescommon.UpdateForResource(a.clientset, resource, obj)
 }
+
+// ListOwned returns the load balancers in the compartment tagged as managed by oci-manager
+func (a *LoadBalancerAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	var owned []resourcescommon.OwnedResource
+	request := ocisdklb.ListLoadBalancersRequest{CompartmentId: &compartmentId}
+	for {
+		r, err := a.lbClient.ListLoadBalancers(a.ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range r.Items {
+			if resourcescommon.IsOwned(item.FreeformTags) &&
+				item.LifecycleState != ocisdklb.LoadBalancerLifecycleStateDeleting && item.LifecycleState != ocisdklb.LoadBalancerLifecycleStateDeleted {
+				resource := resourcescommon.NewOwnedResource(item.Id, item.DisplayName, item.TimeCreated, item.FreeformTags)
+				resource.Parents = item.SubnetIds
+				owned = append(owned, resource)
+			}
+		}
+		if r.OpcNextPage == nil {
+			return owned, nil
+		}
+		request.Page = r.OpcNextPage
+	}
+}
+
+// DeleteOwned deletes the load balancer with the id
+func (a *LoadBalancerAdapter) DeleteOwned(id string) error {
+	_, err := a.lbClient.DeleteLoadBalancer(a.ctx, ocisdklb.DeleteLoadBalancerRequest{LoadBalancerId: &id})
+	return err
+}
+
+// ReleaseOwned removes the ownership tags from the load balancer with the id
+func (a *LoadBalancerAdapter) ReleaseOwned(id string) error {
+	r, err := a.lbClient.GetLoadBalancer(a.ctx, ocisdklb.GetLoadBalancerRequest{LoadBalancerId: &id})
+	if err != nil {
+		return err
+	}
+	_, err = a.lbClient.UpdateLoadBalancer(a.ctx, ocisdklb.UpdateLoadBalancerRequest{
+		LoadBalancerId:            &id,
+		UpdateLoadBalancerDetails: ocisdklb.UpdateLoadBalancerDetails{FreeformTags: resourcescommon.ReleasedTags(r.FreeformTags)},
+	})
+	return err
+}
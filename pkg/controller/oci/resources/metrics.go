@@ -0,0 +1,56 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	"github.com/oracle/oci-manager/pkg/metrics"
+)
+
+// Reconcile outcomes used as metric label values
+const (
+	outcomeChanged   = "changed"
+	outcomeUnchanged = "unchanged"
+	outcomeRetry     = "retry"
+	outcomeError     = "error"
+	outcomeThrottled = "throttled"
+)
+
+var (
+	reconcileTotal = metrics.NewCounterVec("ocimanager_reconcile_total",
+		"Total number of reconciles by kind and outcome", "kind", "outcome")
+	reconcileDuration = metrics.NewHistogramVec("ocimanager_reconcile_duration_seconds",
+		"Duration of reconciles by kind and outcome", nil, "kind", "outcome")
+)
+
+// reconcileOutcome maps the result of a reconcile to its metric label
+func reconcileOutcome(object runtime.Object, err error, retry bool) string {
+	_, throttled := resourcescommon.IsThrottled(err)
+	switch {
+	case throttled:
+		return outcomeThrottled
+	case err != nil:
+		return outcomeError
+	case retry:
+		return outcomeRetry
+	case object != nil:
+		return outcomeChanged
+	}
+	return outcomeUnchanged
+}
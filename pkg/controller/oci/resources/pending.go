@@ -0,0 +1,139 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Operations a pending object waits for
+const (
+	operationCreate = "Create"
+	operationUpdate = "Update"
+	operationDelete = "Delete"
+)
+
+type pendingEntry struct {
+	since     time.Time
+	polls     uint
+	operation string
+	// restarted ignores the start time of the operation after a forced retry
+	restarted bool
+}
+
+// pendingTracker keeps track of objects waiting for a long running OCI operation
+// i.e. a work request or a transitional lifecycle state. The delay between polls
+// starts at baseDelay and doubles with every poll up to maxDelay.
+type pendingTracker struct {
+	lock      sync.Mutex
+	entries   map[string]*pendingEntry
+	baseDelay time.Duration
+	maxDelay  time.Duration
+}
+
+func newPendingTracker(baseDelay, maxDelay time.Duration) *pendingTracker {
+	return &pendingTracker{
+		entries:   make(map[string]*pendingEntry),
+		baseDelay: baseDelay,
+		maxDelay:  maxDelay,
+	}
+}
+
+// next returns the delay before key is polled again. A timeout of 0 never expires,
+// otherwise expired is set once the operation is pending longer than timeout.
+func (t *pendingTracker) next(key, operation string, started *metav1.Time, timeout time.Duration, now time.Time) (delay time.Duration, expired bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	entry, ok := t.entries[key]
+	if ok && entry.restarted && entry.operation == "" {
+		entry.operation = operation
+	}
+	if !ok || entry.operation != operation {
+		entry = &pendingEntry{since: now, operation: operation}
+		t.entries[key] = entry
+	}
+	// the work request may have been accepted before the controller restarted
+	if started != nil && !entry.restarted && started.Time.Before(entry.since) {
+		entry.since = started.Time
+	}
+
+	if timeout > 0 && now.Sub(entry.since) > timeout {
+		return 0, true
+	}
+
+	delay = t.maxDelay
+	if entry.polls < 16 {
+		if d := t.baseDelay << entry.polls; d < t.maxDelay {
+			delay = d
+		}
+	}
+	entry.polls++
+	return delay, false
+}
+
+// restart gives the pending operation of key a new timeout starting now
+func (t *pendingTracker) restart(key string, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.entries[key] = &pendingEntry{since: now, restarted: true}
+}
+
+// forget stops tracking key once it is no longer pending
+func (t *pendingTracker) forget(key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.entries, key)
+}
+
+// pendingOperation returns the operation a pending object waits for and the time
+// it was started if known. Waiting for dependents to be deleted first is not an
+// operation of the object and has no timeout.
+func pendingOperation(object runtime.Object, deleting bool) (string, *metav1.Time) {
+	status := resourceStatus(object)
+	if deleting {
+		if status != nil {
+			if c := status.GetCondition(ocicommon.ConditionDeleting); c != nil && c.Reason == reasonDependentsPresent {
+				return reasonDependentsPresent, nil
+			}
+		}
+		return operationDelete, nil
+	}
+
+	operation := operationCreate
+	var started *metav1.Time
+	if status != nil && status.WorkRequest != nil {
+		started = status.WorkRequest.Accepted
+		switch op := strings.ToUpper(status.WorkRequest.Operation); {
+		case strings.Contains(op, "DELETE"):
+			operation = operationDelete
+		case strings.Contains(op, "UPDATE"):
+			operation = operationUpdate
+		}
+	} else if status != nil && status.IsConditionTrue(ocicommon.ConditionSynced) {
+		if c := status.GetCondition(ocicommon.ConditionSynced); c.Reason == reasonUpdated {
+			operation = operationUpdate
+		}
+	}
+	return operation, started
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPendingTracker(t *testing.T) {
+	tracker := newPendingTracker(2*time.Second, time.Minute)
+	now := time.Now()
+
+	var delays []time.Duration
+	for i := 0; i < 8; i++ {
+		delay, expired := tracker.next("ns/a", operationCreate, nil, time.Hour, now)
+		if expired {
+			t.Fatalf("Unexpected timeout on poll %d", i)
+		}
+		delays = append(delays, delay)
+	}
+	expected := []time.Duration{2, 4, 8, 16, 32, 60, 60, 60}
+	for i, d := range expected {
+		if delays[i] != d*time.Second {
+			t.Errorf("Poll %d expected delay %v got %v", i, d*time.Second, delays[i])
+		}
+	}
+
+	if _, expired := tracker.next("ns/a", operationCreate, nil, time.Hour, now.Add(61*time.Minute)); !expired {
+		t.Errorf("Expected create to time out")
+	}
+
+	// a new operation restarts the backoff and the timeout
+	if delay, expired := tracker.next("ns/a", operationUpdate, nil, time.Hour, now.Add(62*time.Minute)); expired || delay != 2*time.Second {
+		t.Errorf("Expected update to restart polling, got delay %v expired %v", delay, expired)
+	}
+
+	// the accepted time of a work request counts towards the timeout
+	accepted := metav1.NewTime(now.Add(-2 * time.Hour))
+	if _, expired := tracker.next("ns/b", operationCreate, &accepted, time.Hour, now); !expired {
+		t.Errorf("Expected work request accepted before the timeout to expire")
+	}
+
+	// a forced retry restarts the timeout regardless of the accepted time
+	tracker.restart("ns/b", now)
+	if _, expired := tracker.next("ns/b", operationCreate, &accepted, time.Hour, now.Add(time.Minute)); expired {
+		t.Errorf("Expected restarted operation not to expire")
+	}
+
+	tracker.forget("ns/b")
+	if _, expired := tracker.next("ns/b", reasonDependentsPresent, nil, 0, now.Add(24*time.Hour)); expired {
+		t.Errorf("Expected no timeout without a timeout")
+	}
+}
+
+func TestPendingOperation(t *testing.T) {
+	vcn := &corev1alpha1.Vcn{}
+	if op, _ := pendingOperation(vcn, false); op != operationCreate {
+		t.Errorf("Expected create, got %s", op)
+	}
+
+	accepted := metav1.Now()
+	vcn.Status.WorkRequest = &ocicommon.WorkRequestStatus{Id: "wr", Operation: "UpdateLoadBalancer", Accepted: &accepted}
+	if op, started := pendingOperation(vcn, false); op != operationUpdate || started != &accepted {
+		t.Errorf("Expected update started at work request accept time, got %s %v", op, started)
+	}
+
+	vcn.Status.SetCondition(ocicommon.ConditionDeleting, ocicommon.ConditionTrue, reasonDependentsPresent, "", 1)
+	op, _ := pendingOperation(vcn, true)
+	if timeout := resourcescommon.DefaultRetryPolicy().PendingTimeout(op); op != reasonDependentsPresent || timeout != 0 {
+		t.Errorf("Expected waiting for dependents without timeout, got %s %v", op, timeout)
+	}
+}
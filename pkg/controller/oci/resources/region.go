@@ -0,0 +1,263 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Condition reasons of the region checks
+const (
+	reasonRegionMismatch            = "RegionMismatch"
+	reasonInvalidAvailabilityDomain = "InvalidAvailabilityDomain"
+)
+
+// regionError is returned when an object refers to an object of another region or
+// to an availability domain its region does not have
+type regionError struct {
+	reason string
+	msg    string
+}
+
+func (e *regionError) Error() string {
+	return e.msg
+}
+
+// availabilityDomainObject is an object placed in an availability domain
+type availabilityDomainObject interface {
+	GetAvailabilityDomain() string
+}
+
+// objectRegion returns the region selected by the region annotation of the object, the
+// Compartment its compartmentRef names or its namespace, empty for the region of the
+// credentials
+func (c *Controller) objectRegion(obj runtime.Object) string {
+	objectmeta, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	if region := objectmeta.GetAnnotations()[ocicommon.RegionAnnotation]; region != "" {
+		return region
+	}
+	if ref, ok := obj.(ocicommon.ImportInterface); ok {
+		if name := ref.GetCompartmentRef(); name != "" && !resourcescommon.IsOcid(name) {
+			if compartment := c.compartment(objectmeta.GetNamespace(), name); compartment != nil {
+				if region := compartment.Annotations[ocicommon.RegionAnnotation]; region != "" {
+					return region
+				}
+			}
+		}
+	}
+	if c.namespaces == nil || objectmeta.GetNamespace() == "" {
+		return ""
+	}
+	if ns, err := c.namespaces.Get(objectmeta.GetNamespace()); err == nil {
+		return ns.Annotations[ocicommon.RegionAnnotation]
+	}
+	return ""
+}
+
+// compartment returns the Compartment object of a namespace from the informer cache
+func (c *Controller) compartment(namespace, name string) *ociidentityv1alpha1.Compartment {
+	resourceType, ok := resourcescommon.ResourceTypes()[ociidentityv1alpha1.CompartmentKind]
+	if !ok {
+		return nil
+	}
+	informer := c.resourceInformer(resourceType)
+	if informer == nil {
+		return nil
+	}
+	obj, exists, err := informer.GetStore().GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return nil
+	}
+	compartment, _ := obj.(*ociidentityv1alpha1.Compartment)
+	return compartment
+}
+
+// region returns the region the OCI resource of an object is in, the region of the
+// credentials of its namespace unless the object selects another one
+func (c *Controller) region(obj runtime.Object) string {
+	if region := c.objectRegion(obj); region != "" {
+		return region
+	}
+	objectmeta, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	_, _, provider, err := c.namespaceProvider(objectmeta.GetNamespace())
+	if err != nil || provider == nil {
+		return ""
+	}
+	region, _ := provider.Region()
+	return region
+}
+
+// tenancyWideKinds are the kinds whose OCI resources belong to the tenancy rather than to
+// a region, OCI only accepts their writes in the home region
+var tenancyWideKinds = map[string]bool{
+	ociidentityv1alpha1.CompartmentKind:  true,
+	ociidentityv1alpha1.PolicyKind:       true,
+	ociidentityv1alpha1.DynamicGroupKind: true,
+}
+
+// tenancyWide reports if the OCI resource of an object belongs to the tenancy rather
+// than to a region, i.e. compartments, policies and dynamic groups
+func tenancyWide(obj runtime.Object) bool {
+	switch obj.(type) {
+	case *ociidentityv1alpha1.Compartment, *ociidentityv1alpha1.Policy, *ociidentityv1alpha1.DynamicGroup:
+		return true
+	}
+	return false
+}
+
+// checkRegion refuses dependencies on objects of another region, their OCI resources
+// can not be referred to across regions. Identity objects are tenancy-wide and can be
+// referred to from any region. The availability domain of an object must be one of the
+// availability domains its Compartment lists, if the Compartment is in the same region.
+func (c *Controller) checkRegion(obj runtime.Object, deps []runtime.Object) *regionError {
+	region := c.region(obj)
+	for _, dep := range deps {
+		if tenancyWide(obj) || tenancyWide(dep) {
+			continue
+		}
+		if depRegion := c.region(dep); depRegion != region {
+			return &regionError{reasonRegionMismatch,
+				fmt.Sprintf("%s is in region %s, not in %s", describe(dep), depRegion, region)}
+		}
+	}
+
+	placed, ok := obj.(availabilityDomainObject)
+	if !ok || placed.GetAvailabilityDomain() == "" {
+		return nil
+	}
+	for _, dep := range deps {
+		compartment, ok := dep.(*ociidentityv1alpha1.Compartment)
+		if !ok || len(compartment.Status.AvailabilityDomains) == 0 || c.region(compartment) != region {
+			continue
+		}
+		found := false
+		for _, ad := range compartment.Status.AvailabilityDomains {
+			found = found || ad == placed.GetAvailabilityDomain()
+		}
+		if !found {
+			return &regionError{reasonInvalidAvailabilityDomain,
+				fmt.Sprintf("availability domain %s is not in region %s, compartment %s has %s", placed.GetAvailabilityDomain(),
+					region, compartment.Name, strings.Join(compartment.Status.AvailabilityDomains, ", "))}
+		}
+	}
+	return nil
+}
+
+// homeRegionAdapter makes the writes of a tenancy-wide kind with the adapter of the
+// credentials region, its reads stay in the region of the object so that a Compartment
+// lists the availability domains, shapes and images of its region
+type homeRegionAdapter struct {
+	resourcescommon.ResourceTypeAdapter
+	home resourcescommon.ResourceTypeAdapter
+}
+
+// homeRegionOwnedAdapter is a homeRegionAdapter of a kind that is swept for orphans
+type homeRegionOwnedAdapter struct {
+	*homeRegionAdapter
+}
+
+// newHomeRegionAdapter returns an adapter reading with regional and writing with home
+func newHomeRegionAdapter(regional, home resourcescommon.ResourceTypeAdapter) resourcescommon.ResourceTypeAdapter {
+	adapter := &homeRegionAdapter{ResourceTypeAdapter: regional, home: home}
+	_, regionalOwned := regional.(resourcescommon.OwnedResourceAdapter)
+	_, homeOwned := home.(resourcescommon.OwnedResourceAdapter)
+	if regionalOwned && homeOwned {
+		return &homeRegionOwnedAdapter{adapter}
+	}
+	return adapter
+}
+
+func (a *homeRegionAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	return a.home.Create(obj)
+}
+
+func (a *homeRegionAdapter) Update(obj runtime.Object) (runtime.Object, error) {
+	return a.home.Update(obj)
+}
+
+func (a *homeRegionAdapter) Delete(obj runtime.Object) (runtime.Object, error) {
+	return a.home.Delete(obj)
+}
+
+// WithContext returns the adapter with both the regional and the home adapter using ctx
+func (a *homeRegionAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	return newHomeRegionAdapter(withContext(a.ResourceTypeAdapter, ctx), withContext(a.home, ctx))
+}
+
+func (a *homeRegionOwnedAdapter) WithContext(ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	return a.homeRegionAdapter.WithContext(ctx)
+}
+
+func (a *homeRegionOwnedAdapter) ListOwned(compartmentId string) ([]resourcescommon.OwnedResource, error) {
+	return a.ResourceTypeAdapter.(resourcescommon.OwnedResourceAdapter).ListOwned(compartmentId)
+}
+
+func (a *homeRegionOwnedAdapter) DeleteOwned(id string) error {
+	return a.home.(resourcescommon.OwnedResourceAdapter).DeleteOwned(id)
+}
+
+func (a *homeRegionOwnedAdapter) ReleaseOwned(id string) error {
+	return a.home.(resourcescommon.OwnedResourceAdapter).ReleaseOwned(id)
+}
+
+// withContext returns the adapter using ctx if it supports a context
+func withContext(adapter resourcescommon.ResourceTypeAdapter, ctx context.Context) resourcescommon.ResourceTypeAdapter {
+	if contextAdapter, ok := adapter.(resourcescommon.ContextAdapter); ok {
+		return contextAdapter.WithContext(ctx)
+	}
+	return adapter
+}
+
+// describe names an object in messages
+func describe(obj runtime.Object) string {
+	if id, ok := objectID(obj, kindsByPlural()); ok {
+		return id
+	}
+	key, _ := cache.MetaNamespaceKeyFunc(obj)
+	return key
+}
+
+// refuseRegion records a dependency on another region or an availability domain outside
+// of the region, nothing is created until the object or its dependencies change
+func (c *Controller) refuseRegion(kind, key string, source, object runtime.Object, mismatch *regionError, generation int64) (runtime.Object, error, bool) {
+	setCondition(object, ocicommon.ConditionDependenciesReady, ocicommon.ConditionFalse, mismatch.reason, mismatch.msg, generation)
+	setCondition(object, ocicommon.ConditionReady, ocicommon.ConditionFalse, mismatch.reason, mismatch.msg, generation)
+	if !conditionsChanged(source, object) {
+		return nil, nil, false
+	}
+	errMsg := fmt.Sprintf("ERROR refusing region of %s %s: %v", kind, key, mismatch)
+	glog.Error(errMsg)
+	c.recorder.Event(object, corev1.EventTypeWarning, eventTypeResourceError, errMsg)
+	return object, nil, false
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	ocisdkcommon "github.com/oracle/oci-go-sdk/common"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	_ "github.com/oracle/oci-manager/pkg/controller/oci/resources/identity"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// newRegionController returns a vcn controller with the namespace and compartment in its caches
+func newRegionController(t *testing.T, namespace *corev1.Namespace, compartment *ociidentityv1alpha1.Compartment) *Controller {
+	clientset := fakeclient.NewSimpleClientset()
+	kubeclient := fake.NewSimpleClientset()
+	namespaces := kubeinformers.NewSharedInformerFactory(kubeclient, 30*time.Second).Core().V1().Namespaces()
+	if err := namespaces.Informer().GetStore().Add(namespace); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())
+	workQueues := map[string]workqueue.RateLimitingInterface{
+		vcnAdapter.Kind(): workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+	c := New(vcnAdapter, kubeclient, informers.NewSharedInformerFactory(clientset, 30*time.Second), namespaces, workQueues)
+	if compartment != nil {
+		informer := c.resourceInformer(resourcescommon.ResourceTypes()[ociidentityv1alpha1.CompartmentKind])
+		if err := informer.GetStore().Add(compartment); err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+	}
+	return c
+}
+
+func regionCompartment(region string, availabilityDomains ...string) *ociidentityv1alpha1.Compartment {
+	compartment := &ociidentityv1alpha1.Compartment{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: fakeNs},
+	}
+	if region != "" {
+		compartment.Annotations = map[string]string{ocicommon.RegionAnnotation: region}
+	}
+	compartment.Status.AvailabilityDomains = availabilityDomains
+	compartment.SetResourceID("ocid1.compartment.oc1..team")
+	return compartment
+}
+
+func TestObjectRegion(t *testing.T) {
+	testCases := []struct {
+		name              string
+		objectRegion      string
+		compartmentRegion string
+		namespaceRegion   string
+		expectRegion      string
+	}{
+		{name: "no region"},
+		{name: "namespace", namespaceRegion: "us-ashburn-1", expectRegion: "us-ashburn-1"},
+		{name: "compartment", compartmentRegion: "eu-frankfurt-1", namespaceRegion: "us-ashburn-1", expectRegion: "eu-frankfurt-1"},
+		{name: "object", objectRegion: "uk-london-1", compartmentRegion: "eu-frankfurt-1", namespaceRegion: "us-ashburn-1", expectRegion: "uk-london-1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}
+			if tc.namespaceRegion != "" {
+				namespace.Annotations = map[string]string{ocicommon.RegionAnnotation: tc.namespaceRegion}
+			}
+			c := newRegionController(t, namespace, regionCompartment(tc.compartmentRegion))
+
+			vcn := &corev1alpha1.Vcn{
+				ObjectMeta: metav1.ObjectMeta{Name: "vcn1", Namespace: fakeNs},
+				Spec:       corev1alpha1.VcnSpec{CompartmentRef: "team"},
+			}
+			if tc.objectRegion != "" {
+				vcn.Annotations = map[string]string{ocicommon.RegionAnnotation: tc.objectRegion}
+			}
+			if region := c.objectRegion(vcn); region != tc.expectRegion {
+				t.Errorf("Expected region %q, got %q", tc.expectRegion, region)
+			}
+		})
+	}
+}
+
+func TestCheckRegion(t *testing.T) {
+	vcn := &corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{Name: "vcn1", Namespace: fakeNs,
+			Annotations: map[string]string{ocicommon.RegionAnnotation: "eu-frankfurt-1"}},
+	}
+	instance := func(region, ad string) *corev1alpha1.Instance {
+		instance := &corev1alpha1.Instance{
+			ObjectMeta: metav1.ObjectMeta{Name: "instance1", Namespace: fakeNs},
+			Spec:       corev1alpha1.InstanceSpec{CompartmentRef: "team", AvailabilityDomain: ad},
+		}
+		if region != "" {
+			instance.Annotations = map[string]string{ocicommon.RegionAnnotation: region}
+		}
+		return instance
+	}
+
+	testCases := []struct {
+		name         string
+		object       runtime.Object
+		deps         []runtime.Object
+		expectReason string
+	}{
+		{name: "same region", object: instance("eu-frankfurt-1", "Uocm:FRA-AD-1"),
+			deps: []runtime.Object{regionCompartment("eu-frankfurt-1", "Uocm:FRA-AD-1"), vcn}},
+		{name: "vcn in other region", object: instance("", ""),
+			deps: []runtime.Object{regionCompartment(""), vcn}, expectReason: reasonRegionMismatch},
+		{name: "compartment of other region", object: instance("eu-frankfurt-1", ""),
+			deps: []runtime.Object{regionCompartment("")}},
+		{name: "policy of other region", object: &ociidentityv1alpha1.Policy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy1", Namespace: fakeNs,
+				Annotations: map[string]string{ocicommon.RegionAnnotation: "eu-frankfurt-1"}}},
+			deps: []runtime.Object{regionCompartment("")}},
+		{name: "vcn of other region in compartment of other region", object: instance("", ""),
+			deps: []runtime.Object{regionCompartment("uk-london-1"), vcn}, expectReason: reasonRegionMismatch},
+		{name: "availability domain of other region", object: instance("eu-frankfurt-1", "Uocm:PHX-AD-1"),
+			deps: []runtime.Object{regionCompartment("eu-frankfurt-1", "Uocm:FRA-AD-1")}, expectReason: reasonInvalidAvailabilityDomain},
+		{name: "availability domains not listed yet", object: instance("eu-frankfurt-1", "Uocm:PHX-AD-1"),
+			deps: []runtime.Object{regionCompartment("eu-frankfurt-1")}},
+		{name: "availability domain of instance region", object: instance("uk-london-1", "Uocm:LHR-AD-1"),
+			deps: []runtime.Object{regionCompartment("eu-frankfurt-1", "Uocm:FRA-AD-1")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newRegionController(t, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}, nil)
+			mismatch := c.checkRegion(tc.object, tc.deps)
+			if tc.expectReason == "" {
+				if mismatch != nil {
+					t.Errorf("Expected no region error, got %v", mismatch)
+				}
+				return
+			}
+			if mismatch == nil || mismatch.reason != tc.expectReason {
+				t.Errorf("Expected region error with reason %s, got %v", tc.expectReason, mismatch)
+			}
+		})
+	}
+}
+
+func TestRegionAdapter(t *testing.T) {
+	c := newRegionController(t, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}, nil)
+	var regions []string
+	c.setAdapterFactory(ocisdkcommon.NewRawConfigurationProvider("tenancy", "user", "us-phoenix-1", "fingerprint", "key", nil),
+		func(provider ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter {
+			region, _ := provider.Region()
+			regions = append(regions, region)
+			return coreresources.NewVcnAdapterBasic(fakeclient.NewSimpleClientset(), fakeoci.NewVcnClient())
+		})
+
+	objectmeta := &metav1.ObjectMeta{Name: "vcn1", Namespace: fakeNs}
+	if adapter, _, err := c.ociAdapter(objectmeta, ""); err != nil || adapter != c.adapter {
+		t.Errorf("Expected the default adapter without region, got %v %v", adapter, err)
+	}
+	first, _, err := c.ociAdapter(objectmeta, "eu-frankfurt-1")
+	if err != nil || first == c.adapter {
+		t.Fatalf("Expected an adapter for the region, got %v %v", first, err)
+	}
+	if second, _, _ := c.ociAdapter(objectmeta, "eu-frankfurt-1"); second != first {
+		t.Errorf("Expected the adapter of the region to be cached")
+	}
+	if len(regions) != 1 || regions[0] != "eu-frankfurt-1" {
+		t.Errorf("Expected one adapter built for eu-frankfurt-1, got %v", regions)
+	}
+}
+
+// createRecorder records the region of the adapter each create is made with
+type createRecorder struct {
+	resourcescommon.ResourceTypeAdapter
+	region  string
+	creates *[]string
+}
+
+func (a *createRecorder) Create(obj runtime.Object) (runtime.Object, error) {
+	*a.creates = append(*a.creates, a.region)
+	return obj, nil
+}
+
+// kindAdapter gives an adapter the kind of another controller
+type kindAdapter struct {
+	resourcescommon.ResourceTypeAdapter
+	kind string
+}
+
+func (a *kindAdapter) Kind() string {
+	return a.kind
+}
+
+func TestRegionAdapterTenancyWide(t *testing.T) {
+	c := newRegionController(t, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fakeNs}}, nil)
+	// the default adapter is the one of the credentials region
+	var creates []string
+	c.adapter = &kindAdapter{kind: ociidentityv1alpha1.CompartmentKind,
+		ResourceTypeAdapter: &createRecorder{ResourceTypeAdapter: c.adapter, region: "us-phoenix-1", creates: &creates}}
+	c.setAdapterFactory(ocisdkcommon.NewRawConfigurationProvider("tenancy", "user", "us-phoenix-1", "fingerprint", "key", nil),
+		func(provider ocisdkcommon.ConfigurationProvider) resourcescommon.ResourceTypeAdapter {
+			region, _ := provider.Region()
+			vcnAdapter := coreresources.NewVcnAdapterBasic(fakeclient.NewSimpleClientset(), fakeoci.NewVcnClient())
+			return &createRecorder{ResourceTypeAdapter: vcnAdapter, region: region, creates: &creates}
+		})
+
+	objectmeta := &metav1.ObjectMeta{Name: "team", Namespace: fakeNs}
+	adapter, _, err := c.ociAdapter(objectmeta, "eu-frankfurt-1")
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if regional, ok := adapter.(*homeRegionAdapter); !ok || regional.ResourceTypeAdapter.(*createRecorder).region != "eu-frankfurt-1" {
+		t.Errorf("Expected the reads to be made in eu-frankfurt-1, got %v", adapter)
+	}
+	if _, err := adapter.Create(&ociidentityv1alpha1.Compartment{}); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if len(creates) != 1 || creates[0] != "us-phoenix-1" {
+		t.Errorf("Expected the create to be made in the region of the credentials, got %v", creates)
+	}
+}
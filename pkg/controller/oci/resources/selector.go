@@ -0,0 +1,102 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// listDependsOn returns the objects of the resource kind matching a DependsOn relation from the informer cache
+func (c *Controller) listDependsOn(resource string, dependsOn ocicommon.DependsOn) ([]runtime.Object, error) {
+	sgvr := c.adapter.GroupVersionWithResource()
+	sgvr.Resource = resource
+	informer, err := c.factory.ForResource(sgvr)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := dependsOnSelector(dependsOn)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := informer.Lister().List(selector)
+	if err != nil || len(dependsOn.FieldSelector) == 0 {
+		return candidates, err
+	}
+
+	var matches []runtime.Object
+	for _, candidate := range candidates {
+		match, err := matchesFields(candidate, dependsOn.FieldSelector)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches, nil
+}
+
+// dependsOnSelector combines the labels and the set-based expressions of a DependsOn relation
+func dependsOnSelector(dependsOn ocicommon.DependsOn) (labels.Selector, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+		MatchLabels:      dependsOn.LabelSelector,
+		MatchExpressions: dependsOn.MatchExpressions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid dependson label selector: %v", err)
+	}
+	return selector, nil
+}
+
+// matchesFields reports if the fields of obj have the selected values. Paths follow the
+// JSON form of the object as seen by users. A missing field has the empty value, so an
+// empty value selects objects without the field.
+func matchesFields(obj runtime.Object, fields map[string]string) (bool, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+	content := map[string]interface{}{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&content); err != nil {
+		return false, err
+	}
+	for path, expected := range fields {
+		value, found, err := unstructured.NestedFieldNoCopy(content, strings.Split(path, ".")...)
+		if err != nil {
+			return false, fmt.Errorf("invalid dependson field %s: %v", path, err)
+		}
+		actual := ""
+		if found && value != nil {
+			actual = fmt.Sprint(value)
+		}
+		if actual != expected {
+			return false, nil
+		}
+	}
+	return true, nil
+}
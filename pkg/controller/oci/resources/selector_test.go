@@ -0,0 +1,133 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+	"time"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func testSubnet(name, ad string, state ocicommon.ResourceState) *corev1alpha1.Subnet {
+	return &corev1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: fakeNs,
+			Labels:    map[string]string{"ad": ad},
+		},
+		Spec: corev1alpha1.SubnetSpec{
+			AvailabilityDomain: ad,
+		},
+		Status: corev1alpha1.SubnetStatus{
+			ResourceStatus: ocicommon.ResourceStatus{State: state},
+		},
+	}
+}
+
+func TestMatchesFields(t *testing.T) {
+	subnet := testSubnet("subnet1", "AD-1", ocicommon.ResourceStateProcessed)
+
+	tests := []struct {
+		fields map[string]string
+		match  bool
+	}{
+		{map[string]string{"metadata.name": "subnet1"}, true},
+		{map[string]string{"metadata.name": "subnet2"}, false},
+		{map[string]string{"metadata.namespace": fakeNs, "status.state": "Processed"}, true},
+		{map[string]string{"status.state": "Pending"}, false},
+		{map[string]string{"spec.availabilityDomain": "AD-1"}, true},
+		{map[string]string{"status.message": ""}, true},
+	}
+	for _, test := range tests {
+		match, err := matchesFields(subnet, test.fields)
+		if err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+		if match != test.match {
+			t.Errorf("Fields %v expected match %v got %v", test.fields, test.match, match)
+		}
+	}
+}
+
+func TestDependsOnSelector(t *testing.T) {
+	selector, err := dependsOnSelector(ocicommon.DependsOn{
+		LabelSelector: map[string]string{"tier": "db"},
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "ad", Operator: metav1.LabelSelectorOpIn, Values: []string{"AD-1", "AD-2"}},
+			{Key: "legacy", Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if !selector.Matches(labels.Set{"tier": "db", "ad": "AD-2"}) {
+		t.Errorf("Expected selector %s to match", selector)
+	}
+	if selector.Matches(labels.Set{"tier": "db", "ad": "AD-3"}) || selector.Matches(labels.Set{"tier": "db", "ad": "AD-1", "legacy": "true"}) {
+		t.Errorf("Expected selector %s not to match", selector)
+	}
+
+	if _, err := dependsOnSelector(ocicommon.DependsOn{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ad", Operator: metav1.LabelSelectorOpIn}},
+	}); err == nil {
+		t.Errorf("Expected error for In without values")
+	}
+}
+
+func TestListDependsOn(t *testing.T) {
+	clientset := fakeclient.NewSimpleClientset(
+		testSubnet("subnet1", "AD-1", ocicommon.ResourceStateProcessed),
+		testSubnet("subnet2", "AD-1", ocicommon.ResourceStatePending),
+		testSubnet("subnet3", "AD-2", ocicommon.ResourceStateProcessed),
+	)
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	workQueues := map[string]workqueue.RateLimitingInterface{}
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, fakeoci.NewVcnClient())
+	workQueues[vcnAdapter.Kind()] = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	// register the subnet informer before starting the factory
+	if _, err := controller.listDependsOn("subnets", ocicommon.DependsOn{}); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	// any subnet in AD-1 that is Processed
+	deps, err := controller.listDependsOn("subnets", ocicommon.DependsOn{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ad", Operator: metav1.LabelSelectorOpIn, Values: []string{"AD-1"}}},
+		FieldSelector:    map[string]string{"status.state": string(ocicommon.ResourceStateProcessed)},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	if len(deps) != 1 || deps[0].(*corev1alpha1.Subnet).Name != "subnet1" {
+		t.Errorf("Expected only subnet1, got %v", deps)
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	"github.com/oracle/oci-manager/pkg/metrics"
+)
+
+// Event reasons of the orphan sweeper
+const (
+	eventTypeOrphanedResource = "OrphanedResource"
+	eventTypeOrphanDeleted    = "OrphanedResourceDeleted"
+)
+
+// Outcomes of orphan deletes used as metric label values
+const (
+	orphanDeleted = "deleted"
+	orphanPlanned = "planned"
+	orphanError   = "error"
+)
+
+var (
+	orphanedResources = metrics.NewGaugeVec("ocimanager_orphaned_resources",
+		"Number of OCI resources tagged by the cluster without an object managing them, by kind", "kind")
+	orphanDeletesTotal = metrics.NewCounterVec("ocimanager_orphan_deletes_total",
+		"Total number of deletes of orphaned OCI resources by kind and outcome", "kind", "outcome")
+)
+
+// Orphan is an OCI resource carrying the ownership tags of the cluster that no object manages
+type Orphan struct {
+	Kind          string
+	CompartmentId string
+	Resource      resourcescommon.OwnedResource
+	Reason        string
+}
+
+// Sweeper periodically looks for orphaned OCI resources. They are left behind when an object
+// is deleted without its finalizer, or when the manager stops between the create of a resource
+// and writing its OCID to the status of the object.
+type Sweeper struct {
+	controllers map[string]*Controller
+	policy      resourcescommon.SweepPolicy
+	now         func() time.Time
+}
+
+// sweptCompartment is a compartment listed for the orphans of a kind
+type sweptCompartment struct {
+	id      string
+	object  runtime.Object
+	adapter resourcescommon.OwnedResourceAdapter
+}
+
+// NewSweeper returns a sweeper for the kinds of the controllers whose adapter lists owned resources
+func NewSweeper(controllers map[string]*Controller, policy resourcescommon.SweepPolicy) *Sweeper {
+	return &Sweeper{controllers: controllers, policy: policy, now: time.Now}
+}
+
+// Run sweeps every interval of the policy until stopCh is closed
+func (s *Sweeper) Run(stopCh <-chan struct{}) {
+	if s.policy.Disabled {
+		glog.Infof("Orphan sweeper is disabled")
+		return
+	}
+	glog.Infof("Starting orphan sweeper every %v, delete orphans: %v", s.policy.Interval.Duration, s.policy.Delete)
+	go wait.Until(func() { s.Sweep() }, s.policy.Interval.Duration, stopCh)
+}
+
+// Sweep lists the owned resources of every kind in the swept compartments once, reports the
+// orphans and deletes them if the policy says so
+func (s *Sweeper) Sweep() []Orphan {
+	clusterID := resourcescommon.ClusterID()
+	if clusterID == "" {
+		glog.Warningf("Skipping orphan sweep, the cluster id is not known")
+		return nil
+	}
+
+	var orphans []Orphan
+	parents := make(map[string]bool)
+	for _, kind := range sweepOrder(s.controllers) {
+		c := s.controllers[kind]
+		if _, ok := c.adapter.(resourcescommon.OwnedResourceAdapter); !ok || !c.HasSynced() {
+			continue
+		}
+		found := s.sweepKind(c, clusterID, parents)
+		orphanedResources.Set(float64(len(found)), kind)
+		orphans = append(orphans, found...)
+	}
+	return orphans
+}
+
+// sweepOrder returns the kinds of the controllers in the order they are swept, the kinds
+// other resources live in or refer to come last so their orphans are deleted after the
+// orphans of the resources depending on them
+func sweepOrder(controllers map[string]*Controller) []string {
+	var kinds []string
+	for _, kind := range sortedKinds(controllers) {
+		if !isParentKind(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	for _, kind := range parentKinds {
+		if _, ok := controllers[kind]; ok {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+// parentKinds are swept after all other kinds, each after the ones depending on it
+var parentKinds = []string{
+	ocicorev1alpha1.SubnetKind,
+	ocicorev1alpha1.RouteTableKind,
+	ocicorev1alpha1.SecurityRuleSetKind,
+	ocicorev1alpha1.DhcpOptionKind,
+	ocicorev1alpha1.InternetGatewayKind,
+	ocicorev1alpha1.VirtualNetworkKind,
+	ociidentityv1alpha1.CompartmentKind,
+}
+
+func isParentKind(kind string) bool {
+	for _, parent := range parentKinds {
+		if parent == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// sweepKind returns the orphans of the kind of a controller. The ids of the resources the
+// owned resources of the kind live in or refer to are added to parents, an orphan found
+// in parents is not deleted before the resources depending on it are gone.
+func (s *Sweeper) sweepKind(c *Controller, clusterID string, parents map[string]bool) []Orphan {
+	kind := c.adapter.Kind()
+
+	// the OCID of the resource of every object by its uid
+	owners := make(map[string]string)
+	for _, obj := range c.informer.GetStore().List() {
+		object := obj.(runtime.Object)
+		owners[string(c.adapter.ObjectMeta(object).UID)] = c.adapter.Id(object)
+	}
+
+	var orphans []Orphan
+	for _, compartment := range s.compartments(c) {
+		resources, err := compartment.adapter.ListOwned(compartment.id)
+		if err != nil {
+			glog.Errorf("Error listing %s resources in compartment %s: %v", kind, compartment.id, err)
+			continue
+		}
+		for _, resource := range resources {
+			if reason := s.orphanReason(kind, clusterID, resource, owners); reason != "" {
+				orphan := Orphan{Kind: kind, CompartmentId: compartment.id, Resource: resource, Reason: reason}
+				s.report(c, compartment, orphan)
+				if s.policy.Delete && parents[resource.Id] {
+					glog.Infof("Not deleting orphaned OCI resource %s %s yet, owned resources still depend on it", kind, resource.Id)
+				} else if s.policy.Delete {
+					s.delete(c, compartment, orphan)
+				}
+				orphans = append(orphans, orphan)
+			}
+			// a deleted resource takes a while to be gone, its parents are left to the next sweep
+			parents[compartment.id] = true
+			for _, parent := range resource.Parents {
+				parents[parent] = true
+			}
+		}
+	}
+	return orphans
+}
+
+// compartments returns the compartments swept for the kind of a controller: the ones of the
+// policy with the default credentials, and the ones of the Compartment objects with the
+// credentials and region selected for them. A compartment is listed once per credentials
+// and region.
+func (s *Sweeper) compartments(c *Controller) []sweptCompartment {
+	var compartments []sweptCompartment
+	seen := make(map[string]bool)
+
+	for _, id := range s.policy.Compartments {
+		if !seen[id+"//"] {
+			seen[id+"//"] = true
+			compartments = append(compartments, sweptCompartment{id: id, adapter: c.adapter.(resourcescommon.OwnedResourceAdapter)})
+		}
+	}
+
+	compartmentController, ok := s.controllers[ociidentityv1alpha1.CompartmentKind]
+	if !ok || !compartmentController.HasSynced() {
+		return compartments
+	}
+	for _, obj := range compartmentController.informer.GetStore().List() {
+		compartment, ok := obj.(*ociidentityv1alpha1.Compartment)
+		if !ok || compartment.GetResourceID() == "" {
+			continue
+		}
+		region := c.objectRegion(compartment)
+		oci, credentials, err := c.ociAdapter(&compartment.ObjectMeta, region)
+		if err != nil {
+			glog.Errorf("Error selecting the credentials of compartment %s/%s: %v", compartment.Namespace, compartment.Name, err)
+			continue
+		}
+		key := compartment.GetResourceID() + "/" + credentials + "/" + region
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if owned, ok := oci.(resourcescommon.OwnedResourceAdapter); ok {
+			compartments = append(compartments, sweptCompartment{id: compartment.GetResourceID(), object: compartment, adapter: owned})
+		}
+	}
+	return compartments
+}
+
+// orphanReason returns why a resource is orphaned, or empty if it is not orphaned. Resources
+// of other clusters and kinds, and resources younger than the grace period are never orphaned.
+func (s *Sweeper) orphanReason(kind, clusterID string, resource resourcescommon.OwnedResource, owners map[string]string) string {
+	tags := resource.Tags
+	uid := tags[resourcescommon.TagUID]
+	if tags[resourcescommon.TagClusterID] != clusterID || tags[resourcescommon.TagKind] != kind || uid == "" {
+		return ""
+	}
+	if s.now().Sub(resource.TimeCreated) < s.policy.GracePeriod.Duration {
+		return ""
+	}
+
+	object := tags[resourcescommon.TagNamespace] + "/" + tags[resourcescommon.TagName]
+	id, exists := owners[uid]
+	switch {
+	case !exists:
+		return fmt.Sprintf("object %s with uid %s no longer exists", object, uid)
+	case id != "" && id != resource.Id:
+		return fmt.Sprintf("object %s manages %s instead", object, id)
+	}
+	return ""
+}
+
+// report logs an orphan and records an event on the Compartment object it was found in
+func (s *Sweeper) report(c *Controller, compartment sweptCompartment, orphan Orphan) {
+	msg := fmt.Sprintf("Orphaned OCI resource %s %s with id %s in compartment %s: %s",
+		orphan.Kind, orphan.Resource.DisplayName, orphan.Resource.Id, orphan.CompartmentId, orphan.Reason)
+	glog.Warning(msg)
+	if compartment.object != nil {
+		c.recorder.Event(compartment.object, corev1.EventTypeWarning, eventTypeOrphanedResource, msg)
+	}
+}
+
+// delete deletes an orphan, a failed delete is attempted again by the next sweep. The delete
+// is audited with the object named by the ownership tags.
+func (s *Sweeper) delete(c *Controller, compartment sweptCompartment, orphan Orphan) {
+	adapter := compartment.adapter
+	if withContext, ok := adapter.(resourcescommon.ContextAdapter); ok {
+		tags := orphan.Resource.Tags
+		ctx := resourcescommon.WithAuditObject(context.Background(), resourcescommon.AuditObject{
+			Kind:      orphan.Kind,
+			Namespace: tags[resourcescommon.TagNamespace],
+			Name:      tags[resourcescommon.TagName],
+			UID:       tags[resourcescommon.TagUID],
+			OCID:      orphan.Resource.Id,
+		})
+		adapter = withContext.WithContext(ctx).(resourcescommon.OwnedResourceAdapter)
+	}
+	err := adapter.DeleteOwned(orphan.Resource.Id)
+	if resourcescommon.IsNotFound(err) {
+		err = nil
+	}
+
+	msg, eventType, outcome := fmt.Sprintf("Deleted orphaned OCI resource %s %s", orphan.Kind, orphan.Resource.Id), corev1.EventTypeNormal, orphanDeleted
+	if planned, ok := resourcescommon.IsPlannedCall(err); ok {
+		msg, outcome = fmt.Sprintf("Planned delete of orphaned OCI resource %s %s, %v", orphan.Kind, orphan.Resource.Id, planned), orphanPlanned
+	} else if err != nil {
+		msg, eventType, outcome = fmt.Sprintf("ERROR deleting orphaned OCI resource %s %s: %v", orphan.Kind, orphan.Resource.Id, err), corev1.EventTypeWarning, orphanError
+	}
+
+	orphanDeletesTotal.Inc(orphan.Kind, outcome)
+	glog.Info(msg)
+	if compartment.object != nil {
+		c.recorder.Event(compartment.object, eventType, eventTypeOrphanDeleted, msg)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ocicore "github.com/oracle/oci-go-sdk/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	corev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	coreresources "github.com/oracle/oci-manager/pkg/controller/oci/resources/core"
+	fakeoci "github.com/oracle/oci-manager/pkg/controller/oci/resources/fake"
+)
+
+func TestSweeper(t *testing.T) {
+	resourcescommon.SetClusterID("cluster1")
+	defer resourcescommon.SetClusterID("")
+
+	compartmentId := "ocid1.compartment.oc1..sweep"
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := fakeoci.NewVcnClient()
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+
+	createVcn := func(uid string, tags map[string]string) string {
+		if tags == nil {
+			tags = resourcescommon.OwnershipTags(corev1alpha1.VirtualNetworkKind, &metav1.ObjectMeta{Name: "vcn." + uid, Namespace: fakeNs, UID: types.UID(uid)})
+		}
+		created, err := vcnClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+			CreateVcnDetails: ocicore.CreateVcnDetails{CompartmentId: &compartmentId, FreeformTags: tags},
+		})
+		if err != nil {
+			t.Fatalf("Got error %v", err)
+		}
+		return *created.Vcn.Id
+	}
+
+	managed := createVcn("managed", nil)
+	deleted := createVcn("deleted", nil)
+	duplicate := createVcn("managed", nil)
+	otherCluster := resourcescommon.OwnershipTags(corev1alpha1.VirtualNetworkKind, &metav1.ObjectMeta{Name: "vcn.other", Namespace: fakeNs, UID: "other"})
+	otherCluster[resourcescommon.TagClusterID] = "cluster2"
+	createVcn("other", otherCluster)
+	createVcn("untagged", map[string]string{"team": "db"})
+
+	vcn := corev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{Name: "vcn.managed", Namespace: fakeNs, UID: "managed"},
+		Status: corev1alpha1.VcnStatus{
+			Resource: &corev1alpha1.VcnResource{Vcn: ocicore.Vcn{Id: &managed}},
+		},
+	}
+	if _, err := vcnAdapter.CreateObject(&vcn); err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	workQueues := map[string]workqueue.RateLimitingInterface{
+		vcnAdapter.Kind(): workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+	controller := New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go controller.informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, controller.HasSynced) {
+		t.Fatalf("Timed out waiting for the informer")
+	}
+
+	policy := resourcescommon.DefaultSweepPolicy().Merge(resourcescommon.SweepPolicy{Compartments: []string{compartmentId}})
+	sweeper := NewSweeper(map[string]*Controller{vcnAdapter.Kind(): controller}, policy)
+
+	// resources younger than the grace period are not reported
+	sweeper.now = func() time.Time { return time.Time{}.Add(time.Minute) }
+	if orphans := sweeper.Sweep(); len(orphans) != 0 {
+		t.Errorf("Expected no orphans within the grace period, got %v", orphans)
+	}
+
+	sweeper.now = time.Now
+	orphans := sweeper.Sweep()
+	if len(orphans) != 2 {
+		t.Fatalf("Expected 2 orphans, got %v", orphans)
+	}
+	found := map[string]bool{}
+	for _, orphan := range orphans {
+		found[orphan.Resource.Id] = true
+	}
+	if !found[deleted] || !found[duplicate] {
+		t.Errorf("Expected the vcns %s and %s to be orphaned, got %v", deleted, duplicate, orphans)
+	}
+	if value := orphanedResources.Value(corev1alpha1.VirtualNetworkKind); value != 2 {
+		t.Errorf("Expected 2 orphaned resources in the metric, got %v", value)
+	}
+	if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: &deleted}); err != nil {
+		t.Errorf("Expected orphans only to be reported by default, got %v", err)
+	}
+
+	sweeper.policy.Delete = true
+	sweeper.Sweep()
+	for _, id := range []string{deleted, duplicate} {
+		if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: &id}); err == nil {
+			t.Errorf("Expected orphaned vcn %s to be deleted", id)
+		}
+	}
+	if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: &managed}); err != nil {
+		t.Errorf("Expected managed vcn to be kept, got %v", err)
+	}
+	if orphans := sweeper.Sweep(); len(orphans) != 0 {
+		t.Errorf("Expected no orphans after the delete, got %v", orphans)
+	}
+
+	resourcescommon.SetClusterID("")
+	if orphans := sweeper.Sweep(); orphans != nil {
+		t.Errorf("Expected no sweep without a cluster id, got %v", orphans)
+	}
+}
+
+func TestSweeperDeletesChildrenFirst(t *testing.T) {
+	resourcescommon.SetClusterID("cluster1")
+	defer resourcescommon.SetClusterID("")
+
+	compartmentId := "ocid1.compartment.oc1..children"
+	clientset := fakeclient.NewSimpleClientset()
+	vcnClient := fakeoci.NewVcnClient()
+	vcnAdapter := coreresources.NewVcnAdapterBasic(clientset, vcnClient)
+	subnetAdapter := coreresources.NewSubnetAdapterBasic(clientset, vcnClient)
+
+	vcn, err := vcnClient.CreateVcn(context.Background(), ocicore.CreateVcnRequest{
+		CreateVcnDetails: ocicore.CreateVcnDetails{
+			CompartmentId: &compartmentId,
+			FreeformTags:  resourcescommon.OwnershipTags(corev1alpha1.VirtualNetworkKind, &metav1.ObjectMeta{Name: "vcn.gone", Namespace: fakeNs, UID: "vcn"}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	subnet, err := vcnClient.CreateSubnet(context.Background(), ocicore.CreateSubnetRequest{
+		CreateSubnetDetails: ocicore.CreateSubnetDetails{
+			CompartmentId: &compartmentId,
+			VcnId:         vcn.Id,
+			FreeformTags:  resourcescommon.OwnershipTags(corev1alpha1.SubnetKind, &metav1.ObjectMeta{Name: "subnet.gone", Namespace: fakeNs, UID: "subnet"}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(clientset, 30*time.Second)
+	workQueues := map[string]workqueue.RateLimitingInterface{
+		vcnAdapter.Kind():    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		subnetAdapter.Kind(): workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+	controllers := map[string]*Controller{
+		vcnAdapter.Kind():    New(vcnAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues),
+		subnetAdapter.Kind(): New(subnetAdapter, fake.NewSimpleClientset(), informerFactory, nil, workQueues),
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	for _, controller := range controllers {
+		go controller.informer.Run(stopCh)
+		if !cache.WaitForCacheSync(stopCh, controller.HasSynced) {
+			t.Fatalf("Timed out waiting for the informer")
+		}
+	}
+
+	if kinds := sweepOrder(controllers); len(kinds) != 2 || kinds[0] != corev1alpha1.SubnetKind {
+		t.Errorf("Expected subnets to be swept before vcns, got %v", kinds)
+	}
+
+	policy := resourcescommon.DefaultSweepPolicy().Merge(resourcescommon.SweepPolicy{Compartments: []string{compartmentId}, Delete: true})
+	sweeper := NewSweeper(controllers, policy)
+
+	// the vcn is kept while its subnet is deleted, it is deleted by the next sweep
+	if orphans := sweeper.Sweep(); len(orphans) != 2 {
+		t.Fatalf("Expected the subnet and the vcn to be orphaned, got %v", orphans)
+	}
+	if _, err := vcnClient.GetSubnet(context.Background(), ocicore.GetSubnetRequest{SubnetId: subnet.Id}); err == nil {
+		t.Errorf("Expected orphaned subnet to be deleted")
+	}
+	if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: vcn.Id}); err != nil {
+		t.Errorf("Expected vcn to be kept while its subnet is deleted, got %v", err)
+	}
+
+	if orphans := sweeper.Sweep(); len(orphans) != 1 || orphans[0].Kind != corev1alpha1.VirtualNetworkKind {
+		t.Fatalf("Expected the vcn to be orphaned, got %v", orphans)
+	}
+	if _, err := vcnClient.GetVcn(context.Background(), ocicore.GetVcnRequest{VcnId: vcn.Id}); err == nil {
+		t.Errorf("Expected orphaned vcn to be deleted once its subnet is gone")
+	}
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health implements the liveness and readiness endpoints of the manager.
+package health
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// HealthzPath is the liveness endpoint, it succeeds as long as the process serves requests
+	HealthzPath = "/healthz"
+	// ReadyzPath is the readiness endpoint, it succeeds once all ready checks pass
+	ReadyzPath = "/readyz"
+)
+
+// Check returns an error while the manager is not ready
+type Check func() error
+
+// Checker holds the named ready checks
+type Checker struct {
+	lock   sync.RWMutex
+	checks map[string]Check
+}
+
+// NewChecker returns a checker without ready checks
+func NewChecker() *Checker {
+	return &Checker{checks: make(map[string]Check)}
+}
+
+// AddReadyCheck adds a ready check, replacing a check of the same name
+func (c *Checker) AddReadyCheck(name string, check Check) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.checks[name] = check
+}
+
+// Ready runs the ready checks and returns the errors of the failing ones by name
+func (c *Checker) Ready() map[string]error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	failed := make(map[string]error)
+	for name, check := range c.checks {
+		if err := check(); err != nil {
+			failed[name] = err
+		}
+	}
+	return failed
+}
+
+// Register adds the liveness and readiness handlers to mux
+func (c *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc(HealthzPath, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+	mux.HandleFunc(ReadyzPath, c.serveReadyz)
+}
+
+// serveReadyz answers 200 if all ready checks pass and 503 listing the failed checks otherwise,
+// with ?verbose the passing checks are listed too
+func (c *Checker) serveReadyz(w http.ResponseWriter, r *http.Request) {
+	c.lock.RLock()
+	names := make([]string, 0, len(c.checks))
+	for name := range c.checks {
+		names = append(names, name)
+	}
+	c.lock.RUnlock()
+	sort.Strings(names)
+
+	failed := c.Ready()
+	_, verbose := r.URL.Query()["verbose"]
+	if len(failed) == 0 && !verbose {
+		fmt.Fprint(w, "ok")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(failed) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	for _, name := range names {
+		if err, ok := failed[name]; ok {
+			fmt.Fprintf(w, "[-]%s failed: %v\n", name, err)
+		} else if verbose {
+			fmt.Fprintf(w, "[+]%s ok\n", name)
+		}
+	}
+	if len(failed) > 0 {
+		fmt.Fprint(w, "readyz check failed\n")
+	} else {
+		fmt.Fprint(w, "readyz check passed\n")
+	}
+}
+
+// Condition is a check which fails with its message while it is not set, e.g. while
+// the replica does not hold the leader lock
+type Condition struct {
+	lock    sync.RWMutex
+	ok      bool
+	message string
+}
+
+// NewCondition returns an unset condition
+func NewCondition(message string) *Condition {
+	return &Condition{message: message}
+}
+
+// Set sets or clears the condition
+func (c *Condition) Set(ok bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ok = ok
+}
+
+// Check implements Check
+func (c *Condition) Check() error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if !c.ok {
+		return errors.New(c.message)
+	}
+	return nil
+}
+
+// Synced is a check which fails until it is marked complete and all added informers have synced
+type Synced struct {
+	lock     sync.RWMutex
+	complete bool
+	synced   map[string]func() bool
+}
+
+// Add adds the HasSynced of a controller or informer
+func (s *Synced) Add(name string, hasSynced func() bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.synced == nil {
+		s.synced = make(map[string]func() bool)
+	}
+	s.synced[name] = hasSynced
+}
+
+// Complete marks that all informers have been added
+func (s *Synced) Complete() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.complete = true
+}
+
+// Check implements Check
+func (s *Synced) Check() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.complete {
+		return errors.New("controllers are starting")
+	}
+	var pending []string
+	for name, hasSynced := range s.synced {
+		if !hasSynced() {
+			pending = append(pending, name)
+		}
+	}
+	if len(pending) > 0 {
+		sort.Strings(pending)
+		return fmt.Errorf("caches not synced: %v", pending)
+	}
+	return nil
+}
+
+// Probe is a check running a call periodically, it fails with the error of the last call
+type Probe struct {
+	name     string
+	probe    func() error
+	interval time.Duration
+
+	lock sync.RWMutex
+	err  error
+}
+
+// NewProbe returns a probe calling probe every interval once it is run
+func NewProbe(name string, interval time.Duration, probe func() error) *Probe {
+	return &Probe{name: name, probe: probe, interval: interval, err: errors.New("not probed yet")}
+}
+
+// Run calls the probe until stopCh is closed
+func (p *Probe) Run(stopCh <-chan struct{}) {
+	go wait.Until(p.run, p.interval, stopCh)
+}
+
+func (p *Probe) run() {
+	err := p.probe()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err != nil && p.err == nil {
+		glog.Warningf("Probe %s failed: %v", p.name, err)
+	} else if err == nil && p.err != nil {
+		glog.Infof("Probe %s succeeded", p.name)
+	}
+	p.err = err
+}
+
+// Check implements Check
+func (p *Probe) Check() error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.err
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadyz(t *testing.T) {
+	leader := NewCondition("not the leader")
+	synced := &Synced{}
+	cacheSynced := false
+	synced.Add("Vcn", func() bool { return cacheSynced })
+	probeErr := errors.New("NotAuthenticated")
+	probe := NewProbe("oci", time.Minute, func() error { return probeErr })
+
+	checker := NewChecker()
+	checker.AddReadyCheck("leader", leader.Check)
+	checker.AddReadyCheck("informers", synced.Check)
+	checker.AddReadyCheck("oci", probe.Check)
+	mux := http.NewServeMux()
+	checker.Register(mux)
+
+	get := func(path string) (int, string) {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		return rec.Code, rec.Body.String()
+	}
+
+	if code, body := get(HealthzPath); code != http.StatusOK || body != "ok" {
+		t.Errorf("Expected healthz to be ok, got %d %s", code, body)
+	}
+
+	code, body := get(ReadyzPath)
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("Expected readyz to fail, got %d", code)
+	}
+	for _, expected := range []string{"[-]leader failed", "[-]informers failed: controllers are starting", "[-]oci failed: not probed yet"} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected %q in %s", expected, body)
+		}
+	}
+
+	leader.Set(true)
+	synced.Complete()
+	probe.run()
+	if _, body := get(ReadyzPath); !strings.Contains(body, "[-]informers failed: caches not synced: [Vcn]") || !strings.Contains(body, "NotAuthenticated") || strings.Contains(body, "leader") {
+		t.Errorf("Expected only informers and oci to fail, got %s", body)
+	}
+
+	cacheSynced = true
+	probeErr = nil
+	probe.run()
+	if code, body := get(ReadyzPath); code != http.StatusOK || body != "ok" {
+		t.Errorf("Expected readyz to be ok, got %d %s", code, body)
+	}
+	if code, body := get(ReadyzPath + "?verbose"); code != http.StatusOK || !strings.Contains(body, "[+]oci ok") {
+		t.Errorf("Expected verbose readyz to list the checks, got %d %s", code, body)
+	}
+
+	leader.Set(false)
+	if code, _ := get(ReadyzPath); code != http.StatusServiceUnavailable {
+		t.Errorf("Expected readyz to fail after losing the lease, got %d", code)
+	}
+}
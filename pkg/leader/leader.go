@@ -0,0 +1,274 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package leader elects the replica running the controllers and hands leadership over
+// gracefully: a replica that stops leading lets its reconciles in flight finish and
+// releases the lock so that the next candidate takes over at once.
+package leader
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+)
+
+// Lock types
+const (
+	LeasesResourceLock     = "leases"
+	ConfigMapsResourceLock = resourcelock.ConfigMapsResourceLock
+	EndpointsResourceLock  = resourcelock.EndpointsResourceLock
+)
+
+// ErrLeaseLost is returned by Run if the lock could not be renewed
+var ErrLeaseLost = errors.New("leader lease lost")
+
+var errReleased = errors.New("lock has been released")
+
+// Config of the leader election
+type Config struct {
+	LockType  string
+	Namespace string
+	Name      string
+	Identity  string
+
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+	// ShutdownTimeout bounds how long the reconciles in flight are waited for
+	ShutdownTimeout time.Duration
+}
+
+// DefaultConfig returns the default durations, the shutdown timeout ends before another
+// candidate may take over a lock that failed to renew
+func DefaultConfig() Config {
+	return Config{
+		LockType:        LeasesResourceLock,
+		Name:            "oci-manager",
+		LeaseDuration:   45 * time.Second,
+		RenewDeadline:   30 * time.Second,
+		RetryPeriod:     10 * time.Second,
+		ShutdownTimeout: 15 * time.Second,
+	}
+}
+
+// Callbacks are called by Run
+type Callbacks struct {
+	// OnStartedLeading runs the controllers until stop is closed
+	OnStartedLeading func(stop <-chan struct{})
+	// OnStoppedLeading is called once stop is closed
+	OnStoppedLeading func()
+	// Drain waits up to timeout for the reconciles in flight and their status writes,
+	// it returns false if they did not finish in time
+	Drain func(timeout time.Duration) bool
+}
+
+// Lock wraps a resource lock so that it can be released. A record without holder is
+// reported as held by the candidate itself, the leader elector then takes it over at once
+// instead of waiting for the lease to expire. The update still conflicts if another
+// candidate took it first.
+type Lock struct {
+	lock     sync.Mutex
+	inner    resourcelock.Interface
+	ref      *corev1.ObjectReference
+	recorder record.EventRecorder
+	released bool
+	takeover bool
+}
+
+// NewLock returns the lock of config.LockType. Leases are read through leaseClient,
+// ConfigMaps and Endpoints through kubeclient.
+func NewLock(config Config, kubeclient kubernetes.Interface, leaseClient rest.Interface, recorder record.EventRecorder) (*Lock, error) {
+	rlc := resourcelock.ResourceLockConfig{Identity: config.Identity, EventRecorder: recorder}
+	ref := &corev1.ObjectReference{Namespace: config.Namespace, Name: config.Name}
+	switch config.LockType {
+	case LeasesResourceLock:
+		ref.APIVersion, ref.Kind = LeaseGroupVersion.String(), "Lease"
+		return &Lock{
+			inner: &LeaseLock{
+				LeaseMeta:  metav1.ObjectMeta{Namespace: config.Namespace, Name: config.Name},
+				Client:     leaseClient,
+				LockConfig: rlc,
+			},
+			ref:      ref,
+			recorder: recorder,
+		}, nil
+	case ConfigMapsResourceLock:
+		ref.APIVersion, ref.Kind = "v1", "ConfigMap"
+	case EndpointsResourceLock:
+		ref.APIVersion, ref.Kind = "v1", "Endpoints"
+	}
+	inner, err := resourcelock.New(config.LockType, config.Namespace, config.Name, kubeclient.CoreV1(), rlc)
+	if err != nil {
+		return nil, err
+	}
+	return &Lock{inner: inner, ref: ref, recorder: recorder}, nil
+}
+
+// Get returns the election record
+func (l *Lock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.released {
+		return nil, errReleased
+	}
+	record, err := l.inner.Get()
+	if err != nil {
+		return nil, err
+	}
+	l.takeover = record.HolderIdentity == ""
+	if l.takeover {
+		released := *record
+		released.HolderIdentity = l.inner.Identity()
+		return &released, nil
+	}
+	return record, nil
+}
+
+// Create creates the election record
+func (l *Lock) Create(ler resourcelock.LeaderElectionRecord) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.released {
+		return errReleased
+	}
+	return l.inner.Create(ler)
+}
+
+// Update writes the election record
+func (l *Lock) Update(ler resourcelock.LeaderElectionRecord) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.released {
+		return errReleased
+	}
+	if l.takeover {
+		ler.AcquireTime = ler.RenewTime
+		ler.LeaderTransitions++
+	}
+	if err := l.inner.Update(ler); err != nil {
+		return err
+	}
+	l.takeover = false
+	return nil
+}
+
+// RecordEvent records an event of the lock object. The ConfigMap and Endpoints locks
+// lose their object on a failed update, so the event is recorded on a reference.
+func (l *Lock) RecordEvent(s string) {
+	if l.recorder == nil {
+		return
+	}
+	l.recorder.Eventf(l.ref, corev1.EventTypeNormal, "LeaderElection", "%v %v", l.inner.Identity(), s)
+}
+
+// Identity returns the identity of the candidate
+func (l *Lock) Identity() string {
+	return l.inner.Identity()
+}
+
+// Describe returns the lock object
+func (l *Lock) Describe() string {
+	return l.inner.Describe()
+}
+
+// Release stops the lock from being acquired or renewed and clears the holder if the candidate
+// still holds it
+func (l *Lock) Release() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.released {
+		return nil
+	}
+	l.released = true
+	record, err := l.inner.Get()
+	if err != nil {
+		return err
+	}
+	if record.HolderIdentity != l.inner.Identity() {
+		return nil
+	}
+	record.HolderIdentity = ""
+	record.RenewTime = metav1.Now()
+	if err := l.inner.Update(*record); err != nil {
+		return err
+	}
+	glog.Infof("Released lock %s", l.inner.Describe())
+	return nil
+}
+
+// Run campaigns for the lock until stopCh is closed and runs the controllers while holding
+// it. It returns ErrLeaseLost after the lock could not be renewed, or nil once stopCh is
+// closed, in both cases after the controllers were drained and the lock was released.
+func Run(config Config, lock *Lock, callbacks Callbacks, stopCh <-chan struct{}) error {
+	started := make(chan (<-chan struct{}), 1)
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: config.LeaseDuration,
+		RenewDeadline: config.RenewDeadline,
+		RetryPeriod:   config.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(lost <-chan struct{}) {
+				started <- lost
+			},
+			OnStoppedLeading: func() {},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("invalid leader election config: %v", err)
+	}
+	go elector.Run()
+
+	var lost <-chan struct{}
+	select {
+	case <-stopCh:
+		return lock.Release()
+	case lost = <-started:
+	}
+
+	glog.Infof("Started leading as %s on %s", lock.Identity(), lock.Describe())
+	stop := make(chan struct{})
+	go callbacks.OnStartedLeading(stop)
+
+	select {
+	case <-lost:
+		err = ErrLeaseLost
+		glog.Errorf("Lost lock %s, stopping the controllers", lock.Describe())
+	case <-stopCh:
+		glog.Infof("Shutting down, stopping the controllers")
+	}
+	close(stop)
+	if callbacks.OnStoppedLeading != nil {
+		callbacks.OnStoppedLeading()
+	}
+
+	if callbacks.Drain != nil && !callbacks.Drain(config.ShutdownTimeout) {
+		glog.Warningf("Reconciles still in flight after %v", config.ShutdownTimeout)
+	}
+	if e := lock.Release(); e != nil {
+		glog.Errorf("Error releasing lock %s: %v", lock.Describe(), e)
+	}
+	return err
+}
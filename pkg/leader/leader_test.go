@@ -0,0 +1,274 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+)
+
+// fakeLeaseServer serves the leases of the coordination.k8s.io API from memory
+type fakeLeaseServer struct {
+	lock    sync.Mutex
+	leases  map[string]*Lease
+	version int
+}
+
+func (s *fakeLeaseServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	path := strings.TrimPrefix(r.URL.Path, "/apis/"+LeaseGroupVersion.String()+"/namespaces/")
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 || parts[1] != "leases" {
+		http.NotFound(w, r)
+		return
+	}
+
+	var lease *Lease
+	switch r.Method {
+	case "GET":
+		if lease = s.leases[parts[2]]; lease == nil {
+			http.NotFound(w, r)
+			return
+		}
+	case "POST", "PUT":
+		body, _ := ioutil.ReadAll(r.Body)
+		lease = &Lease{}
+		if err := json.Unmarshal(body, lease); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		existing := s.leases[lease.Name]
+		if r.Method == "POST" && existing != nil || r.Method == "PUT" && (existing == nil || existing.ResourceVersion != lease.ResourceVersion) {
+			http.Error(w, "conflict", http.StatusConflict)
+			return
+		}
+		s.version++
+		lease.ResourceVersion = strconv.Itoa(s.version)
+		s.leases[lease.Name] = lease
+	}
+	json.NewEncoder(w).Encode(lease)
+}
+
+func (s *fakeLeaseServer) get(name string) *resourcelock.LeaderElectionRecord {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return leaseRecord(&s.leases[name].Spec)
+}
+
+func testConfig(identity string) Config {
+	return Config{
+		Namespace:       "oci-system",
+		Name:            "oci-manager",
+		Identity:        identity,
+		LeaseDuration:   10 * time.Second,
+		RenewDeadline:   5 * time.Second,
+		RetryPeriod:     100 * time.Millisecond,
+		ShutdownTimeout: time.Second,
+	}
+}
+
+// candidate runs a leader election and records its lifecycle
+type candidate struct {
+	stopCh   chan struct{}
+	leading  chan struct{}
+	stopped  chan struct{}
+	drained  time.Duration
+	err      error
+	returned chan struct{}
+}
+
+func campaign(t *testing.T, config Config, lock *Lock) *candidate {
+	c := &candidate{
+		stopCh:   make(chan struct{}),
+		leading:  make(chan struct{}),
+		stopped:  make(chan struct{}),
+		returned: make(chan struct{}),
+	}
+	go func() {
+		c.err = Run(config, lock, Callbacks{
+			OnStartedLeading: func(stop <-chan struct{}) {
+				close(c.leading)
+				<-stop
+			},
+			OnStoppedLeading: func() { close(c.stopped) },
+			Drain: func(timeout time.Duration) bool {
+				c.drained = timeout
+				return true
+			},
+		}, c.stopCh)
+		close(c.returned)
+	}()
+	return c
+}
+
+func wait(t *testing.T, ch chan struct{}, timeout time.Duration, what string) {
+	select {
+	case <-ch:
+	case <-time.After(timeout):
+		t.Fatalf("Timed out waiting for %s", what)
+	}
+}
+
+func TestRunHandoff(t *testing.T) {
+	testCases := []struct {
+		lockType string
+		newLock  func(t *testing.T, config Config) (*Lock, func() *resourcelock.LeaderElectionRecord)
+	}{
+		{
+			lockType: ConfigMapsResourceLock,
+			newLock: func() func(t *testing.T, config Config) (*Lock, func() *resourcelock.LeaderElectionRecord) {
+				kubeclient := fake.NewSimpleClientset()
+				return func(t *testing.T, config Config) (*Lock, func() *resourcelock.LeaderElectionRecord) {
+					lock, err := NewLock(config, kubeclient, nil, record.NewFakeRecorder(100))
+					if err != nil {
+						t.Fatalf("Got error %v", err)
+					}
+					return lock, func() *resourcelock.LeaderElectionRecord {
+						cm, err := kubeclient.CoreV1().ConfigMaps(config.Namespace).Get(config.Name, metav1.GetOptions{})
+						if err != nil {
+							t.Fatalf("Got error %v", err)
+						}
+						record := &resourcelock.LeaderElectionRecord{}
+						if err := json.Unmarshal([]byte(cm.Annotations[resourcelock.LeaderElectionRecordAnnotationKey]), record); err != nil {
+							t.Fatalf("Got error %v", err)
+						}
+						return record
+					}
+				}
+			}(),
+		},
+		{
+			lockType: LeasesResourceLock,
+			newLock: func() func(t *testing.T, config Config) (*Lock, func() *resourcelock.LeaderElectionRecord) {
+				server := &fakeLeaseServer{leases: make(map[string]*Lease)}
+				httpServer := httptest.NewServer(server)
+				return func(t *testing.T, config Config) (*Lock, func() *resourcelock.LeaderElectionRecord) {
+					client, err := NewLeaseClient(&rest.Config{Host: httpServer.URL})
+					if err != nil {
+						t.Fatalf("Got error %v", err)
+					}
+					lock, err := NewLock(config, nil, client, record.NewFakeRecorder(100))
+					if err != nil {
+						t.Fatalf("Got error %v", err)
+					}
+					return lock, func() *resourcelock.LeaderElectionRecord { return server.get(config.Name) }
+				}
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.lockType, func(t *testing.T) {
+			configA, configB := testConfig("a"), testConfig("b")
+			configA.LockType, configB.LockType = tc.lockType, tc.lockType
+			lockA, record := tc.newLock(t, configA)
+			lockB, _ := tc.newLock(t, configB)
+
+			a := campaign(t, configA, lockA)
+			wait(t, a.leading, 5*time.Second, "a to lead")
+			b := campaign(t, configB, lockB)
+
+			time.Sleep(500 * time.Millisecond)
+			select {
+			case <-b.leading:
+				t.Fatalf("b started leading while a holds the lock")
+			default:
+			}
+			if holder := record().HolderIdentity; holder != "a" {
+				t.Errorf("Expected a to hold the lock, got %q", holder)
+			}
+
+			// a shuts down, b takes over long before the lease of a would expire
+			close(a.stopCh)
+			wait(t, a.returned, 5*time.Second, "a to return")
+			if a.err != nil {
+				t.Errorf("Expected a to return without error, got %v", a.err)
+			}
+			wait(t, a.stopped, time.Second, "a to stop leading")
+			if a.drained != configA.ShutdownTimeout {
+				t.Errorf("Expected a to be drained with %v, got %v", configA.ShutdownTimeout, a.drained)
+			}
+			wait(t, b.leading, 3*time.Second, "b to take over")
+
+			got := record()
+			if got.HolderIdentity != "b" || got.LeaderTransitions != 1 {
+				t.Errorf("Expected b to hold the lock after one transition, got %#v", got)
+			}
+
+			close(b.stopCh)
+			wait(t, b.returned, 5*time.Second, "b to return")
+			if holder := record().HolderIdentity; holder != "" {
+				t.Errorf("Expected the lock to be released, got holder %q", holder)
+			}
+		})
+	}
+}
+
+func TestRunLeaseLost(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset()
+	var failing bool
+	var lock sync.Mutex
+	kubeclient.PrependReactor("update", "configmaps", func(action core.Action) (bool, runtime.Object, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if failing {
+			return true, nil, errors.New("apiserver unavailable")
+		}
+		return false, nil, nil
+	})
+
+	config := testConfig("a")
+	config.LockType = ConfigMapsResourceLock
+	config.LeaseDuration = 2 * time.Second
+	config.RenewDeadline = time.Second
+	leaderLock, err := NewLock(config, kubeclient, nil, record.NewFakeRecorder(100))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+
+	a := campaign(t, config, leaderLock)
+	wait(t, a.leading, 5*time.Second, "a to lead")
+
+	lock.Lock()
+	failing = true
+	lock.Unlock()
+
+	wait(t, a.returned, 5*time.Second, "a to lose the lock")
+	if a.err != ErrLeaseLost {
+		t.Errorf("Expected %v, got %v", ErrLeaseLost, a.err)
+	}
+	if a.drained != config.ShutdownTimeout {
+		t.Errorf("Expected the controllers to be drained after losing the lock")
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// LeaseGroupVersion is the version of the coordination.k8s.io Leases, the vendored client-go
+// predates their typed client so they are read and written as JSON
+var LeaseGroupVersion = schema.GroupVersion{Group: "coordination.k8s.io", Version: "v1beta1"}
+
+const leaseResource = "leases"
+
+// Lease is a coordination.k8s.io Lease
+type Lease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LeaseSpec `json:"spec,omitempty"`
+}
+
+// LeaseSpec is the spec of a Lease
+type LeaseSpec struct {
+	HolderIdentity       *string           `json:"holderIdentity,omitempty"`
+	LeaseDurationSeconds *int32            `json:"leaseDurationSeconds,omitempty"`
+	AcquireTime          *metav1.MicroTime `json:"acquireTime,omitempty"`
+	RenewTime            *metav1.MicroTime `json:"renewTime,omitempty"`
+	LeaseTransitions     *int32            `json:"leaseTransitions,omitempty"`
+}
+
+// NewLeaseClient returns a REST client of the coordination.k8s.io Leases
+func NewLeaseClient(config *rest.Config) (rest.Interface, error) {
+	config = rest.CopyConfig(config)
+	config.APIPath = "/apis"
+	config.GroupVersion = &LeaseGroupVersion
+	config.NegotiatedSerializer = serializer.DirectCodecFactory{CodecFactory: scheme.Codecs}
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	return rest.RESTClientFor(config)
+}
+
+// LeaseLock is a resource lock keeping the election record in the spec of a Lease
+type LeaseLock struct {
+	LeaseMeta  metav1.ObjectMeta
+	Client     rest.Interface
+	LockConfig resourcelock.ResourceLockConfig
+	lease      *Lease
+}
+
+// Get returns the election record of the Lease
+func (ll *LeaseLock) Get() (*resourcelock.LeaderElectionRecord, error) {
+	body, err := ll.Client.Get().
+		Namespace(ll.LeaseMeta.Namespace).
+		Resource(leaseResource).
+		Name(ll.LeaseMeta.Name).
+		Do().
+		Raw()
+	if err != nil {
+		return nil, err
+	}
+	lease := &Lease{}
+	if err := json.Unmarshal(body, lease); err != nil {
+		return nil, err
+	}
+	ll.lease = lease
+	return leaseRecord(&lease.Spec), nil
+}
+
+// Create creates the Lease holding the election record
+func (ll *LeaseLock) Create(ler resourcelock.LeaderElectionRecord) error {
+	lease := &Lease{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: LeaseGroupVersion.String(),
+			Kind:       "Lease",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ll.LeaseMeta.Name,
+			Namespace: ll.LeaseMeta.Namespace,
+		},
+		Spec: leaseSpec(ler),
+	}
+	return ll.write(ll.Client.Post().Namespace(ll.LeaseMeta.Namespace).Resource(leaseResource), lease)
+}
+
+// Update writes the election record to the Lease read by the last Get or Create
+func (ll *LeaseLock) Update(ler resourcelock.LeaderElectionRecord) error {
+	if ll.lease == nil {
+		return errors.New("lease not initialized, call get or create first")
+	}
+	lease := *ll.lease
+	lease.Spec = leaseSpec(ler)
+	return ll.write(ll.Client.Put().Namespace(ll.LeaseMeta.Namespace).Resource(leaseResource).Name(ll.LeaseMeta.Name), &lease)
+}
+
+func (ll *LeaseLock) write(request *rest.Request, lease *Lease) error {
+	body, err := json.Marshal(lease)
+	if err != nil {
+		return err
+	}
+	body, err = request.SetHeader("Content-Type", "application/json").Body(body).Do().Raw()
+	if err != nil {
+		return err
+	}
+	written := &Lease{}
+	if err := json.Unmarshal(body, written); err != nil {
+		return err
+	}
+	ll.lease = written
+	return nil
+}
+
+// RecordEvent records an event of the Lease
+func (ll *LeaseLock) RecordEvent(s string) {
+	if ll.LockConfig.EventRecorder == nil {
+		return
+	}
+	ref := &corev1.ObjectReference{
+		APIVersion: LeaseGroupVersion.String(),
+		Kind:       "Lease",
+		Namespace:  ll.LeaseMeta.Namespace,
+		Name:       ll.LeaseMeta.Name,
+	}
+	if ll.lease != nil {
+		ref.UID = ll.lease.UID
+	}
+	ll.LockConfig.EventRecorder.Eventf(ref, corev1.EventTypeNormal, "LeaderElection", "%v %v", ll.LockConfig.Identity, s)
+}
+
+// Describe returns the namespace and name of the Lease
+func (ll *LeaseLock) Describe() string {
+	return fmt.Sprintf("%v/%v", ll.LeaseMeta.Namespace, ll.LeaseMeta.Name)
+}
+
+// Identity returns the identity of the candidate
+func (ll *LeaseLock) Identity() string {
+	return ll.LockConfig.Identity
+}
+
+func leaseRecord(spec *LeaseSpec) *resourcelock.LeaderElectionRecord {
+	record := &resourcelock.LeaderElectionRecord{}
+	if spec.HolderIdentity != nil {
+		record.HolderIdentity = *spec.HolderIdentity
+	}
+	if spec.LeaseDurationSeconds != nil {
+		record.LeaseDurationSeconds = int(*spec.LeaseDurationSeconds)
+	}
+	if spec.AcquireTime != nil {
+		record.AcquireTime = metav1.Time{Time: spec.AcquireTime.Time}
+	}
+	if spec.RenewTime != nil {
+		record.RenewTime = metav1.Time{Time: spec.RenewTime.Time}
+	}
+	if spec.LeaseTransitions != nil {
+		record.LeaderTransitions = int(*spec.LeaseTransitions)
+	}
+	return record
+}
+
+func leaseSpec(ler resourcelock.LeaderElectionRecord) LeaseSpec {
+	holder := ler.HolderIdentity
+	duration := int32(ler.LeaseDurationSeconds)
+	transitions := int32(ler.LeaderTransitions)
+	return LeaseSpec{
+		HolderIdentity:       &holder,
+		LeaseDurationSeconds: &duration,
+		AcquireTime:          &metav1.MicroTime{Time: ler.AcquireTime.Time},
+		RenewTime:            &metav1.MicroTime{Time: ler.RenewTime.Time},
+		LeaseTransitions:     &transitions,
+	}
+}
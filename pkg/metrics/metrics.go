@@ -0,0 +1,312 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics implements a small registry of labelled counters, gauges and
+// histograms exported in the Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default histogram buckets in seconds
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Collector is a metric family that can write itself in text format
+type Collector interface {
+	Name() string
+	Write(w io.Writer)
+}
+
+// Registry holds the registered metric families
+type Registry struct {
+	lock       sync.RWMutex
+	collectors map[string]Collector
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// DefaultRegistry is used by the package level constructors and the handler
+var DefaultRegistry = NewRegistry()
+
+// Register adds a collector to the registry, it panics on duplicate names
+func (r *Registry) Register(c Collector) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.collectors[c.Name()]; ok {
+		panic(fmt.Sprintf("metric %q has already been registered", c.Name()))
+	}
+	r.collectors[c.Name()] = c
+}
+
+// Write writes every registered family sorted by name
+func (r *Registry) Write(w io.Writer) {
+	r.lock.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	r.lock.RUnlock()
+	sort.Strings(names)
+
+	for _, name := range names {
+		r.lock.RLock()
+		c := r.collectors[name]
+		r.lock.RUnlock()
+		c.Write(w)
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	r.Write(w)
+}
+
+// Handler returns the http handler for the default registry
+func Handler() http.Handler {
+	return DefaultRegistry
+}
+
+// vec keeps one value per distinct set of label values
+type vec struct {
+	name       string
+	help       string
+	metricType string
+	labels     []string
+
+	lock   sync.Mutex
+	values map[string][]string
+}
+
+func newVec(name, help, metricType string, labels []string) vec {
+	return vec{name: name, help: help, metricType: metricType, labels: labels, values: make(map[string][]string)}
+}
+
+func (v *vec) Name() string {
+	return v.name
+}
+
+// key must be called with the lock held
+func (v *vec) key(values []string) string {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metric %s expects %d label values, got %d", v.name, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	if _, ok := v.values[key]; !ok {
+		v.values[key] = append([]string(nil), values...)
+	}
+	return key
+}
+
+// sortedKeys must be called with the lock held
+func (v *vec) sortedKeys() []string {
+	keys := make([]string, 0, len(v.values))
+	for key := range v.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (v *vec) header(w io.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", v.name, v.help, v.name, v.metricType)
+}
+
+func (v *vec) labelPairs(key string, extra ...string) string {
+	pairs := make([]string, 0, len(v.labels)+1)
+	for i, label := range v.labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", label, v.values[key][i]))
+	}
+	pairs = append(pairs, extra...)
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// CounterVec is a monotonically increasing value per label set
+type CounterVec struct {
+	vec
+	counts map[string]float64
+}
+
+// NewCounterVec creates and registers a counter family
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{vec: newVec(name, help, "counter", labels), counts: make(map[string]float64)}
+	DefaultRegistry.Register(c)
+	return c
+}
+
+// Add increments the counter for the label values by delta
+func (c *CounterVec) Add(delta float64, values ...string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.counts[c.key(values)] += delta
+}
+
+// Inc increments the counter for the label values by one
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+// Value returns the current counter value for the label values
+func (c *CounterVec) Value(values ...string) float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.counts[c.key(values)]
+}
+
+// Write implements Collector
+func (c *CounterVec) Write(w io.Writer) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.header(w)
+	for _, key := range c.sortedKeys() {
+		fmt.Fprintf(w, "%s%s %v\n", c.name, c.labelPairs(key), c.counts[key])
+	}
+}
+
+// GaugeVec is a value per label set that can go up and down
+type GaugeVec struct {
+	vec
+	gauges map[string]float64
+}
+
+// NewGaugeVec creates and registers a gauge family
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	g := &GaugeVec{vec: newVec(name, help, "gauge", labels), gauges: make(map[string]float64)}
+	DefaultRegistry.Register(g)
+	return g
+}
+
+// Add adds delta to the gauge for the label values
+func (g *GaugeVec) Add(delta float64, values ...string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.gauges[g.key(values)] += delta
+}
+
+// Set sets the gauge for the label values
+func (g *GaugeVec) Set(value float64, values ...string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.gauges[g.key(values)] = value
+}
+
+// Value returns the current gauge value for the label values
+func (g *GaugeVec) Value(values ...string) float64 {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.gauges[g.key(values)]
+}
+
+// Write implements Collector
+func (g *GaugeVec) Write(w io.Writer) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.header(w)
+	for _, key := range g.sortedKeys() {
+		fmt.Fprintf(w, "%s%s %v\n", g.name, g.labelPairs(key), g.gauges[key])
+	}
+}
+
+type histogram struct {
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// HistogramVec counts observations in cumulative buckets per label set
+type HistogramVec struct {
+	vec
+	buckets    []float64
+	histograms map[string]*histogram
+}
+
+// NewHistogramVec creates and registers a histogram family, nil buckets means DefBuckets
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefBuckets
+	}
+	h := &HistogramVec{vec: newVec(name, help, "histogram", labels), buckets: buckets, histograms: make(map[string]*histogram)}
+	DefaultRegistry.Register(h)
+	return h
+}
+
+// Observe adds a single observation for the label values
+func (h *HistogramVec) Observe(value float64, values ...string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	key := h.key(values)
+	hist, ok := h.histograms[key]
+	if !ok {
+		hist = &histogram{counts: make([]uint64, len(h.buckets))}
+		h.histograms[key] = hist
+	}
+	for i, bound := range h.buckets {
+		if value <= bound {
+			hist.counts[i]++
+		}
+	}
+	hist.count++
+	hist.sum += value
+}
+
+// Count returns the number of observations for the label values
+func (h *HistogramVec) Count(values ...string) uint64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if hist, ok := h.histograms[h.key(values)]; ok {
+		return hist.count
+	}
+	return 0
+}
+
+// Write implements Collector
+func (h *HistogramVec) Write(w io.Writer) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.header(w)
+	for _, key := range h.sortedKeys() {
+		hist, ok := h.histograms[key]
+		if !ok {
+			continue
+		}
+		for i, bound := range h.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(key, fmt.Sprintf("le=%q", formatBound(bound))), hist.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, h.labelPairs(key, `le="+Inf"`), hist.count)
+		fmt.Fprintf(w, "%s_sum%s %v\n", h.name, h.labelPairs(key), hist.sum)
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, h.labelPairs(key), hist.count)
+	}
+}
+
+func formatBound(bound float64) string {
+	if math.IsInf(bound, 1) {
+		return "+Inf"
+	}
+	return fmt.Sprintf("%v", bound)
+}
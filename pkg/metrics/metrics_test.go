@@ -0,0 +1,69 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	registry := NewRegistry()
+	counter := &CounterVec{vec: newVec("test_total", "test counter", "counter", []string{"kind"}), counts: make(map[string]float64)}
+	histogram := &HistogramVec{vec: newVec("test_seconds", "test histogram", "histogram", []string{"kind"}), buckets: []float64{1, 5}, histograms: make(map[string]*histogram)}
+	registry.Register(counter)
+	registry.Register(histogram)
+
+	counter.Inc("Vcn")
+	counter.Add(2, "Vcn")
+	histogram.Observe(0.5, "Vcn")
+	histogram.Observe(3, "Vcn")
+
+	var buf bytes.Buffer
+	registry.Write(&buf)
+	out := buf.String()
+
+	for _, expected := range []string{
+		"# TYPE test_total counter",
+		`test_total{kind="Vcn"} 3`,
+		`test_seconds_bucket{kind="Vcn",le="1"} 1`,
+		`test_seconds_bucket{kind="Vcn",le="5"} 2`,
+		`test_seconds_bucket{kind="Vcn",le="+Inf"} 2`,
+		`test_seconds_sum{kind="Vcn"} 3.5`,
+		`test_seconds_count{kind="Vcn"} 2`,
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected %q in output:\n%s", expected, out)
+		}
+	}
+}
+
+func TestWorkqueueProvider(t *testing.T) {
+	depth := workqueueMetricsProvider{}.NewDepthMetric("test")
+	depth.Inc()
+	depth.Inc()
+	depth.Dec()
+	if v := workqueueDepth.Value("test"); v != 1 {
+		t.Errorf("Expected depth 1, got %v", v)
+	}
+
+	workqueueMetricsProvider{}.NewLatencyMetric("test").Observe(2e6)
+	if c := workqueueLatency.Count("test"); c != 1 {
+		t.Errorf("Expected one latency observation, got %d", c)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"k8s.io/client-go/util/workqueue"
+)
+
+var (
+	workqueueDepth = NewGaugeVec("ocimanager_workqueue_depth",
+		"Current depth of the workqueue", "name")
+	workqueueAdds = NewCounterVec("ocimanager_workqueue_adds_total",
+		"Total number of adds handled by the workqueue", "name")
+	workqueueRetries = NewCounterVec("ocimanager_workqueue_retries_total",
+		"Total number of rate limited retries handled by the workqueue", "name")
+	workqueueLatency = NewHistogramVec("ocimanager_workqueue_queue_duration_seconds",
+		"How long an item stays in the workqueue before being requested", nil, "name")
+	workqueueWorkDuration = NewHistogramVec("ocimanager_workqueue_work_duration_seconds",
+		"How long processing an item from the workqueue takes", nil, "name")
+)
+
+func init() {
+	workqueue.SetProvider(workqueueMetricsProvider{})
+}
+
+type gauge struct {
+	vec  *GaugeVec
+	name string
+}
+
+func (g gauge) Inc() { g.vec.Add(1, g.name) }
+func (g gauge) Dec() { g.vec.Add(-1, g.name) }
+
+type counter struct {
+	vec  *CounterVec
+	name string
+}
+
+func (c counter) Inc() { c.vec.Inc(c.name) }
+
+// microseconds converts the workqueue's microsecond observations to seconds
+type microseconds struct {
+	vec  *HistogramVec
+	name string
+}
+
+func (s microseconds) Observe(value float64) { s.vec.Observe(value/1e6, s.name) }
+
+// workqueueMetricsProvider exports the metrics of every named workqueue
+type workqueueMetricsProvider struct{}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return gauge{workqueueDepth, name}
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return counter{workqueueAdds, name}
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.SummaryMetric {
+	return microseconds{workqueueLatency, name}
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.SummaryMetric {
+	return microseconds{workqueueWorkDuration, name}
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return counter{workqueueRetries, name}
+}
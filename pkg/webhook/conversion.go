@@ -0,0 +1,112 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1beta1"
+	commonv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1beta1"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HubVersion is the version the controllers, the validation and the defaults work on.
+// Objects stored or sent in another version are converted to it first.
+const HubVersion = "v1alpha1"
+
+// fieldRenames are the renames between v1alpha1 and v1beta1 by group and kind, the
+// groups without renames of their own only share the common renames
+var fieldRenames = map[string]map[string][]commonv1beta1.FieldRename{
+	ocicorev1beta1.SchemeGroupVersion.Group: ocicorev1beta1.FieldRenames,
+	ocilbv1beta1.SchemeGroupVersion.Group:   ocilbv1beta1.FieldRenames,
+	ocicev1beta1.SchemeGroupVersion.Group:   ocicev1beta1.FieldRenames,
+}
+
+// FieldRenames returns the renames of a kind between v1alpha1 and v1beta1 including the
+// common renames
+func FieldRenames(group, kind string) []commonv1beta1.FieldRename {
+	return append(append([]commonv1beta1.FieldRename{}, commonv1beta1.CommonFieldRenames...), fieldRenames[group][kind]...)
+}
+
+// ConvertObject converts the JSON of an oci resource object to version
+func ConvertObject(raw []byte, version string) ([]byte, error) {
+	// numbers are kept as written, int64 fields like the generation may not survive a float64
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	obj := map[string]interface{}{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, err
+	}
+	apiVersion, _ := obj["apiVersion"].(string)
+	kind, _ := obj["kind"].(string)
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if gv.Version == version {
+		return raw, nil
+	}
+	if err := commonv1beta1.Convert(obj, version, fieldRenames[gv.Group][kind]); err != nil {
+		return nil, err
+	}
+	return json.Marshal(obj)
+}
+
+// Convert converts the objects of a conversion request, a single failure fails the request
+func (s *Server) Convert(req *ConversionRequest) *ConversionResponse {
+	response := &ConversionResponse{UID: req.UID}
+	gv, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	for i := 0; err == nil && i < len(req.Objects); i++ {
+		var converted []byte
+		if converted, err = ConvertObject(req.Objects[i].Raw, gv.Version); err == nil {
+			response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+		}
+	}
+	if err != nil {
+		glog.Errorf("Error converting to %s: %v", req.DesiredAPIVersion, err)
+		response.ConvertedObjects = nil
+		response.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+		return response
+	}
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+// serveConversion answers a ConversionReview
+func (s *Server) serveConversion(w http.ResponseWriter, body []byte) {
+	review := ConversionReview{}
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid conversion review: %v", err), http.StatusBadRequest)
+		return
+	}
+	review.Response = s.Convert(review.Request)
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		glog.Errorf("Error writing conversion review: %v", err)
+	}
+}
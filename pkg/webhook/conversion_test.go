@@ -0,0 +1,243 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	ocisdkce "github.com/oracle/oci-go-sdk/containerengine"
+	ocisdkcore "github.com/oracle/oci-go-sdk/core"
+	ocilb "github.com/oracle/oci-go-sdk/loadbalancer"
+	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1alpha1"
+	ocicev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1beta1"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocicorev1beta1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1beta1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	ocilbv1beta1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func typeMeta(gv schema.GroupVersion, kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: gv.String(), Kind: kind}
+}
+
+// strictDecode fails on fields the v1beta1 types do not know
+func strictDecode(t *testing.T, raw []byte, obj interface{}) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		t.Fatalf("Error decoding %T: %v\n%s", obj, err, raw)
+	}
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	id := "ocid1.subnet.oc1.phx.aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q"
+	subnet := testSubnet("subnet1", "vcn1", "10.0.1.0/24")
+	subnet.TypeMeta = typeMeta(ocicorev1alpha1.SchemeGroupVersion, ocicorev1alpha1.SubnetKind)
+	subnet.Spec.RouteTableRef = "routetable1"
+	subnet.Spec.SecurityRuleSetRefs = []string{"rules1"}
+	subnet.Spec.DependsOn = map[string]ocicommon.DependsOn{
+		"vcn": {
+			LabelSelector:    map[string]string{"app": "web"},
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"a"}}},
+			FieldSelector:    map[string]string{"metadata.name": "vcn1"},
+		},
+	}
+	subnet.Spec.DeletionPolicy = ocicommon.DeletionPolicyOrphan
+	subnet.Status.ResetCounter = 2
+	subnet.SetResource(&ocisdkcore.Subnet{Id: &id})
+
+	tcp, port := "6", 22
+	rules := &ocicorev1alpha1.SecurityRuleSet{
+		TypeMeta:   typeMeta(ocicorev1alpha1.SchemeGroupVersion, ocicorev1alpha1.SecurityRuleSetKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "rules1", Namespace: fakeNs},
+		Spec: ocicorev1alpha1.SecurityRuleSetSpec{
+			IngressSecurityRules: []ocisdkcore.IngressSecurityRule{{
+				Protocol:   &tcp,
+				Source:     &subnet.Spec.CidrBlock,
+				TcpOptions: &ocisdkcore.TcpOptions{DestinationPortRange: &ocisdkcore.PortRange{Min: &port, Max: &port}},
+			}},
+		},
+	}
+
+	serverType := ocisdkcore.DhcpDnsOptionServerTypeCustomdnsserver
+	dhcp := &ocicorev1alpha1.DhcpOption{
+		TypeMeta:   typeMeta(ocicorev1alpha1.SchemeGroupVersion, ocicorev1alpha1.DhcpOptionKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "dhcp1", Namespace: fakeNs},
+		Spec: ocicorev1alpha1.DhcpOptionSpec{Options: []ocisdkcore.DhcpOption{
+			ocisdkcore.DhcpDnsOption{ServerType: serverType, CustomDnsServers: []string{"10.0.0.2"}},
+			ocisdkcore.DhcpSearchDomainOption{SearchDomainNames: []string{"example.com"}},
+		}},
+	}
+
+	volume := &ocicorev1alpha1.Volume{
+		TypeMeta:   typeMeta(ocicorev1alpha1.SchemeGroupVersion, ocicorev1alpha1.VolumeKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "volume1", Namespace: fakeNs},
+		Status: ocicorev1alpha1.VolumeStatus{
+			AttachmentState: ocisdkcore.VolumeAttachmentLifecycleStateAttached,
+			Attachment: &ocicorev1alpha1.VolumeAttachment{
+				AttachmentType:   "iscsi",
+				VolumeAttachment: ocisdkcore.IScsiVolumeAttachment{Id: &id},
+			},
+		},
+	}
+
+	lbID, workRequestStatus := "ocid1.loadbalancer", ocilb.WorkRequestLifecycleStateSucceeded
+	listener := &ocilbv1alpha1.Listener{
+		TypeMeta:   typeMeta(ocilbv1alpha1.SchemeGroupVersion, ocilbv1alpha1.ListenerKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "listener1", Namespace: fakeNs},
+		Spec:       ocilbv1alpha1.ListenerSpec{Protocol: "HTTP", Port: 80},
+		Status:     ocilbv1alpha1.ListenerStatus{LoadBalancerId: &lbID, WorkRequestStatus: &workRequestStatus},
+	}
+
+	version, kubeconfig, podsCidr := "v1.11.1", "apiVersion: v1", "10.244.0.0/16"
+	cluster := &ocicev1alpha1.Cluster{
+		TypeMeta:   typeMeta(ocicev1alpha1.SchemeGroupVersion, ocicev1alpha1.ClusterKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: fakeNs},
+		Spec: ocicev1alpha1.ClusterSpec{
+			KubernetesVersion: &version,
+			Options:           &ocisdkce.ClusterCreateOptions{KubernetesNetworkConfig: &ocisdkce.KubernetesNetworkConfig{PodsCidr: &podsCidr}},
+		},
+		Status: ocicev1alpha1.ClusterStatus{KubeConfig: &kubeconfig},
+	}
+
+	tests := []struct {
+		v1alpha1 runtime.Object
+		v1beta1  runtime.Object
+		check    func(obj runtime.Object) bool
+	}{
+		{subnet, &ocicorev1beta1.Subnet{}, func(obj runtime.Object) bool {
+			s := obj.(*ocicorev1beta1.Subnet)
+			return s.Spec.RouteTableRef == "routetable1" && s.Spec.SecurityRuleSetRefs[0] == "rules1" &&
+				s.Spec.DependsOn["vcn"].LabelSelector["app"] == "web" && s.Spec.DependsOn["vcn"].MatchExpressions[0].Key == "tier" &&
+				s.Spec.DependsOn["vcn"].FieldSelector["metadata.name"] == "vcn1" && s.Spec.DeletionPolicy == ocicommon.DeletionPolicyOrphan &&
+				s.Status.ResetCounter == 2 && s.Status.Resource != nil && strings.Contains(string(s.Status.Resource.Raw), id)
+		}},
+		{rules, &ocicorev1beta1.SecurityRuleSet{}, func(obj runtime.Object) bool {
+			r := obj.(*ocicorev1beta1.SecurityRuleSet).Spec.IngressSecurityRules[0]
+			return r.Protocol == "6" && r.Source == "10.0.1.0/24" && r.TcpOptions.DestinationPortRange.Max == 22
+		}},
+		{dhcp, &ocicorev1beta1.DhcpOption{}, func(obj runtime.Object) bool {
+			options := obj.(*ocicorev1beta1.DhcpOption).Spec.Options
+			return options[0].Type == ocicorev1beta1.DhcpOptionTypeDomainNameServer && options[0].CustomDnsServers[0] == "10.0.0.2" &&
+				options[1].Type == ocicorev1beta1.DhcpOptionTypeSearchDomain && options[1].SearchDomainNames[0] == "example.com"
+		}},
+		{volume, &ocicorev1beta1.Volume{}, func(obj runtime.Object) bool {
+			status := obj.(*ocicorev1beta1.Volume).Status
+			return status.AttachmentState == "ATTACHED" && status.Attachment.AttachmentType == "iscsi" && status.Attachment.Resource != nil
+		}},
+		{listener, &ocilbv1beta1.Listener{}, func(obj runtime.Object) bool {
+			status := obj.(*ocilbv1beta1.Listener).Status
+			return *status.LoadBalancerId == lbID && status.WorkRequestStatus == "SUCCEEDED"
+		}},
+		{cluster, &ocicev1beta1.Cluster{}, func(obj runtime.Object) bool {
+			c := obj.(*ocicev1beta1.Cluster)
+			return c.Spec.KubernetesVersion == version && c.Spec.Options.KubernetesNetworkConfig.PodsCidr == podsCidr && *c.Status.KubeConfig == kubeconfig
+		}},
+	}
+	for _, test := range tests {
+		raw, err := json.Marshal(test.v1alpha1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		converted, err := ConvertObject(raw, "v1beta1")
+		if err != nil {
+			t.Fatalf("Error converting %T: %v", test.v1alpha1, err)
+		}
+		strictDecode(t, converted, test.v1beta1)
+		if test.v1beta1.GetObjectKind().GroupVersionKind().Version != "v1beta1" || !test.check(test.v1beta1) {
+			t.Errorf("Unexpected conversion of %T: %s", test.v1alpha1, converted)
+		}
+
+		back, err := ConvertObject(converted, HubVersion)
+		if err != nil {
+			t.Fatalf("Error converting %T back: %v", test.v1alpha1, err)
+		}
+		var original, roundTripped map[string]interface{}
+		json.Unmarshal(raw, &original)
+		json.Unmarshal(back, &roundTripped)
+		if !reflect.DeepEqual(original, roundTripped) {
+			t.Errorf("Expected %T to survive the round trip\n%s\n%s", test.v1alpha1, raw, back)
+		}
+	}
+}
+
+func TestServeConversion(t *testing.T) {
+	server, stopCh := newTestServer(nil)
+	defer close(stopCh)
+
+	subnet := testSubnet("subnet1", "vcn1", "10.0.1.0/24")
+	subnet.TypeMeta = typeMeta(ocicorev1alpha1.SchemeGroupVersion, ocicorev1alpha1.SubnetKind)
+	subnet.Spec.RouteTableRef = "routetable1"
+	raw, _ := json.Marshal(subnet)
+	review := ConversionReview{Request: &ConversionRequest{
+		UID:               "1234",
+		DesiredAPIVersion: ocicorev1beta1.SchemeGroupVersion.String(),
+		Objects:           []runtime.RawExtension{{Raw: raw}},
+	}}
+	body, _ := json.Marshal(review)
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ConvertPath, bytes.NewReader(body)))
+	answer := ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &answer); err != nil || answer.Response == nil {
+		t.Fatalf("Expected a conversion review, got %v %s", err, recorder.Body.String())
+	}
+	response := answer.Response
+	if response.UID != "1234" || response.Result.Status != metav1.StatusSuccess || len(response.ConvertedObjects) != 1 {
+		t.Fatalf("Expected one converted object, got %#v", response)
+	}
+	converted := &ocicorev1beta1.Subnet{}
+	strictDecode(t, response.ConvertedObjects[0].Raw, converted)
+	if converted.APIVersion != ocicorev1beta1.SchemeGroupVersion.String() || converted.Spec.RouteTableRef != "routetable1" {
+		t.Errorf("Unexpected converted subnet %s", response.ConvertedObjects[0].Raw)
+	}
+
+	review.Request.DesiredAPIVersion = "ocicore.oracle.com/v2"
+	body, _ = json.Marshal(review)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ConvertPath, bytes.NewReader(body)))
+	answer = ConversionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &answer); err != nil || answer.Response.Result.Status != metav1.StatusFailure || answer.Response.ConvertedObjects != nil {
+		t.Errorf("Expected an unknown version to fail, got %v %s", err, recorder.Body.String())
+	}
+}
+
+func TestReviewV1beta1(t *testing.T) {
+	server, stopCh := newTestServer(nil)
+	defer close(stopCh)
+
+	listener := &ocilbv1beta1.Listener{
+		TypeMeta:   typeMeta(ocilbv1beta1.SchemeGroupVersion, ocilbv1alpha1.ListenerKind),
+		ObjectMeta: metav1.ObjectMeta{Name: "listener1", Namespace: fakeNs},
+		Spec:       ocilbv1beta1.ListenerSpec{Protocol: "UDP", Port: 80},
+	}
+	raw, _ := json.Marshal(listener)
+	response := server.Review(&AdmissionRequest{Operation: OperationCreate, Object: runtime.RawExtension{Raw: raw}})
+	if response.Allowed || response.Result == nil || !strings.Contains(response.Result.Message, "spec.protocol") {
+		t.Errorf("Expected the v1beta1 listener to be validated, got %#v", response)
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Keys of the namespace defaults. They are read from the data of the DefaultsConfigMap
+// of the namespace and from the namespace annotations prefixed with DefaultsAnnotationPrefix,
+// the config map takes precedence.
+const (
+	DefaultCompartmentRef     = "compartmentRef"
+	DefaultAvailabilityDomain = "availabilityDomain"
+	DefaultLoadBalancerShape  = "loadBalancerShape"
+
+	DefaultsConfigMapName    = "oci-defaults"
+	DefaultsAnnotationPrefix = "defaults.oci.oracle.com/"
+)
+
+// JSONPatchType is the only patch type of admission responses
+const JSONPatchType = "JSONPatch"
+
+// displayNameRegex is the strictest displayName pattern of the CRD schemas, an object
+// name not matching it is not copied since the defaulted object would fail the schema
+var displayNameRegex = regexp.MustCompile(ocicommon.HostnameValidationRegex)
+
+// specDefault fills an empty top level string field of the spec
+type specDefault struct {
+	field string
+	// kinds restricts the default to some kinds, e.g. the Shape of an Instance is no lb shape
+	kinds []string
+	value func(objectmeta metav1.Object, defaults map[string]string) string
+}
+
+var specDefaults = []specDefault{
+	{
+		// the cloud controllers look up images and shapes from the compartment named like the namespace
+		field: "CompartmentRef",
+		value: func(objectmeta metav1.Object, defaults map[string]string) string {
+			if ref := defaults[DefaultCompartmentRef]; ref != "" {
+				return ref
+			}
+			return objectmeta.GetNamespace()
+		},
+	},
+	{
+		field: "DisplayName",
+		value: func(objectmeta metav1.Object, defaults map[string]string) string {
+			if displayNameRegex.MatchString(objectmeta.GetName()) {
+				return objectmeta.GetName()
+			}
+			return ""
+		},
+	},
+	{
+		field: "AvailabilityDomain",
+		value: func(objectmeta metav1.Object, defaults map[string]string) string {
+			return defaults[DefaultAvailabilityDomain]
+		},
+	},
+	{
+		field: "Shape",
+		kinds: []string{ocilbv1alpha1.LoadBalancerKind},
+		value: func(objectmeta metav1.Object, defaults map[string]string) string {
+			return defaults[DefaultLoadBalancerShape]
+		},
+	},
+}
+
+// patchOperation is one operation of a JSON patch
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Default fills the empty common spec fields of the object of an admission request. An
+// update keeps the values of the old object so defaults stay stable when the namespace
+// defaults change or a client replaces the object without them.
+func (s *Server) Default(req *AdmissionRequest) *AdmissionResponse {
+	if req.Operation != OperationCreate && req.Operation != OperationUpdate {
+		return &AdmissionResponse{Allowed: true}
+	}
+	obj, err := s.decode(req.Object.Raw)
+	if err != nil {
+		return &AdmissionResponse{Allowed: true}
+	}
+	var old runtime.Object
+	if req.Operation == OperationUpdate {
+		if old, err = s.decode(req.OldObject.Raw); err != nil {
+			return &AdmissionResponse{Allowed: true}
+		}
+	}
+
+	patch := s.defaultPatch(obj, old)
+	if len(patch) == 0 {
+		return &AdmissionResponse{Allowed: true}
+	}
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(req.Object.Raw, &raw); err == nil {
+		if _, ok := raw["spec"]; !ok {
+			patch = append([]patchOperation{{Op: "add", Path: "/spec", Value: map[string]interface{}{}}}, patch...)
+		}
+	}
+	encoded, err := json.Marshal(patch)
+	if err != nil {
+		return &AdmissionResponse{Allowed: true}
+	}
+	patchType := JSONPatchType
+	return &AdmissionResponse{Allowed: true, Patch: encoded, PatchType: &patchType}
+}
+
+// defaultPatch returns the operations adding the defaults of the empty spec fields of obj
+func (s *Server) defaultPatch(obj, old runtime.Object) []patchOperation {
+	objectmeta, err := meta.Accessor(obj)
+	if err != nil {
+		return nil
+	}
+	kind := reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	defaults := s.namespaceDefaults(objectmeta.GetNamespace())
+	spec := specOf(obj)
+
+	var patch []patchOperation
+	for _, d := range specDefaults {
+		value := spec.FieldByName(d.field)
+		if !value.IsValid() || value.Kind() != reflect.String || value.String() != "" || !appliesTo(d, kind) {
+			continue
+		}
+		defaulted := ""
+		if old != nil {
+			if oldValue := specOf(old).FieldByName(d.field); oldValue.IsValid() {
+				defaulted = oldValue.String()
+			}
+		}
+		if defaulted == "" {
+			defaulted = d.value(objectmeta, defaults)
+		}
+		if defaulted == "" {
+			continue
+		}
+		structField, _ := spec.Type().FieldByName(d.field)
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/" + jsonName(structField), Value: defaulted})
+	}
+	return patch
+}
+
+// namespaceDefaults merges the defaults annotated on the namespace with the defaults config map
+func (s *Server) namespaceDefaults(namespace string) map[string]string {
+	defaults := map[string]string{}
+	if s.namespaces != nil {
+		if ns, err := s.namespaces.Get(namespace); err == nil {
+			for key, value := range ns.Annotations {
+				if strings.HasPrefix(key, DefaultsAnnotationPrefix) {
+					defaults[strings.TrimPrefix(key, DefaultsAnnotationPrefix)] = value
+				}
+			}
+		}
+	}
+	if s.configMaps != nil {
+		if configMap, err := s.configMaps.ConfigMaps(namespace).Get(DefaultsConfigMapName); err == nil {
+			for key, value := range configMap.Data {
+				defaults[key] = value
+			}
+		}
+	}
+	return defaults
+}
+
+func appliesTo(d specDefault, kind string) bool {
+	if len(d.kinds) == 0 {
+		return true
+	}
+	for _, k := range d.kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDefault(t *testing.T) {
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fakeNs,
+			Annotations: map[string]string{
+				DefaultsAnnotationPrefix + DefaultAvailabilityDomain: "Uocm:PHX-AD-1",
+				DefaultsAnnotationPrefix + DefaultLoadBalancerShape:  "100Mbps",
+			},
+		},
+	}
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultsConfigMapName, Namespace: fakeNs},
+		Data:       map[string]string{DefaultAvailabilityDomain: "Uocm:PHX-AD-2"},
+	}
+	server, stopCh := newTestServer([]runtime.Object{namespace, configMap})
+	defer close(stopCh)
+
+	// the config map wins over the annotation, the compartment is named like the namespace
+	instance := &ocicorev1alpha1.Instance{
+		TypeMeta:   metav1.TypeMeta{APIVersion: ocicorev1alpha1.SchemeGroupVersion.String(), Kind: ocicorev1alpha1.InstanceKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "instance1", Namespace: fakeNs},
+		Spec:       ocicorev1alpha1.InstanceSpec{Shape: "VM.Standard1.1"},
+	}
+	expected := []patchOperation{
+		{Op: "add", Path: "/spec/compartmentRef", Value: fakeNs},
+		{Op: "add", Path: "/spec/displayName", Value: "instance1"},
+		{Op: "add", Path: "/spec/availabilityDomain", Value: "Uocm:PHX-AD-2"},
+	}
+	if patch := server.defaultPatch(instance, nil); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected patch %v, got %v", expected, patch)
+	}
+
+	lb := &ocilbv1alpha1.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Name: "lb.with.dots", Namespace: fakeNs},
+		Spec:       ocilbv1alpha1.LoadBalancerSpec{CompartmentRef: "other"},
+	}
+	expected = []patchOperation{{Op: "add", Path: "/spec/shapeName", Value: "100Mbps"}}
+	if patch := server.defaultPatch(lb, nil); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected patch %v, got %v", expected, patch)
+	}
+
+	// an update keeps the values of the old object
+	old := instance.DeepCopy()
+	old.Spec.CompartmentRef = "compartment1"
+	old.Spec.DisplayName = "instance1"
+	old.Spec.AvailabilityDomain = "Uocm:PHX-AD-3"
+	expected = []patchOperation{
+		{Op: "add", Path: "/spec/compartmentRef", Value: "compartment1"},
+		{Op: "add", Path: "/spec/displayName", Value: "instance1"},
+		{Op: "add", Path: "/spec/availabilityDomain", Value: "Uocm:PHX-AD-3"},
+	}
+	if patch := server.defaultPatch(instance, old); !reflect.DeepEqual(patch, expected) {
+		t.Errorf("Expected patch %v, got %v", expected, patch)
+	}
+
+	raw, _ := json.Marshal(instance)
+	response := server.Default(&AdmissionRequest{Operation: OperationCreate, Object: runtime.RawExtension{Raw: raw}})
+	if !response.Allowed || response.PatchType == nil || *response.PatchType != JSONPatchType {
+		t.Fatalf("Expected an allowed JSON patch, got %#v", response)
+	}
+	patch := []patchOperation{}
+	if err := json.Unmarshal(response.Patch, &patch); err != nil || len(patch) != 3 {
+		t.Errorf("Expected 3 patch operations, got %v %s", err, response.Patch)
+	}
+}
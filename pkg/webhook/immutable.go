@@ -0,0 +1,85 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"reflect"
+	"strings"
+
+	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1alpha1"
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1alpha1"
+	ociidentityv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ociidentity.oracle.com/v1alpha1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// createOnlyFields lists the spec fields by kind that have no counterpart in the update
+// request of OCI, changing them after the resource was created would never converge
+var createOnlyFields = map[string][]string{
+	ocicorev1alpha1.VirtualNetworkKind:  {"CompartmentRef", "CidrBlock", "DNSLabel"},
+	ocicorev1alpha1.SubnetKind:          {"CompartmentRef", "VcnRef", "AvailabilityDomain", "CidrBlock", "DNSLabel"},
+	ocicorev1alpha1.InstanceKind:        {"CompartmentRef", "SubnetRef", "AvailabilityDomain", "HostnameLabel", "Image", "IpxeScript", "Shape"},
+	ocicorev1alpha1.VolumeKind:          {"CompartmentRef", "AvailabilityDomain"},
+	ocicorev1alpha1.VolumeBackupKind:    {"VolumeRef", "VolumeBackupType"},
+	ocicorev1alpha1.RouteTableKind:      {"CompartmentRef", "VcnRef"},
+	ocicorev1alpha1.SecurityRuleSetKind: {"CompartmentRef", "VcnRef"},
+	ocicorev1alpha1.DhcpOptionKind:      {"CompartmentRef", "VcnRef"},
+	ocicorev1alpha1.InternetGatewayKind: {"CompartmentRef", "VcnRef"},
+
+	ocilbv1alpha1.LoadBalancerKind: {"CompartmentRef", "SubnetRefs", "IsPrivate", "Shape"},
+	ocilbv1alpha1.ListenerKind:     {"LoadBalancerRef"},
+	ocilbv1alpha1.BackendSetKind:   {"LoadBalancerRef"},
+	ocilbv1alpha1.BackendKind:      {"LoadBalancerRef", "BackendSetRef", "InstanceRef", "IPAddress", "Port"},
+	ocilbv1alpha1.CertificateKind:  {"LoadBalancerRef", "PublicCertificate", "PrivateKey", "CACertificate", "Passphrase"},
+
+	ocicev1alpha1.ClusterKind:  {"CompartmentRef", "VcnRef", "ServiceLbSubnetRefs", "Options"},
+	ocicev1alpha1.NodePoolKind: {"CompartmentRef", "ClusterRef", "NodeImageName", "NodeShape", "SshPublicKey"},
+
+	ocidbv1alpha1.AutonomousDatabaseKind: {"CompartmentRef", "LicenseModel"},
+
+	ociidentityv1alpha1.PolicyKind:       {"CompartmentRef"},
+	ociidentityv1alpha1.DynamicGroupKind: {"CompartmentRef"},
+}
+
+// validateImmutable rejects changes of the create-only fields of kind
+func validateImmutable(kind string, obj, old runtime.Object) field.ErrorList {
+	var errs field.ErrorList
+	spec, oldSpec := specOf(obj), specOf(old)
+	for _, name := range createOnlyFields[kind] {
+		value, oldValue := spec.FieldByName(name), oldSpec.FieldByName(name)
+		if !value.IsValid() || !oldValue.IsValid() {
+			continue
+		}
+		if !reflect.DeepEqual(value.Interface(), oldValue.Interface()) {
+			structField, _ := spec.Type().FieldByName(name)
+			path := field.NewPath("spec", jsonName(structField))
+			errs = append(errs, field.Forbidden(path, "field cannot be changed once the OCI resource has been created"))
+		}
+	}
+	return errs
+}
+
+// jsonName returns the name of a struct field in the serialized object
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"sort"
+
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	admissionv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Names of the webhook configurations and of their only webhook
+const (
+	ValidatingWebhookName = "validate.oci.oracle.com"
+	MutatingWebhookName   = "default.oci.oracle.com"
+)
+
+// webhookRules sends creates and updates of every registered resource type
+func webhookRules() []admissionv1beta1.RuleWithOperations {
+	resourcesByGroup := map[string][]string{}
+	for _, resourceType := range resourcescommon.ResourceTypes() {
+		resourcesByGroup[resourceType.GroupName] = append(resourcesByGroup[resourceType.GroupName], resourceType.ResourcePlural)
+	}
+	groups := make([]string, 0, len(resourcesByGroup))
+	for group := range resourcesByGroup {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	var rules []admissionv1beta1.RuleWithOperations
+	for _, group := range groups {
+		sort.Strings(resourcesByGroup[group])
+		rules = append(rules, admissionv1beta1.RuleWithOperations{
+			Operations: []admissionv1beta1.OperationType{admissionv1beta1.Create, admissionv1beta1.Update},
+			Rule: admissionv1beta1.Rule{
+				APIGroups:   []string{group},
+				APIVersions: []string{"*"},
+				Resources:   resourcesByGroup[group],
+			},
+		})
+	}
+	return rules
+}
+
+// webhook returns the webhook called on path of the service
+func webhook(name, path, serviceNamespace, serviceName string, caBundle []byte) admissionv1beta1.Webhook {
+	// While no manager is serving, objects are only checked against the CRD schemas
+	// instead of blocking every write including the status updates of the controllers
+	failurePolicy := admissionv1beta1.Ignore
+	return admissionv1beta1.Webhook{
+		Name: name,
+		ClientConfig: admissionv1beta1.WebhookClientConfig{
+			Service:  &admissionv1beta1.ServiceReference{Namespace: serviceNamespace, Name: serviceName, Path: &path},
+			CABundle: caBundle,
+		},
+		Rules:         webhookRules(),
+		FailurePolicy: &failurePolicy,
+	}
+}
+
+// ValidatingWebhookConfiguration returns the configuration of the validating webhook
+func ValidatingWebhookConfiguration(serviceNamespace, serviceName string, caBundle []byte) *admissionv1beta1.ValidatingWebhookConfiguration {
+	return &admissionv1beta1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: ValidatingWebhookName},
+		Webhooks:   []admissionv1beta1.Webhook{webhook(ValidatingWebhookName, ValidatePath, serviceNamespace, serviceName, caBundle)},
+	}
+}
+
+// MutatingWebhookConfiguration returns the configuration of the defaulting webhook
+func MutatingWebhookConfiguration(serviceNamespace, serviceName string, caBundle []byte) *admissionv1beta1.MutatingWebhookConfiguration {
+	return &admissionv1beta1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: MutatingWebhookName},
+		Webhooks:   []admissionv1beta1.Webhook{webhook(MutatingWebhookName, DefaultPath, serviceNamespace, serviceName, caBundle)},
+	}
+}
+
+// RegisterWebhooks creates or updates the validating and the mutating webhook configuration
+func RegisterWebhooks(kubeclient kubernetes.Interface, serviceNamespace, serviceName string, caBundle []byte) error {
+	validating := ValidatingWebhookConfiguration(serviceNamespace, serviceName, caBundle)
+	validatingClient := kubeclient.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations()
+	existing, err := validatingClient.Get(validating.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = validatingClient.Create(validating)
+	case err == nil:
+		existing.Webhooks = validating.Webhooks
+		_, err = validatingClient.Update(existing)
+	}
+	if err != nil {
+		return err
+	}
+
+	mutating := MutatingWebhookConfiguration(serviceNamespace, serviceName, caBundle)
+	mutatingClient := kubeclient.AdmissionregistrationV1beta1().MutatingWebhookConfigurations()
+	existingMutating, err := mutatingClient.Get(mutating.Name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		_, err = mutatingClient.Create(mutating)
+	case err == nil:
+		existingMutating.Webhooks = mutating.Webhooks
+		_, err = mutatingClient.Update(existingMutating)
+	}
+	return err
+}
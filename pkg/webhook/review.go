@@ -0,0 +1,83 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The admission.k8s.io/v1beta1 types are not part of the vendored k8s.io/api and the
+// vendored apiextensions predate the ConversionReview, the structs below carry the fields
+// of the wire formats the server needs.
+
+// Admission operations
+const (
+	OperationCreate = "CREATE"
+	OperationUpdate = "UPDATE"
+	OperationDelete = "DELETE"
+)
+
+// AdmissionReview is sent by the apiserver with a request and returned with the response
+type AdmissionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *AdmissionRequest  `json:"request,omitempty"`
+	Response        *AdmissionResponse `json:"response,omitempty"`
+}
+
+// AdmissionRequest describes the object under admission
+type AdmissionRequest struct {
+	UID       types.UID                   `json:"uid"`
+	Kind      metav1.GroupVersionKind     `json:"kind"`
+	Resource  metav1.GroupVersionResource `json:"resource"`
+	Name      string                      `json:"name,omitempty"`
+	Namespace string                      `json:"namespace,omitempty"`
+	Operation string                      `json:"operation"`
+	Object    runtime.RawExtension        `json:"object,omitempty"`
+	OldObject runtime.RawExtension        `json:"oldObject,omitempty"`
+}
+
+// AdmissionResponse allows or denies the request, a mutating webhook may patch the object
+type AdmissionResponse struct {
+	UID       types.UID      `json:"uid"`
+	Allowed   bool           `json:"allowed"`
+	Result    *metav1.Status `json:"result,omitempty"`
+	Patch     []byte         `json:"patch,omitempty"`
+	PatchType *string        `json:"patchType,omitempty"`
+}
+
+// ConversionReview is sent by the apiserver with a request and returned with the response
+type ConversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *ConversionRequest  `json:"request,omitempty"`
+	Response        *ConversionResponse `json:"response,omitempty"`
+}
+
+// ConversionRequest asks for objects of one group in another version of the group
+type ConversionRequest struct {
+	UID               types.UID              `json:"uid"`
+	DesiredAPIVersion string                 `json:"desiredAPIVersion"`
+	Objects           []runtime.RawExtension `json:"objects"`
+}
+
+// ConversionResponse returns the converted objects in the order of the request
+type ConversionResponse struct {
+	UID              types.UID              `json:"uid"`
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	Result           metav1.Status          `json:"result"`
+}
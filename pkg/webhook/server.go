@@ -0,0 +1,208 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements the admission webhooks of the oci resource kinds. The
+// validating webhook adds semantic and cross-object checks to the OpenAPI schemas of
+// the CRDs and rejects edits of fields OCI only accepts on create. The defaulting webhook
+// fills common spec fields from the defaults of the namespace. The conversion webhook
+// converts objects between the served versions of the CRDs.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/golang/glog"
+
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	"github.com/oracle/oci-manager/pkg/client/clientset/versioned/scheme"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// Paths the webhooks are served on
+const (
+	ValidatePath = "/validate"
+	DefaultPath  = "/default"
+	ConvertPath  = "/convert"
+)
+
+// ValidateFunc checks an object of one kind, old is nil on create
+type ValidateFunc func(s *Server, obj, old runtime.Object) field.ErrorList
+
+// Server answers admission reviews of the oci resource kinds
+type Server struct {
+	factory    informers.SharedInformerFactory
+	namespaces corelisters.NamespaceLister
+	configMaps corelisters.ConfigMapLister
+	kubeclient kubernetes.Interface
+	decoder    runtime.Decoder
+}
+
+// NewServer returns a server reading other objects from the informers of factory and
+// the namespace defaults from kubeFactory. The informers the webhooks need are registered
+// with the factories before they are started.
+func NewServer(factory informers.SharedInformerFactory, kubeFactory kubeinformers.SharedInformerFactory, kubeclient kubernetes.Interface) *Server {
+	factory.Ocicore().V1alpha1().Vcns().Informer()
+	factory.Ocicore().V1alpha1().Subnets().Informer()
+	return &Server{
+		factory:    factory,
+		namespaces: kubeFactory.Core().V1().Namespaces().Lister(),
+		configMaps: kubeFactory.Core().V1().ConfigMaps().Lister(),
+		kubeclient: kubeclient,
+		decoder:    scheme.Codecs.UniversalDeserializer(),
+	}
+}
+
+// ServeHTTP answers an AdmissionReview or a ConversionReview of the webhook on the request path
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var review func(*AdmissionRequest) *AdmissionResponse
+	switch r.URL.Path {
+	case ValidatePath:
+		review = s.Review
+	case DefaultPath:
+		review = s.Default
+	case ConvertPath:
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "reviews must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if review == nil {
+		s.serveConversion(w, body)
+		return
+	}
+	admissionReview := AdmissionReview{}
+	if err := json.Unmarshal(body, &admissionReview); err != nil || admissionReview.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	admissionReview.Response = review(admissionReview.Request)
+	admissionReview.Response.UID = admissionReview.Request.UID
+	admissionReview.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(admissionReview); err != nil {
+		glog.Errorf("Error writing admission review: %v", err)
+	}
+}
+
+// Review validates the object of an admission request
+func (s *Server) Review(req *AdmissionRequest) *AdmissionResponse {
+	if req.Operation != OperationCreate && req.Operation != OperationUpdate {
+		return &AdmissionResponse{Allowed: true}
+	}
+
+	obj, err := s.decode(req.Object.Raw)
+	if err != nil {
+		return deny(apierrors.NewBadRequest(err.Error()))
+	}
+	var old runtime.Object
+	if req.Operation == OperationUpdate {
+		if old, err = s.decode(req.OldObject.Raw); err != nil {
+			return deny(apierrors.NewBadRequest(err.Error()))
+		}
+	}
+
+	errs := s.Validate(obj, old)
+	if len(errs) == 0 {
+		return &AdmissionResponse{Allowed: true}
+	}
+	gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+	glog.V(4).Infof("Denied %s %s/%s: %v", req.Operation, req.Namespace, req.Name, errs.ToAggregate())
+	return deny(apierrors.NewInvalid(gk, req.Name, errs))
+}
+
+// Validate checks obj, old is nil on create. Updates leaving the spec alone, such as
+// status and finalizer writes of the controllers, and objects being deleted pass.
+func (s *Server) Validate(obj, old runtime.Object) field.ErrorList {
+	// the annotations are checked before the spec shortcut, they change without the spec
+	if errs := append(validateDeletionPolicyAnnotation(obj, old), validateDriftPolicyAnnotation(obj, old)...); len(errs) > 0 {
+		return errs
+	}
+	if old != nil {
+		if objectmeta, err := meta.Accessor(obj); err == nil && objectmeta.GetDeletionTimestamp() != nil {
+			return nil
+		}
+		if reflect.DeepEqual(specOf(obj).Interface(), specOf(old).Interface()) {
+			return nil
+		}
+	}
+
+	kind := reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	var errs field.ErrorList
+	if old != nil {
+		if resource, ok := old.(ocicommon.ObjectInterface); ok && resource.GetResourceID() != "" {
+			errs = append(errs, validateImmutable(kind, obj, old)...)
+		}
+	}
+	if dependsOn := specOf(obj).FieldByName("DependsOn"); dependsOn.IsValid() {
+		relations, _ := dependsOn.Interface().(map[string]ocicommon.DependsOn)
+		errs = append(errs, validateDependsOn(relations, field.NewPath("spec", "dependson"))...)
+	}
+	if validate, ok := validators[kind]; ok {
+		errs = append(errs, validate(s, obj, old)...)
+	}
+	return errs
+}
+
+func (s *Server) decode(raw []byte) (runtime.Object, error) {
+	raw, err := ConvertObject(raw, HubVersion)
+	if err != nil {
+		return nil, err
+	}
+	obj, _, err := s.decoder.Decode(raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !specOf(obj).IsValid() {
+		return nil, fmt.Errorf("%T has no spec", obj)
+	}
+	return obj, nil
+}
+
+func deny(err *apierrors.StatusError) *AdmissionResponse {
+	status := err.Status()
+	return &AdmissionResponse{Allowed: false, Result: &status}
+}
+
+// specOf returns the spec of a resource object
+func specOf(obj runtime.Object) reflect.Value {
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	return v.FieldByName("Spec")
+}
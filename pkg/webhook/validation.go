@@ -0,0 +1,326 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"unicode"
+
+	ocicev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocice.oracle.com/v1alpha1"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1alpha1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	resourcescommon "github.com/oracle/oci-manager/pkg/controller/oci/resources/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Limits of the OCI services
+const (
+	vcnMinPrefix     = 16
+	maxPrefix        = 30
+	dnsLabelMaxLen   = 15
+	volumeMinSizeGBs = 50
+	volumeMaxSizeGBs = 32768
+	adbMinCores      = 1
+	adbMaxCores      = 128
+	adbMinStorageTBs = 1
+	adbMaxStorageTBs = 128
+	adbPasswordMin   = 12
+	adbPasswordMax   = 30
+)
+
+var (
+	dnsLabelRegex         = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]*$")
+	adbNameRegex          = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]{0,13}$")
+	listenerProtocolRegex = regexp.MustCompile(ocicommon.LoadBalancerProtocolRegex)
+)
+
+// validators holds the semantic checks by kind, the dependson selectors and the
+// create-only fields are checked for every kind
+var validators = map[string]ValidateFunc{
+	ocicorev1alpha1.VirtualNetworkKind:   validateVcn,
+	ocicorev1alpha1.SubnetKind:           validateSubnet,
+	ocicorev1alpha1.VolumeKind:           validateVolume,
+	ocilbv1alpha1.ListenerKind:           validateListener,
+	ocilbv1alpha1.BackendKind:            validateBackend,
+	ocicev1alpha1.NodePoolKind:           validateNodePool,
+	ocidbv1alpha1.AutonomousDatabaseKind: validateAutonomousDatabase,
+}
+
+func validateVcn(s *Server, obj, old runtime.Object) field.ErrorList {
+	vcn := obj.(*ocicorev1alpha1.Vcn)
+	specPath := field.NewPath("spec")
+	_, errs := validateCidr(vcn.Spec.CidrBlock, vcnMinPrefix, specPath.Child("cidrBlock"))
+	return append(errs, validateDNSLabel(vcn.Spec.DNSLabel, specPath.Child("dnsLabel"))...)
+}
+
+// validateSubnet checks that the subnet lies within its vcn and does not overlap the
+// other subnets of the vcn, as far as they are known to the informer cache
+func validateSubnet(s *Server, obj, old runtime.Object) field.ErrorList {
+	subnet := obj.(*ocicorev1alpha1.Subnet)
+	specPath := field.NewPath("spec")
+	cidrPath := specPath.Child("cidrBlock")
+	errs := validateDNSLabel(subnet.Spec.DNSLabel, specPath.Child("dnsLabel"))
+	network, cidrErrs := validateCidr(subnet.Spec.CidrBlock, 0, cidrPath)
+	errs = append(errs, cidrErrs...)
+	if network == nil || subnet.Spec.VcnRef == "" || resourcescommon.IsOcid(subnet.Spec.VcnRef) {
+		return errs
+	}
+
+	vcn, err := s.factory.Ocicore().V1alpha1().Vcns().Lister().Vcns(subnet.Namespace).Get(subnet.Spec.VcnRef)
+	if err == nil {
+		if _, vcnNetwork, err := net.ParseCIDR(vcn.Spec.CidrBlock); err == nil && !containsNetwork(vcnNetwork, network) {
+			errs = append(errs, field.Invalid(cidrPath, subnet.Spec.CidrBlock,
+				fmt.Sprintf("must be within the cidr block %s of vcn %s", vcn.Spec.CidrBlock, vcn.Name)))
+		}
+	}
+
+	siblings, err := s.factory.Ocicore().V1alpha1().Subnets().Lister().Subnets(subnet.Namespace).List(labels.Everything())
+	if err != nil {
+		return append(errs, field.InternalError(cidrPath, err))
+	}
+	for _, sibling := range siblings {
+		if sibling.Name == subnet.Name || sibling.Spec.VcnRef != subnet.Spec.VcnRef {
+			continue
+		}
+		if _, siblingNetwork, err := net.ParseCIDR(sibling.Spec.CidrBlock); err == nil && overlaps(network, siblingNetwork) {
+			errs = append(errs, field.Invalid(cidrPath, subnet.Spec.CidrBlock,
+				fmt.Sprintf("overlaps the cidr block %s of subnet %s", sibling.Spec.CidrBlock, sibling.Name)))
+		}
+	}
+	return errs
+}
+
+func validateVolume(s *Server, obj, old runtime.Object) field.ErrorList {
+	volume := obj.(*ocicorev1alpha1.Volume)
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	if size := volume.Spec.SizeInGBs; size != 0 && (size < volumeMinSizeGBs || size > volumeMaxSizeGBs) {
+		errs = append(errs, field.Invalid(specPath.Child("sizeInGBs"), size,
+			fmt.Sprintf("must be between %d and %d", volumeMinSizeGBs, volumeMaxSizeGBs)))
+	}
+	return errs
+}
+
+func validateListener(s *Server, obj, old runtime.Object) field.ErrorList {
+	listener := obj.(*ocilbv1alpha1.Listener)
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	if !listenerProtocolRegex.MatchString(listener.Spec.Protocol) {
+		errs = append(errs, field.NotSupported(specPath.Child("protocol"), listener.Spec.Protocol, []string{"HTTP", "HTTP2", "TCP"}))
+	}
+	errs = append(errs, validatePort(listener.Spec.Port, specPath.Child("port"))...)
+	if listener.Spec.IdleTimeout < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("idleTimeout"), listener.Spec.IdleTimeout, "must not be negative"))
+	}
+	return errs
+}
+
+func validateBackend(s *Server, obj, old runtime.Object) field.ErrorList {
+	backend := obj.(*ocilbv1alpha1.Backend)
+	specPath := field.NewPath("spec")
+	errs := validatePort(backend.Spec.Port, specPath.Child("port"))
+	if backend.Spec.IPAddress != "" && net.ParseIP(backend.Spec.IPAddress) == nil {
+		errs = append(errs, field.Invalid(specPath.Child("ipAddress"), backend.Spec.IPAddress, "must be an IP address"))
+	}
+	return errs
+}
+
+func validateNodePool(s *Server, obj, old runtime.Object) field.ErrorList {
+	nodePool := obj.(*ocicev1alpha1.NodePool)
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	if quantity := nodePool.Spec.QuantityPerSubnet; quantity != nil && *quantity < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("quantityPerSubnet"), *quantity, "must not be negative"))
+	}
+	if len(nodePool.Spec.SubnetRefs) == 0 {
+		errs = append(errs, field.Required(specPath.Child("subnetRefs"), "node pools need at least one subnet"))
+	}
+	return errs
+}
+
+// validateAutonomousDatabase checks the sizing and, on create, the admin password
+// secret the adapter reuses when it exists. The object name becomes the db name.
+func validateAutonomousDatabase(s *Server, obj, old runtime.Object) field.ErrorList {
+	db := obj.(*ocidbv1alpha1.AutonomousDatabase)
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	if !adbNameRegex.MatchString(db.Name) {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), db.Name,
+			"is used as the database name and must start with a letter followed by at most 13 letters or digits"))
+	}
+	errs = append(errs, validateRange(db.Spec.CpuCoreCount, adbMinCores, adbMaxCores, specPath.Child("cpuCoreCount"))...)
+	errs = append(errs, validateRange(db.Spec.DataStorageSizeInTBs, adbMinStorageTBs, adbMaxStorageTBs, specPath.Child("dataStorageSizeInTBs"))...)
+
+	if old != nil || s.kubeclient == nil {
+		return errs
+	}
+	secret, err := s.kubeclient.CoreV1().Secrets(db.Namespace).Get(db.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return errs
+	}
+	secretPath := field.NewPath("metadata", "name")
+	if err != nil {
+		return append(errs, field.InternalError(secretPath, err))
+	}
+	if msg := adminPasswordError(string(secret.Data["password"])); msg != "" {
+		errs = append(errs, field.Invalid(secretPath, db.Name, fmt.Sprintf("the password in secret %s %s", db.Name, msg)))
+	}
+	return errs
+}
+
+// adminPasswordError describes why an autonomous database admin password is rejected by OCI
+func adminPasswordError(password string) string {
+	if len(password) < adbPasswordMin || len(password) > adbPasswordMax {
+		return fmt.Sprintf("must be %d to %d characters", adbPasswordMin, adbPasswordMax)
+	}
+	var upper, lower, digit bool
+	for _, r := range password {
+		upper = upper || unicode.IsUpper(r)
+		lower = lower || unicode.IsLower(r)
+		digit = digit || unicode.IsDigit(r)
+	}
+	if !upper || !lower || !digit {
+		return "must contain an uppercase letter, a lowercase letter and a number"
+	}
+	if strings.Contains(password, `"`) || strings.Contains(strings.ToLower(password), "admin") {
+		return `must not contain the " character or the word admin`
+	}
+	return ""
+}
+
+// validateDeletionPolicyAnnotation rejects unknown values of the deletion policy annotation, it
+// overrides the spec so a typo of Orphan would delete the OCI resource. Only a changed value is
+// checked so that the controllers can still write objects carrying an unknown value.
+func validateDeletionPolicyAnnotation(obj, old runtime.Object) field.ErrorList {
+	policy, changed := changedAnnotation(obj, old, ocicommon.DeletionPolicyAnnotation)
+	if !changed || ocicommon.DeletionPolicy(policy).IsValid() {
+		return nil
+	}
+	path := field.NewPath("metadata", "annotations").Key(ocicommon.DeletionPolicyAnnotation)
+	return field.ErrorList{field.NotSupported(path, policy,
+		[]string{string(ocicommon.DeletionPolicyDelete), string(ocicommon.DeletionPolicyOrphan)})}
+}
+
+// validateDriftPolicyAnnotation rejects unknown values of the drift policy annotation, it
+// overrides the spec and its enum so a typo would neither correct nor report the drift
+func validateDriftPolicyAnnotation(obj, old runtime.Object) field.ErrorList {
+	policy, changed := changedAnnotation(obj, old, ocicommon.DriftPolicyAnnotation)
+	if !changed || ocicommon.DriftPolicy(policy).IsValid() {
+		return nil
+	}
+	path := field.NewPath("metadata", "annotations").Key(ocicommon.DriftPolicyAnnotation)
+	return field.ErrorList{field.NotSupported(path, policy,
+		[]string{string(ocicommon.DriftPolicyEnforce), string(ocicommon.DriftPolicyReport), string(ocicommon.DriftPolicyIgnore)})}
+}
+
+// changedAnnotation returns the value of an annotation of obj and reports if it is set and
+// differs from the value of old
+func changedAnnotation(obj, old runtime.Object, annotation string) (string, bool) {
+	objectmeta, err := meta.Accessor(obj)
+	if err != nil {
+		return "", false
+	}
+	value, ok := objectmeta.GetAnnotations()[annotation]
+	if !ok || value == "" {
+		return "", false
+	}
+	if old != nil {
+		if oldmeta, err := meta.Accessor(old); err == nil && oldmeta.GetAnnotations()[annotation] == value {
+			return value, false
+		}
+	}
+	return value, true
+}
+
+// validateCidr parses a cidr block and checks its prefix length, minPrefix 0 allows any size
+func validateCidr(cidr string, minPrefix int, path *field.Path) (*net.IPNet, field.ErrorList) {
+	if cidr == "" {
+		return nil, nil
+	}
+	ip, network, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() == nil {
+		return nil, field.ErrorList{field.Invalid(path, cidr, "must be an IPv4 cidr block")}
+	}
+	if !ip.Equal(network.IP) {
+		return nil, field.ErrorList{field.Invalid(path, cidr, fmt.Sprintf("has host bits set, the network is %s", network))}
+	}
+	if prefix, _ := network.Mask.Size(); prefix < minPrefix || prefix > maxPrefix {
+		return nil, field.ErrorList{field.Invalid(path, cidr, fmt.Sprintf("prefix length must be between /%d and /%d", minPrefix, maxPrefix))}
+	}
+	return network, nil
+}
+
+func validateDNSLabel(label string, path *field.Path) field.ErrorList {
+	if label == "" {
+		return nil
+	}
+	if len(label) > dnsLabelMaxLen || !dnsLabelRegex.MatchString(label) {
+		return field.ErrorList{field.Invalid(path, label,
+			fmt.Sprintf("must start with a letter followed by letters or digits, at most %d characters", dnsLabelMaxLen))}
+	}
+	return nil
+}
+
+// validateDependsOn checks that the selectors parse, the controller skips relations it cannot evaluate
+func validateDependsOn(dependsOn map[string]ocicommon.DependsOn, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for resource, relation := range dependsOn {
+		selector := &metav1.LabelSelector{MatchLabels: relation.LabelSelector, MatchExpressions: relation.MatchExpressions}
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			errs = append(errs, field.Invalid(path.Key(resource), relation, err.Error()))
+		}
+	}
+	return errs
+}
+
+func validatePort(port int, path *field.Path) field.ErrorList {
+	if port < 1 || port > 65535 {
+		return field.ErrorList{field.Invalid(path, port, "must be between 1 and 65535")}
+	}
+	return nil
+}
+
+func validateRange(value *int, min, max int, path *field.Path) field.ErrorList {
+	if value == nil {
+		return field.ErrorList{field.Required(path, "")}
+	}
+	if *value < min || *value > max {
+		return field.ErrorList{field.Invalid(path, *value, fmt.Sprintf("must be between %d and %d", min, max))}
+	}
+	return nil
+}
+
+// containsNetwork reports if inner lies within outer
+func containsNetwork(outer, inner *net.IPNet) bool {
+	outerPrefix, _ := outer.Mask.Size()
+	innerPrefix, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerPrefix >= outerPrefix
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2018 Oracle and/or its affiliates. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ocisdkcore "github.com/oracle/oci-go-sdk/core"
+	ocicommon "github.com/oracle/oci-manager/pkg/apis/ocicommon.oracle.com/v1alpha1"
+	ocicorev1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocicore.oracle.com/v1alpha1"
+	ocidbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocidb.oracle.com/v1alpha1"
+	ocilbv1alpha1 "github.com/oracle/oci-manager/pkg/apis/ocilb.oracle.com/v1alpha1"
+	fakeclient "github.com/oracle/oci-manager/pkg/client/clientset/versioned/fake"
+	informers "github.com/oracle/oci-manager/pkg/client/informers/externalversions"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const fakeNs = "fakeNs"
+
+func newTestServer(kubeObjects []runtime.Object, objects ...runtime.Object) (*Server, chan struct{}) {
+	factory := informers.NewSharedInformerFactory(fakeclient.NewSimpleClientset(objects...), 30*time.Second)
+	kubeclient := fake.NewSimpleClientset(kubeObjects...)
+	kubeFactory := kubeinformers.NewSharedInformerFactory(kubeclient, 30*time.Second)
+	server := NewServer(factory, kubeFactory, kubeclient)
+	stopCh := make(chan struct{})
+	factory.Start(stopCh)
+	kubeFactory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+	kubeFactory.WaitForCacheSync(stopCh)
+	return server, stopCh
+}
+
+func testVcn(name, cidr string) *ocicorev1alpha1.Vcn {
+	return &ocicorev1alpha1.Vcn{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: fakeNs},
+		Spec:       ocicorev1alpha1.VcnSpec{CidrBlock: cidr, DNSLabel: name},
+	}
+}
+
+func testSubnet(name, vcnRef, cidr string) *ocicorev1alpha1.Subnet {
+	return &ocicorev1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: fakeNs},
+		Spec:       ocicorev1alpha1.SubnetSpec{VcnRef: vcnRef, CidrBlock: cidr},
+	}
+}
+
+func TestValidateSubnet(t *testing.T) {
+	server, stopCh := newTestServer(nil,
+		testVcn("vcn1", "10.0.0.0/16"),
+		testSubnet("subnet1", "vcn1", "10.0.1.0/24"),
+		testSubnet("subnet2", "vcn2", "10.0.2.0/24"),
+	)
+	defer close(stopCh)
+
+	tests := map[string]struct {
+		subnet *ocicorev1alpha1.Subnet
+		errMsg string
+	}{
+		"valid":          {testSubnet("subnet3", "vcn1", "10.0.2.0/24"), ""},
+		"outside vcn":    {testSubnet("subnet3", "vcn1", "10.1.0.0/24"), "must be within the cidr block 10.0.0.0/16 of vcn vcn1"},
+		"wider than vcn": {testSubnet("subnet3", "vcn1", "10.0.0.0/8"), "must be within the cidr block"},
+		"overlapping":    {testSubnet("subnet3", "vcn1", "10.0.1.128/25"), "overlaps the cidr block 10.0.1.0/24 of subnet subnet1"},
+		"itself":         {testSubnet("subnet1", "vcn1", "10.0.1.0/25"), ""},
+		"unknown vcn":    {testSubnet("subnet3", "vcn3", "10.1.0.0/24"), ""},
+		"host bits":      {testSubnet("subnet3", "vcn1", "10.0.3.1/24"), "has host bits set"},
+		"not a cidr":     {testSubnet("subnet3", "vcn1", "10.0.3.0"), "must be an IPv4 cidr block"},
+	}
+	for name, test := range tests {
+		errs := server.Validate(test.subnet, nil)
+		if test.errMsg == "" && len(errs) != 0 {
+			t.Errorf("%s: expected no errors, got %v", name, errs)
+		}
+		if test.errMsg != "" && (len(errs) == 0 || !strings.Contains(errs[0].Error(), test.errMsg)) {
+			t.Errorf("%s: expected error %q, got %v", name, test.errMsg, errs)
+		}
+	}
+}
+
+func TestValidateCreateOnlyFields(t *testing.T) {
+	server, stopCh := newTestServer(nil, testVcn("vcn1", "10.0.0.0/16"))
+	defer close(stopCh)
+
+	old := testSubnet("subnet1", "vcn1", "10.0.1.0/24")
+	changed := testSubnet("subnet1", "vcn1", "10.0.2.0/24")
+	changed.Spec.DisplayName = "renamed"
+	if errs := server.Validate(changed, old); len(errs) != 0 {
+		t.Errorf("Expected changes before create to be allowed, got %v", errs)
+	}
+
+	id := "ocid1.subnet.oc1.phx.aaaaaaaas3of2ieoysj25xjoqt6mw3ft5veqixo2fyoapobspc65novfpo6q"
+	old.SetResource(&ocisdkcore.Subnet{Id: &id})
+	errs := server.Validate(changed, old)
+	if len(errs) != 1 || errs[0].Field != "spec.cidrBlock" {
+		t.Errorf("Expected cidrBlock to be immutable, got %v", errs)
+	}
+
+	renamed := old.DeepCopy()
+	renamed.Spec.DisplayName = "renamed"
+	if errs := server.Validate(renamed, old); len(errs) != 0 {
+		t.Errorf("Expected displayName to be mutable, got %v", errs)
+	}
+
+	now := metav1.Now()
+	changed.DeletionTimestamp = &now
+	if errs := server.Validate(changed, old); len(errs) != 0 {
+		t.Errorf("Expected objects being deleted to pass, got %v", errs)
+	}
+}
+
+func TestValidateDeletionPolicyAnnotation(t *testing.T) {
+	server, stopCh := newTestServer(nil)
+	defer close(stopCh)
+
+	annotated := func(policy string) *ocicorev1alpha1.Vcn {
+		vcn := testVcn("vcn1", "10.0.0.0/16")
+		vcn.Annotations = map[string]string{ocicommon.DeletionPolicyAnnotation: policy}
+		return vcn
+	}
+
+	for _, policy := range []string{"Delete", "Orphan"} {
+		if errs := server.Validate(annotated(policy), nil); len(errs) != 0 {
+			t.Errorf("Expected %s to be allowed, got %v", policy, errs)
+		}
+	}
+	for _, policy := range []string{"orphan", "Orphaned"} {
+		errs := server.Validate(annotated(policy), nil)
+		if len(errs) != 1 || errs[0].Field != "metadata.annotations[oci.oracle.com/deletion-policy]" {
+			t.Errorf("Expected %s to be rejected, got %v", policy, errs)
+		}
+		// an annotation-only update leaves the spec alone
+		if errs := server.Validate(annotated(policy), annotated("Orphan")); len(errs) != 1 {
+			t.Errorf("Expected update to %s to be rejected, got %v", policy, errs)
+		}
+		if errs := server.Validate(annotated(policy), annotated(policy)); len(errs) != 0 {
+			t.Errorf("Expected unchanged %s to pass, got %v", policy, errs)
+		}
+	}
+}
+
+func TestValidateDriftPolicyAnnotation(t *testing.T) {
+	server, stopCh := newTestServer(nil)
+	defer close(stopCh)
+
+	annotated := func(policy string) *ocicorev1alpha1.Vcn {
+		vcn := testVcn("vcn1", "10.0.0.0/16")
+		vcn.Annotations = map[string]string{ocicommon.DriftPolicyAnnotation: policy}
+		return vcn
+	}
+
+	for _, policy := range []string{"Enforce", "Report", "Ignore"} {
+		if errs := server.Validate(annotated(policy), nil); len(errs) != 0 {
+			t.Errorf("Expected %s to be allowed, got %v", policy, errs)
+		}
+	}
+	for _, policy := range []string{"enforce", "Reprot"} {
+		errs := server.Validate(annotated(policy), nil)
+		if len(errs) != 1 || errs[0].Field != "metadata.annotations[oci.oracle.com/drift-policy]" {
+			t.Errorf("Expected %s to be rejected, got %v", policy, errs)
+		}
+		if errs := server.Validate(annotated(policy), annotated("Report")); len(errs) != 1 {
+			t.Errorf("Expected update to %s to be rejected, got %v", policy, errs)
+		}
+		if errs := server.Validate(annotated(policy), annotated(policy)); len(errs) != 0 {
+			t.Errorf("Expected unchanged %s to pass, got %v", policy, errs)
+		}
+	}
+}
+
+func TestValidateAutonomousDatabase(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db1", Namespace: fakeNs},
+		Data:       map[string][]byte{"password": []byte("short")},
+	}
+	server, stopCh := newTestServer([]runtime.Object{secret})
+	defer close(stopCh)
+
+	cores, storage := 200, 1
+	db := &ocidbv1alpha1.AutonomousDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "db1", Namespace: fakeNs},
+		Spec:       ocidbv1alpha1.AutonomousDatabaseSpec{CpuCoreCount: &cores, DataStorageSizeInTBs: &storage},
+	}
+	errs := server.Validate(db, nil)
+	if len(errs) != 2 || errs[0].Field != "spec.cpuCoreCount" || !strings.Contains(errs[1].Error(), "the password in secret db1") {
+		t.Errorf("Expected core count and password errors, got %v", errs)
+	}
+}
+
+func TestAdminPasswordError(t *testing.T) {
+	tests := map[string]bool{
+		"Welcome12345":                    true,
+		"welcome12345":                    false,
+		"Welcome":                         false,
+		"Admin12345abc":                   false,
+		`Welcome"12345`:                   false,
+		"WelcomeWelcomeWelcome123456789X": false,
+	}
+	for password, valid := range tests {
+		if msg := adminPasswordError(password); (msg == "") != valid {
+			t.Errorf("Password %s expected valid %v, got %q", password, valid, msg)
+		}
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	server, stopCh := newTestServer(nil)
+	defer close(stopCh)
+
+	listener := &ocilbv1alpha1.Listener{
+		TypeMeta:   metav1.TypeMeta{APIVersion: ocilbv1alpha1.SchemeGroupVersion.String(), Kind: ocilbv1alpha1.ListenerKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "listener1", Namespace: fakeNs},
+		Spec:       ocilbv1alpha1.ListenerSpec{Protocol: "UDP", Port: 80},
+	}
+	raw, _ := json.Marshal(listener)
+	review := AdmissionReview{Request: &AdmissionRequest{
+		UID:       "1234",
+		Kind:      metav1.GroupVersionKind{Group: ocilbv1alpha1.SchemeGroupVersion.Group, Version: ocilbv1alpha1.SchemeGroupVersion.Version, Kind: ocilbv1alpha1.ListenerKind},
+		Name:      listener.Name,
+		Namespace: fakeNs,
+		Operation: OperationCreate,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+	body, _ := json.Marshal(review)
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader(body)))
+	answer := AdmissionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &answer); err != nil || answer.Response == nil {
+		t.Fatalf("Expected an admission review, got %v %s", err, recorder.Body.String())
+	}
+	response := answer.Response
+	if response.UID != "1234" || response.Allowed || response.Result == nil || response.Result.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected the listener to be denied, got %#v", response)
+	}
+	if !strings.Contains(response.Result.Message, "spec.protocol") {
+		t.Errorf("Expected the protocol to be named, got %s", response.Result.Message)
+	}
+
+	review.Request.Operation = OperationDelete
+	body, _ = json.Marshal(review)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader(body)))
+	answer = AdmissionReview{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &answer); err != nil || answer.Response == nil || !answer.Response.Allowed {
+		t.Errorf("Expected deletes to be allowed, got %v %s", err, recorder.Body.String())
+	}
+}